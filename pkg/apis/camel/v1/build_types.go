@@ -159,6 +159,8 @@ type PublishTask struct {
 	Image string `json:"image,omitempty"`
 	// where to publish the final image
 	Registry RegistrySpec `json:"registry,omitempty"`
+	// how to sign the final image
+	Signing *ImageSigningSpec `json:"signing,omitempty"`
 }
 
 // BuildahTask is used to configure Buildah.
@@ -258,6 +260,8 @@ type BuildStatus struct {
 	BaseImage string `json:"baseImage,omitempty"`
 	// a list of artifacts contained in the build
 	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// the SBOM and provenance attestations attached to the image built (if any)
+	Attestations []Attestation `json:"attestations,omitempty"`
 	// the error description (if any)
 	Error string `json:"error,omitempty"`
 	// the reason of the failure (if any)
@@ -272,6 +276,30 @@ type BuildStatus struct {
 	Duration string `json:"duration,omitempty"`
 }
 
+// Attestation references a document describing the content or the origin of an image (SBOM, provenance, ...).
+type Attestation struct {
+	// the type of the attestation
+	Type AttestationType `json:"type"`
+	// the media type of the document
+	MediaType string `json:"mediaType,omitempty"`
+	// the digest of the document
+	Digest string `json:"digest,omitempty"`
+	// the OCI artifact attached to the image in the registry (if any)
+	Image string `json:"image,omitempty"`
+	// the ConfigMap key storing the document (if any)
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// AttestationType -- .
+type AttestationType string
+
+const (
+	// AttestationTypeSBOM is a CycloneDX software bill of materials listing the artifacts contained in the image.
+	AttestationTypeSBOM AttestationType = "SBOM"
+	// AttestationTypeProvenance is an in-toto statement with a SLSA provenance predicate describing how the image has been built.
+	AttestationTypeProvenance AttestationType = "Provenance"
+)
+
 // BuildPhase -- .
 type BuildPhase string
 
@@ -303,11 +331,17 @@ const (
 
 	// BuildConditionScheduled --.
 	BuildConditionScheduled BuildConditionType = "Scheduled"
+	// BuildConditionDependencyCache reports whether the Maven dependencies were loaded from the dependency cache.
+	BuildConditionDependencyCache BuildConditionType = "DependencyCache"
 
 	// BuildConditionReadyReason --.
 	BuildConditionReadyReason string = "Ready"
 	// BuildConditionWaitingReason --.
 	BuildConditionWaitingReason string = "Waiting"
+	// BuildConditionDependencyCacheHitReason --.
+	BuildConditionDependencyCacheHitReason string = "Hit"
+	// BuildConditionDependencyCacheMissReason --.
+	BuildConditionDependencyCacheMissReason string = "Miss"
 )
 
 // +genclient
@@ -44,6 +44,11 @@ const (
 	IntegrationDontRunAfterBuildAnnotation = "camel.apache.org/dont-run-after-build"
 	// IntegrationDontRunAfterBuildAnnotationTrueValue -- .
 	IntegrationDontRunAfterBuildAnnotationTrueValue = "true"
+	// IntegrationWokenAtAnnotation is the time an Integration has been woken up on demand, in RFC 3339 format.
+	IntegrationWokenAtAnnotation = "camel.apache.org/woken-at"
+	// IntegrationRollbackRevisionAnnotation is the revision an Integration has been rolled back to. The kit of the
+	// revision is reused, as long as the Integration specification is the one recorded in the revision.
+	IntegrationRollbackRevisionAnnotation = "camel.apache.org/rollback-revision"
 )
 
 // BuildConfiguration represent the configuration required to build the runtime.
@@ -122,7 +127,7 @@ var BuildOrderStrategies = []BuildOrderStrategy{
 
 // KameletRepositorySpec defines the location of the Kamelet catalog to use.
 type KameletRepositorySpec struct {
-	// the remote repository in the format github:ORG/REPO/PATH_TO_KAMELETS_FOLDER
+	// the repository in the format github:ORG/REPO/PATH_TO_KAMELETS_FOLDER[@VERSION], file:PATH[@VERSION] or oci:REGISTRY/REPOSITORY[:TAG|@DIGEST]
 	URI string `json:"uri,omitempty"`
 }
 
@@ -224,6 +229,8 @@ type Traits struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty" property:"gitops"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty" property:"health"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty" property:"hpa"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty" property:"ingress"`
 	// The configuration of Init Containers trait
@@ -250,9 +257,9 @@ type Traits struct {
 	Master *trait.MasterTrait `json:"master,omitempty" property:"master"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty" property:"mount"`
-	// The configuration of OpenAPI trait.
-	//
-	// Deprecated: no longer in use.
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty" property:"network-policy"`
+	// The configuration of OpenAPI trait
 	OpenAPI *trait.OpenAPITrait `json:"openapi,omitempty" property:"openapi"`
 	// The configuration of Owner trait
 	Owner *trait.OwnerTrait `json:"owner,omitempty" property:"owner"`
@@ -270,10 +277,14 @@ type Traits struct {
 	PullSecret *trait.PullSecretTrait `json:"pull-secret,omitempty" property:"pull-secret"`
 	// The configuration of Quarkus trait
 	Quarkus *trait.QuarkusTrait `json:"quarkus,omitempty" property:"quarkus"`
+	// The configuration of RBAC trait
+	RBAC *trait.RBACTrait `json:"rbac,omitempty" property:"rbac"`
 	// The configuration of Registry trait (support removed since version 2.5.0).
 	//
 	// Deprecated: use jvm trait or read documentation.
 	Registry *trait.RegistryTrait `json:"registry,omitempty" property:"registry"`
+	// The configuration of Rollout trait
+	Rollout *trait.RolloutTrait `json:"rollout,omitempty" property:"rollout"`
 	// The configuration of Route trait.
 	//
 	// Deprecated: use ingress instead.
@@ -282,10 +293,10 @@ type Traits struct {
 	SecurityContext *trait.SecurityContextTrait `json:"security-context,omitempty" property:"security-context"`
 	// The configuration of Service trait
 	Service *trait.ServiceTrait `json:"service,omitempty" property:"service"`
-	// The configuration of Service Binding trait.
-	//
-	// Deprecated: no longer in use.
+	// The configuration of Service Binding trait
 	ServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty" property:"service-binding"`
+	// The configuration of Sleep trait
+	Sleep *trait.SleepTrait `json:"sleep,omitempty" property:"sleep"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty" property:"telemetry"`
 	// The configuration of Toleration trait
@@ -353,6 +364,15 @@ type RegistrySpec struct {
 	Organization string `json:"organization,omitempty"`
 }
 
+// ImageSigningSpec defines how the images built by the operator are signed and how the Integration images are verified.
+type ImageSigningSpec struct {
+	// the secret holding the signing keys: a PEM encoded private key (`cosign.key`) used to sign the images
+	// built by the operator and a PEM encoded public key (`cosign.pub`) used to verify the Integration images
+	Secret string `json:"secret,omitempty"`
+	// verify the signature of the Integration images before deploying them (default `true`)
+	Verify *bool `json:"verify,omitempty"`
+}
+
 // ValueSource --.
 type ValueSource struct {
 	// Selects a key of a ConfigMap.
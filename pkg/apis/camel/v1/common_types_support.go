@@ -218,6 +218,11 @@ func (bc *BuildConfiguration) IsEmpty() bool {
 		bc.LimitMemory == ""
 }
 
+// IsVerificationEnabled tells whether the signature of the Integration images must be verified before deployment.
+func (s *ImageSigningSpec) IsVerificationEnabled() bool {
+	return s != nil && s.Secret != "" && (s.Verify == nil || *s.Verify)
+}
+
 // DecodeValueSource returns a ValueSource object from an input that respects the format configmap|secret:resource-name[/path].
 func DecodeValueSource(input string, defaultKey string) (ValueSource, error) {
 	sub := make([]string, 0)
@@ -19,7 +19,10 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -40,6 +43,8 @@ import (
 // +kubebuilder:printcolumn:name="Catalog Version",type=string,JSONPath=`.status.catalog.version`,description="The catalog version"
 // +kubebuilder:printcolumn:name="Kit",type=string,JSONPath=`.status.integrationKit.name`,description="The integration kit"
 // +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.status.replicas`,description="The number of pods"
+// +kubebuilder:printcolumn:name="Recommended CPU",type=string,JSONPath=`.status.resources.recommendation.requestCPU`,description="The recommended CPU request",priority=1
+// +kubebuilder:printcolumn:name="Recommended Memory",type=string,JSONPath=`.status.resources.recommendation.requestMemory`,description="The recommended memory request",priority=1
 
 // Integration is the Schema for the integrations API.
 type Integration struct {
@@ -101,6 +106,9 @@ type IntegrationStatus struct {
 	Digest string `json:"digest,omitempty"`
 	// the container image used
 	Image string `json:"image,omitempty"`
+	// the container image, addressed by digest, the signature of which has been verified.
+	// The Integration is deployed from it, in place of the image.
+	VerifiedImage string `json:"verifiedImage,omitempty"`
 	// the Java jar dependency to execute (if available)
 	Jar string `json:"jar,omitempty"`
 	// a list of dependencies needed by the application
@@ -143,8 +151,83 @@ type IntegrationStatus struct {
 	DeploymentTimestamp *metav1.Time `json:"lastDeploymentTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was built.
 	BuildTimestamp *metav1.Time `json:"lastBuildTimestamp,omitempty"`
+	// the progress of the last rollout of a new version of the Integration (see the rollout trait).
+	Rollout *IntegrationRolloutStatus `json:"rollout,omitempty"`
+	// the requests and limits recommended for the Integration container, from its resource usage.
+	Resources *IntegrationResourcesStatus `json:"resources,omitempty"`
+	// the state of the sleep schedules of the Integration (see the sleep trait).
+	Sleep *IntegrationSleepStatus `json:"sleep,omitempty"`
+	// the revision of the Integration currently deployed, as recorded in its revision history (see the deployer trait).
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// IntegrationSleepStatus describes the state of the sleep schedules of an Integration.
+type IntegrationSleepStatus struct {
+	// whether the Integration is scaled down to zero replicas by its sleep schedules
+	Sleeping bool `json:"sleeping,omitempty"`
+	// the last time the Integration went to sleep or woke up
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// the time of the next scheduled transition, either going to sleep or waking up
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+}
+
+// IntegrationResourcesStatus describes the requests and limits recommended for the Integration container,
+// from the usage samples collected by the operator from the resource metrics API, over the observation window.
+type IntegrationResourcesStatus struct {
+	// the requests and limits recommended from the usage samples
+	Recommendation *IntegrationResourcesRecommendation `json:"recommendation,omitempty"`
+	// the recommendation taken into account by the last rollout, when the container trait applies the recommendations
+	Applied *IntegrationResourcesRecommendation `json:"applied,omitempty"`
+}
+
+// IntegrationResourcesRecommendation describes the requests and limits recommended for the Integration container.
+type IntegrationResourcesRecommendation struct {
+	// the time at which the recommendation was computed
+	Timestamp metav1.Time `json:"timestamp"`
+	// the number of usage samples the recommendation is computed from
+	Samples int32 `json:"samples,omitempty"`
+	// the recommended amount of CPU requested
+	RequestCPU resource.Quantity `json:"requestCPU"`
+	// the recommended amount of memory requested
+	RequestMemory resource.Quantity `json:"requestMemory"`
+	// the recommended maximum amount of CPU
+	LimitCPU resource.Quantity `json:"limitCPU"`
+	// the recommended maximum amount of memory
+	LimitMemory resource.Quantity `json:"limitMemory"`
 }
 
+// IntegrationRolloutStatus describes the progress of a rollout replacing the running Integration with a new version.
+type IntegrationRolloutStatus struct {
+	// the phase of the rollout
+	Phase IntegrationRolloutPhase `json:"phase,omitempty"`
+	// the strategy of the rollout
+	Strategy trait.RolloutStrategy `json:"strategy,omitempty"`
+	// the container image of the previous version
+	StableImage string `json:"stableImage,omitempty"`
+	// the container image of the new version
+	CanaryImage string `json:"canaryImage,omitempty"`
+	// the index of the current rollout step
+	Step int32 `json:"step,omitempty"`
+	// the percentage of the traffic routed to the new version
+	Weight int32 `json:"weight,omitempty"`
+	// the last time the rollout moved to the current step or phase
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// a human-readable message indicating details about the rollout
+	Message string `json:"message,omitempty"`
+}
+
+// IntegrationRolloutPhase --.
+type IntegrationRolloutPhase string
+
+const (
+	// IntegrationRolloutPhaseProgressing --.
+	IntegrationRolloutPhaseProgressing IntegrationRolloutPhase = "Progressing"
+	// IntegrationRolloutPhasePromoted --.
+	IntegrationRolloutPhasePromoted IntegrationRolloutPhase = "Promoted"
+	// IntegrationRolloutPhaseRolledBack --.
+	IntegrationRolloutPhaseRolledBack IntegrationRolloutPhase = "RolledBack"
+)
+
 // +kubebuilder:object:root=true
 
 // IntegrationList contains a list of Integration.
@@ -289,6 +372,20 @@ const (
 	IntegrationConditionKameletsNotAvailableReason string = "KameletsNotAvailable"
 	// IntegrationConditionImportingKindAvailableReason used (as false) if we're trying to import an unsupported kind.
 	IntegrationConditionImportingKindAvailableReason string = "ImportingKindAvailable"
+	// IntegrationConditionImageSignatureVerified reports the verification of the signature of the Integration image.
+	IntegrationConditionImageSignatureVerified IntegrationConditionType = "ImageSignatureVerified"
+	// IntegrationConditionImageSignatureVerifiedReason --.
+	IntegrationConditionImageSignatureVerifiedReason string = "ImageSignatureVerified"
+	// IntegrationConditionImageSignatureVerificationFailedReason --.
+	IntegrationConditionImageSignatureVerificationFailedReason string = "ImageSignatureVerificationFailed"
+	// IntegrationConditionSleeping reports whether the Integration is scaled down by its sleep schedules.
+	IntegrationConditionSleeping IntegrationConditionType = "Sleeping"
+	// IntegrationConditionSleepScheduledReason --.
+	IntegrationConditionSleepScheduledReason string = "SleepScheduled"
+	// IntegrationConditionAwakeScheduledReason --.
+	IntegrationConditionAwakeScheduledReason string = "AwakeScheduled"
+	// IntegrationConditionWokenUpReason --.
+	IntegrationConditionWokenUpReason string = "WokenUp"
 )
 
 // IntegrationCondition describes the state of a resource at a certain point.
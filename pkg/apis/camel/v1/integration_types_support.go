@@ -35,6 +35,9 @@ import (
 const (
 	// IntegrationLabel is used to tag k8s object created by a given Integration.
 	IntegrationLabel = "camel.apache.org/integration"
+	// IntegrationRevisionLabel is used to tag the revisions of a given Integration, which are kept apart from the
+	// resources tagged with IntegrationLabel, so that they survive the undeployment of the Integration.
+	IntegrationRevisionLabel = "camel.apache.org/revision-of"
 	// IntegrationGenerationLabel is used to check on outdated integration resources that can be removed by garbage collection.
 	IntegrationGenerationLabel = "camel.apache.org/generation"
 	// IntegrationSyntheticLabel is used to tag k8s synthetic Integrations.
@@ -43,6 +46,12 @@ const (
 	IntegrationImportedKindLabel = "camel.apache.org/imported-from-kind"
 	// IntegrationImportedNameLabel specifies from what resource an Integration was imported.
 	IntegrationImportedNameLabel = "camel.apache.org/imported-from-name"
+	// IntegrationRolloutTrackLabel is used to tell apart the Pods of the previous and new versions of an Integration during a rollout.
+	IntegrationRolloutTrackLabel = "camel.apache.org/rollout-track"
+	// IntegrationRolloutTrackStable identifies the Pods of the version of an Integration which is currently serving.
+	IntegrationRolloutTrackStable = "stable"
+	// IntegrationRolloutTrackCanary identifies the Pods of the new version of an Integration being rolled out.
+	IntegrationRolloutTrackCanary = "canary"
 
 	// IntegrationFlowEmbeddedSourceName --.
 	IntegrationFlowEmbeddedSourceName = "camel-k-embedded-flow.yaml"
@@ -75,9 +84,16 @@ func (in *Integration) Initialize() {
 	if in.Spec.Profile != "" {
 		profile = in.Spec.Profile
 	}
+	// The resources observed are kept across versions, and the current recommendation
+	// is the one to be taken into account by the next rollout
+	resources := in.Status.Resources
+	if resources != nil && resources.Recommendation != nil {
+		resources.Applied = resources.Recommendation.DeepCopy()
+	}
 	in.Status = IntegrationStatus{
-		Phase:   IntegrationPhaseInitialization,
-		Profile: profile,
+		Phase:     IntegrationPhaseInitialization,
+		Profile:   profile,
+		Resources: resources,
 	}
 }
 
@@ -319,6 +335,9 @@ func (in *Integration) SetIntegrationKit(kit *IntegrationKit) {
 	if image == "" {
 		image = kit.Spec.Image
 	}
+	if in.Status.Image != image {
+		in.Status.VerifiedImage = ""
+	}
 	in.Status.Image = image
 }
 
@@ -115,6 +115,8 @@ type IntegrationKitStatus struct {
 	Digest string `json:"digest,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// the SBOM and provenance attestations of the kit image
+	Attestations []Attestation `json:"attestations,omitempty"`
 	// failure reason (if any)
 	Failure *Failure `json:"failure,omitempty"`
 	// the runtime version for which this kit was configured
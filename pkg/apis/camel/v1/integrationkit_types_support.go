@@ -128,6 +128,17 @@ func (in *IntegrationKit) HasCapability(capability string) bool {
 	return slices.Contains(in.Spec.Capabilities, capability)
 }
 
+// GetAttestation returns the attestation with the provided type.
+func (in *IntegrationKitStatus) GetAttestation(attestationType AttestationType) *Attestation {
+	for i := range in.Attestations {
+		if in.Attestations[i].Type == attestationType {
+			return &in.Attestations[i]
+		}
+	}
+
+	return nil
+}
+
 // GetCondition returns the condition with the provided type.
 func (in *IntegrationKitStatus) GetCondition(condType IntegrationKitConditionType) *IntegrationKitCondition {
 	for i := range in.Conditions {
@@ -140,6 +140,8 @@ type IntegrationPlatformBuildSpec struct {
 	BaseImage string `json:"baseImage,omitempty"`
 	// the image registry used to push/pull Integration images
 	Registry RegistrySpec `json:"registry,omitempty"`
+	// the keys used to sign the Integration images built by the operator and to verify them before deployment
+	Signing *ImageSigningSpec `json:"signing,omitempty"`
 	// the timeout (in seconds) to use when creating the build tools container image.
 	//
 	// Deprecated: no longer in use
@@ -56,6 +56,7 @@ type IntegrationProfileStatus struct {
 // +kubebuilder:resource:path=integrationprofiles,scope=Namespaced,shortName=ipr,categories=kamel;camel
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The integration profile phase"
 
 // IntegrationProfile is the resource used to apply user defined settings to the Camel K operator behavior.
 // It defines the behavior of all Custom Resources (`IntegrationKit`, `Integration`, `Kamelet`) in the given namespace.
@@ -64,7 +65,7 @@ type IntegrationProfile struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec IntegrationProfileSpec `json:"spec,omitempty"`
-	// Deprecated: no longer in use.
+	// the validation state of the IntegrationProfile
 	Status IntegrationProfileStatus `json:"status,omitempty"`
 }
 
@@ -124,8 +125,33 @@ const (
 	// IntegrationProfileConditionTypeCreated is the condition if the IntegrationProfile has been created.
 	IntegrationProfileConditionTypeCreated IntegrationProfileConditionType = "Created"
 
+	// IntegrationProfileConditionTypeTraitsValid is the condition if the traits of the IntegrationProfile are valid.
+	IntegrationProfileConditionTypeTraitsValid IntegrationProfileConditionType = "TraitsValid"
+	// IntegrationProfileConditionTypeBuildValid is the condition if the build settings of the IntegrationProfile are valid.
+	IntegrationProfileConditionTypeBuildValid IntegrationProfileConditionType = "BuildValid"
+	// IntegrationProfileConditionTypeRegistryAvailable is the condition if the registry of the IntegrationProfile is reachable.
+	IntegrationProfileConditionTypeRegistryAvailable IntegrationProfileConditionType = "RegistryAvailable"
+	// IntegrationProfileConditionTypeInUse is the condition if the IntegrationProfile is referenced by any Integration or Pipe.
+	IntegrationProfileConditionTypeInUse IntegrationProfileConditionType = "InUse"
+
 	// IntegrationProfileConditionCreatedReason represents the reason that the IntegrationProfile is created.
 	IntegrationProfileConditionCreatedReason = "IntegrationProfileCreated"
+	// IntegrationProfileConditionTraitsValidReason represents the reason that the traits of the IntegrationProfile are valid.
+	IntegrationProfileConditionTraitsValidReason = "TraitsValid"
+	// IntegrationProfileConditionTraitsInvalidReason represents the reason that the traits of the IntegrationProfile are invalid.
+	IntegrationProfileConditionTraitsInvalidReason = "TraitsInvalid"
+	// IntegrationProfileConditionBuildValidReason represents the reason that the build settings of the IntegrationProfile are valid.
+	IntegrationProfileConditionBuildValidReason = "BuildValid"
+	// IntegrationProfileConditionBuildInvalidReason represents the reason that the build settings of the IntegrationProfile are invalid.
+	IntegrationProfileConditionBuildInvalidReason = "BuildInvalid"
+	// IntegrationProfileConditionRegistryAvailableReason represents the reason that the registry of the IntegrationProfile is reachable.
+	IntegrationProfileConditionRegistryAvailableReason = "RegistryAvailable"
+	// IntegrationProfileConditionRegistryUnavailableReason represents the reason that the registry of the IntegrationProfile
+	// is not reachable.
+	IntegrationProfileConditionRegistryUnavailableReason = "RegistryUnavailable"
+	// IntegrationProfileConditionInUseReason represents the reason that the IntegrationProfile is referenced by
+	// Integrations or Pipes, or not.
+	IntegrationProfileConditionInUseReason = "IntegrationProfileInUse"
 )
 
 // IntegrationProfileCondition describes the state of a resource at a certain point.
@@ -67,6 +67,7 @@ var (
 // +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.metadata.annotations.camel\.apache\.org\/provider`,description="The Kamelet provider"
 // +kubebuilder:printcolumn:name="Bundled",type=string,JSONPath=`.metadata.labels.camel\.apache\.org\/kamelet\.bundled`,description="The Kamelet bundled"
 // +kubebuilder:printcolumn:name="Camel Version",type=string,JSONPath=`.metadata.annotations.camel\.apache\.org\/catalog\.version`,description="The Camel compatible version"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The Kamelet phase"
 
 // Kamelet is the Schema for the kamelets API.
 type Kamelet struct {
@@ -77,7 +78,6 @@ type Kamelet struct {
 	Spec KameletSpec `json:"spec,omitempty"`
 	// the actual status of the resource
 	// +kubebuilder:default:={phase:"Ready"}
-	// Deprecated no longer in use
 	Status KameletStatus `json:"status,omitempty"`
 }
 
@@ -230,6 +230,12 @@ const (
 	KameletConditionReasonInvalidProperty string = "InvalidProperty"
 	// KameletConditionReasonInvalidTemplate --.
 	KameletConditionReasonInvalidTemplate string = "InvalidTemplate"
+	// KameletConditionReasonInvalidDataType --.
+	KameletConditionReasonInvalidDataType string = "InvalidDataType"
+	// KameletConditionReasonInvalidDependency --.
+	KameletConditionReasonInvalidDependency string = "InvalidDependency"
+	// KameletConditionReasonValid --.
+	KameletConditionReasonValid string = "Valid"
 )
 
 // KameletPhase --.
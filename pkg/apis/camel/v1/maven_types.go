@@ -21,6 +21,7 @@ import (
 	"encoding/xml"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // MavenSpec --.
@@ -51,6 +52,27 @@ type MavenSpec struct {
 	// e.g., `-V,--no-transfer-progress,-Dstyle.color=never`.
 	// See https://maven.apache.org/ref/3.9.14/maven-embedder/cli.html.
 	CLIOptions []string `json:"cliOptions,omitempty"`
+	// The cache of the Maven dependencies, shared across the builds.
+	DependencyCache *MavenDependencyCacheSpec `json:"dependencyCache,omitempty"`
+}
+
+// MavenDependencyCacheSpec configures a content-addressed cache of the Maven dependencies, keyed by
+// the dependencies and the runtime of each build, so that builds resolving the same dependencies can
+// run without downloading them again.
+type MavenDependencyCacheSpec struct {
+	// true if the cache is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+	// The directory where the cache is stored (default `/tmp/camel-k-dependency-cache`).
+	Path string `json:"path,omitempty"`
+	// The PersistentVolumeClaim mounted on the cache directory of the builder Pods, required to share the cache
+	// across the builds when using the `pod` build strategy. The claim is created by the operator if it does not exist.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// The StorageClass used when the operator creates the PersistentVolumeClaim.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// The maximum size of the cache (ie, `10Gi`), beyond which the least recently used entries are evicted.
+	MaxSize string `json:"maxSize,omitempty"`
+	// How long an entry is kept in the cache when it is not used by any build.
+	TTL *metav1.Duration `json:"ttl,omitempty"`
 }
 
 // Repository defines a Maven repository.
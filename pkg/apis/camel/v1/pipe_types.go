@@ -50,7 +50,8 @@ type PipeSpec struct {
 	Integration *IntegrationSpec `json:"integration,omitempty"`
 	// Source is the starting point of the integration defined by this Pipe
 	Source Endpoint `json:"source,omitempty"`
-	// Sink is the destination of the integration defined by this Pipe
+	// Sink is the destination of the integration defined by this Pipe. It is optional when the Pipe
+	// declares a Choice or a Multicast, in which case it is executed after the branches
 	Sink Endpoint `json:"sink,omitempty"`
 	// ErrorHandler is an optional handler called upon an error occurring in the integration
 	ErrorHandler *ErrorHandlerSpec `json:"errorHandler,omitempty"`
@@ -58,6 +59,12 @@ type PipeSpec struct {
 	Traits *Traits `json:"traits,omitempty"`
 	// Steps contains an optional list of intermediate steps that are executed between the Source and the Sink
 	Steps []Endpoint `json:"steps,omitempty"`
+	// Choice is an optional content-based router executed after the Steps: the message is routed to the first
+	// branch whose condition matches, or to the otherwise branch. It cannot be used together with Multicast
+	Choice *PipeChoice `json:"choice,omitempty"`
+	// Multicast is an optional list of branches executed after the Steps: a copy of the message is sent to
+	// every branch. It cannot be used together with Choice
+	Multicast *PipeMulticast `json:"multicast,omitempty"`
 	// Replicas is the number of desired replicas for the Pipe
 	// +kubebuilder:default=1
 	Replicas *int32 `json:"replicas,omitempty"`
@@ -79,6 +86,40 @@ type Endpoint struct {
 	DataTypes map[TypeSlot]DataTypeReference `json:"dataTypes,omitempty"`
 }
 
+// PipeChoice routes the message to the first branch whose condition matches (content-based router).
+type PipeChoice struct {
+	// When is the ordered list of conditional branches
+	When []PipeWhen `json:"when"`
+	// Otherwise is the optional branch executed when no condition matches
+	Otherwise *PipeBranch `json:"otherwise,omitempty"`
+}
+
+// PipeWhen is a branch of a PipeChoice executed when its condition matches.
+type PipeWhen struct {
+	// the branch executed when the condition matches
+	PipeBranch `json:",inline"`
+	// Language is the language of the condition expression (default `simple`)
+	Language string `json:"language,omitempty"`
+	// Expression is the condition evaluated against the message
+	Expression string `json:"expression"`
+}
+
+// PipeMulticast sends a copy of the message to every branch.
+type PipeMulticast struct {
+	// Branches is the list of branches receiving the message
+	Branches []PipeBranch `json:"branches"`
+	// Parallel enables the processing of the branches in parallel
+	Parallel bool `json:"parallel,omitempty"`
+}
+
+// PipeBranch is a sequence of endpoints executed within a Pipe Choice or Multicast.
+type PipeBranch struct {
+	// Steps contains an optional list of steps executed by the branch before its Sink
+	Steps []Endpoint `json:"steps,omitempty"`
+	// Sink is the optional destination of the branch
+	Sink *Endpoint `json:"sink,omitempty"`
+}
+
 // EndpointType represents the type (ie, source or sink).
 type EndpointType string
 
@@ -138,6 +179,8 @@ type PipeConditionType string
 const (
 	// PipeConditionReady --.
 	PipeConditionReady PipeConditionType = "Ready"
+	// PipeConditionKameletsReady reports whether all the Kamelets referenced by the Pipe are ready.
+	PipeConditionKameletsReady PipeConditionType = "KameletsReady"
 	// PipeIntegrationConditionError -- .
 	//
 	// Deprecated: no longer in use.
@@ -199,3 +199,33 @@ func NewPipeList() PipeList {
 		},
 	}
 }
+
+// GetBranches returns the branches declared by the Choice and Multicast of the Pipe, if any.
+func (in *PipeSpec) GetBranches() []*PipeBranch {
+	var branches []*PipeBranch
+	if in.Choice != nil {
+		for i := range in.Choice.When {
+			branches = append(branches, &in.Choice.When[i].PipeBranch)
+		}
+		if in.Choice.Otherwise != nil {
+			branches = append(branches, in.Choice.Otherwise)
+		}
+	}
+	if in.Multicast != nil {
+		for i := range in.Multicast.Branches {
+			branches = append(branches, &in.Multicast.Branches[i])
+		}
+	}
+
+	return branches
+}
+
+// HasBranches returns true if the Pipe declares a Choice or a Multicast.
+func (in *PipeSpec) HasBranches() bool {
+	return in.Choice != nil || in.Multicast != nil
+}
+
+// IsEmpty returns true if neither a Ref nor a URI is set on the Endpoint.
+func (e *Endpoint) IsEmpty() bool {
+	return e.Ref == nil && e.URI == nil
+}
@@ -17,9 +17,15 @@ limitations under the License.
 
 package trait
 
+import corev1 "k8s.io/api/core/v1"
+
 // Allows constraining which nodes the integration pod(s) are eligible to be scheduled on, based on labels on the node,
 // or with inter-pod affinity and anti-affinity, based on labels on pods that are already running on the nodes.
 //
+// It can also spread the integration pod(s) across the zones, or the nodes, of the cluster, and select their priority class.
+// When the pdb trait is enabled as well, the disruption budget defaults to the spread maximum skew, so that
+// a voluntary disruption does not unbalance the integration pod(s) more than the spread allows.
+//
 // It's disabled by default.
 //
 // +camel-k:trait=affinity.
@@ -40,4 +46,18 @@ type AffinityTrait struct {
 	// Defines a set of pods (namely those matching the label selector, relative to the given namespace) that the
 	// integration pod(s) should not be co-located with.
 	PodAntiAffinityLabels []string `json:"podAntiAffinityLabels,omitempty" property:"pod-anti-affinity-labels"`
+	// Spreads the integration pod(s) across the zones of the cluster, as defined by the `topology.kubernetes.io/zone`
+	// node label (default `false`).
+	ZoneSpread *bool `json:"zoneSpread,omitempty" property:"zone-spread"`
+	// Spreads the integration pod(s) across the nodes of the cluster, as defined by the `kubernetes.io/hostname`
+	// node label (default `false`).
+	HostSpread *bool `json:"hostSpread,omitempty" property:"host-spread"`
+	// The maximum difference between the number of integration pods of any two zones, or nodes, when spreading them (default `1`).
+	MaxSkew *int32 `json:"maxSkew,omitempty" property:"max-skew"`
+	// How to deal with a pod that doesn't satisfy the spread constraints: either `DoNotSchedule` (default), to leave it pending,
+	// or `ScheduleAnyway`, to schedule it while minimizing the skew.
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	WhenUnsatisfiable corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty" property:"when-unsatisfiable"`
+	// The name of the PriorityClass of the integration pod(s).
+	PriorityClassName string `json:"priorityClassName,omitempty" property:"priority-class-name"`
 }
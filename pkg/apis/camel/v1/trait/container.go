@@ -39,6 +39,10 @@ type ContainerTrait struct {
 	LimitCPU string `json:"limitCPU,omitempty" property:"limit-cpu"`
 	// The maximum amount of memory to be provided (default 512 Mi).
 	LimitMemory string `json:"limitMemory,omitempty" property:"limit-memory"`
+	// Set the requests and limits to the values recommended from the resource usage observed by the operator
+	// (see the Integration `.status.resources` field), in place of the configured ones (default `false`).
+	// The recommendation is taken into account the next time the Integration is rolled out.
+	ApplyRecommendations *bool `json:"applyRecommendations,omitempty" property:"apply-recommendations"`
 	// List of container ports available in the container (syntax: <port-name>;<port-number>[;port-protocol]).
 	// When omitted, `port-protocol` (admitted values `TCP`, `UDP` or `SCTP`) is `TCP`.
 	// Don't use this for the primary http managed port (for which case you need to use `portName` and `port`).
@@ -20,6 +20,9 @@ package trait
 // The deployer trait is responsible for deploying the resources owned by the integration, and can be used
 // to explicitly select the underlying controller that will manage the integration pods.
 //
+// It also records a revision of the integration each time a new version is deployed, so that it can be
+// rolled back to a previous revision with `kamel rollback`.
+//
 // +camel-k:trait=deployer.
 //
 //nolint:godoclint
@@ -34,4 +37,7 @@ type DeployerTrait struct {
 	Kind string `json:"kind,omitempty" property:"kind"`
 	// Deprecated: no longer in use.
 	UseSSA *bool `json:"useSSA,omitempty" property:"use-ssa"`
+	// The number of old revisions of the Integration to retain, so that it can be rolled back
+	// with `kamel rollback` without being rebuilt. Set it to `0` to disable the revision history (default `10`).
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" property:"revision-history-limit"`
 }
@@ -21,6 +21,13 @@ package trait
 // to the outside world with a Kubernetes Gateway API. The trait is in charge to automatically discover associate the
 // Integration Service generated with a Gateway and an HTTPRoute resource (HTTP/HTTPS protocol only supported).
 //
+// By default, the trait creates a Gateway dedicated to the Integration. It can instead attach the HTTPRoute
+// to an existing Gateway shared by several applications, using the `gateway` property.
+//
+// By default, the HTTPRoute matches all the paths. It can instead only match explicit `paths`, or, with `auto`, the paths
+// exposed by the Integration, as discovered from the REST DSL and the `platform-http` consumers of the sources.
+// Header matches, request and response header filters, and timeouts can be configured on the generated rules.
+//
 // NOTE: if any other protocol is required, please create a request in order to develop it.
 //
 // +camel-k:trait=gateway.
@@ -33,4 +40,35 @@ type GatewayTrait struct {
 	ClassName string `json:"className,omitempty" property:"class-name"`
 	// The listeners in the format "port;protocol" (default, "8080;HTTP").
 	Listeners []string `json:"listeners,omitempty" property:"listeners"`
+	// The TLS certificates Secrets, in the format "[namespace/]name", used by the HTTPS listeners of the Gateway.
+	// Required when an HTTPS listener is configured.
+	TLSCertificates []string `json:"tlsCertificates,omitempty" property:"tls-certificates"`
+	// The existing Gateway, in the format "[namespace/]name", the HTTPRoute is attached to.
+	// When set, no Gateway is created, and the `class-name`, `listeners` and `tls-certificates` properties are ignored.
+	Gateway string `json:"gateway,omitempty" property:"gateway"`
+	// The name of the listener of the Gateway the HTTPRoute is attached to (default, all the listeners).
+	SectionName string `json:"sectionName,omitempty" property:"section-name"`
+	// The hostnames matched by the HTTPRoute.
+	Hostnames []string `json:"hostnames,omitempty" property:"hostnames"`
+	// To only match the paths exposed by the REST DSL and the `platform-http` consumers of the sources,
+	// and the paths of the OpenAPI specs, rather than all the paths (default `false`).
+	Auto *bool `json:"auto,omitempty" property:"auto"`
+	// The paths matched by the HTTPRoute, in the format "[METHOD ]path", e.g., "GET /orders".
+	// Path parameters, such as `/orders/{id}`, and trailing wildcards, such as `/orders/*`, are matched by prefix.
+	// Overrides the discovered paths, the paths of the OpenAPI specs being matched as well.
+	Paths []string `json:"paths,omitempty" property:"paths"`
+	// The request headers matched by the HTTPRoute, in the format "name=value".
+	Headers []string `json:"headers,omitempty" property:"headers"`
+	// The headers set on the request before it is forwarded to the Integration, in the format "name=value".
+	RequestHeaders []string `json:"requestHeaders,omitempty" property:"request-headers"`
+	// The names of the headers removed from the request before it is forwarded to the Integration.
+	RemoveRequestHeaders []string `json:"removeRequestHeaders,omitempty" property:"remove-request-headers"`
+	// The headers set on the response before it is returned to the client, in the format "name=value".
+	ResponseHeaders []string `json:"responseHeaders,omitempty" property:"response-headers"`
+	// The names of the headers removed from the response before it is returned to the client.
+	RemoveResponseHeaders []string `json:"removeResponseHeaders,omitempty" property:"remove-response-headers"`
+	// The timeout for the Gateway to respond to the client, as a Gateway API duration, e.g., "30s" or "1m30s".
+	RequestTimeout string `json:"requestTimeout,omitempty" property:"request-timeout"`
+	// The timeout of a single request from the Gateway to the Integration, as a Gateway API duration, e.g., "10s".
+	BackendRequestTimeout string `json:"backendRequestTimeout,omitempty" property:"backend-request-timeout"`
 }
@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HPA trait configures a Kubernetes HorizontalPodAutoscaler to scale the Integration on CPU, memory
+// or custom metrics, without requiring KEDA or Knative to be installed.
+//
+// The autoscaler targets the scale subresource of the Integration (or of the Pipe owning it), so the replicas
+// decided by the autoscaler are stored in the Integration `replicas` field and propagated to the Deployment
+// by the operator. When the Integration `replicas` field is not set, it is initialized to the minimum number of
+// replicas. Setting it to `0` suspends the autoscaling.
+//
+// NOTE: this trait only applies to Integrations deployed as a Kubernetes Deployment, and it cannot be enabled
+// together with the `keda` trait.
+//
+// +camel-k:trait=hpa.
+//
+//nolint:godoclint
+type HPATrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The minimum number of replicas (default `1`).
+	MinReplicas *int32 `json:"minReplicas,omitempty" property:"min-replicas"`
+	// The maximum number of replicas (default `10`).
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" property:"max-replicas"`
+	// The target average CPU utilization, as a percentage of the requested CPU
+	// (default `80` when no other metric is configured).
+	CPUUtilization *int32 `json:"cpuUtilization,omitempty" property:"cpu-utilization"`
+	// The target average memory utilization, as a percentage of the requested memory.
+	MemoryUtilization *int32 `json:"memoryUtilization,omitempty" property:"memory-utilization"`
+	// Custom metrics, served by the custom metrics API (e.g., by the Prometheus Adapter), in the format
+	// `<metric-name>=<target average value per pod>`, e.g., `camel_exchanges_inflight=10`.
+	Metrics []string `json:"metrics,omitempty" property:"metrics"`
+	// The number of seconds the autoscaler waits, after the metrics decrease, before scaling down
+	// (the Kubernetes default is `300`).
+	ScaleDownStabilizationWindowSeconds *int32 `json:"scaleDownStabilizationWindowSeconds,omitempty" property:"scale-down-stabilization-window-seconds"`
+}
@@ -29,6 +29,7 @@ type MountTrait struct {
 	// The configuration are expected to be UTF-8 resources as they are processed by runtime Camel Context and tried to be parsed as property files.
 	// They are also made available on the classpath in order to ease their usage directly from the Route.
 	// Syntax: [configmap|secret]:name[/key], where name represents the resource name and key optionally represents the resource key to be filtered
+	// External secrets can be referenced as well, with syntax <provider>:<path>#<key>, where key also represents the property name
 	Configs []string `json:"configs,omitempty" property:"configs"`
 	// A list of resources (text or binary content) pointing to configmap/secret.
 	// The resources are expected to be any resource type (text or binary content).
@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The NetworkPolicy trait generates a Kubernetes NetworkPolicy for the Integration pods, so that the Integration
+// can run in namespaces where all the traffic is denied by default.
+//
+// The ingress traffic is only allowed on the ports exposed by the Integration container (e.g., the HTTP port,
+// when the routes expose HTTP services). The egress traffic is only allowed towards the endpoints discovered from
+// the Integration routes: the Kubernetes Services (including other Integrations and Pipes), the Strimzi Kafka clusters
+// and, when the routes use Knative, the Knative data plane. Additional namespaces and CIDR blocks can be allowed
+// explicitly, e.g., to reach external systems or the Kubernetes API server.
+//
+// NOTE: the trait requires a network plugin supporting NetworkPolicy resources.
+//
+// +camel-k:trait=network-policy.
+//
+//nolint:godoclint
+type NetworkPolicyTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The namespaces allowed to reach the ports exposed by the Integration (by default, any source is allowed).
+	IngressNamespaces []string `json:"ingressNamespaces,omitempty" property:"ingress-namespaces"`
+	// The CIDR blocks allowed to reach the ports exposed by the Integration (by default, any source is allowed).
+	IngressCIDRs []string `json:"ingressCIDRs,omitempty" property:"ingress-cidrs"`
+	// Additional namespaces the Integration is allowed to reach.
+	EgressNamespaces []string `json:"egressNamespaces,omitempty" property:"egress-namespaces"`
+	// Additional CIDR blocks the Integration is allowed to reach, e.g., `10.0.0.1/32` for the Kubernetes API server.
+	EgressCIDRs []string `json:"egressCIDRs,omitempty" property:"egress-cidrs"`
+	// The namespaces hosting the Knative data plane, allowed when the Integration uses Knative
+	// (default `knative-eventing` and `knative-serving`).
+	KnativeNamespaces []string `json:"knativeNamespaces,omitempty" property:"knative-namespaces"`
+	// Allow the egress traffic towards the cluster DNS (default `true`).
+	AllowDNS *bool `json:"allowDNS,omitempty" property:"allow-dns"`
+}
@@ -17,15 +17,14 @@ limitations under the License.
 
 package trait
 
-// WARNING: The OpenAPI trait was deprecated in version 2.5.0 and is no longer active since version 2.9.0.
+// The OpenAPI trait generates the REST DSL routes of the Integration from OpenAPI specs (contract first).
 //
-// The OpenAPI DSL trait is internally used to allow creating integrations from a OpenAPI specs.
-//
-// WARNING: The Openapi trait is **deprecated** and will removed in future release versions:
-// use Camel REST contract first instead (see Camel core documentation).
+// The specs are provided by ConfigMaps, e.g., with `kamel run --openapi spec.yaml` or `kamel run --openapi configmap:my-spec`.
+// Each operation of a spec is exposed as a REST endpoint, which calls the `direct:<operationId>` endpoint, that the
+// Integration routes are expected to implement. The specs are served by the Integration, under `/openapi/<file name>`,
+// and the exposed paths are wired into the `ingress` and `gateway` traits.
 //
 // +camel-k:trait=openapi.
-// +camel-k:internal.
 //
 //nolint:godoclint
 type OpenAPITrait struct {
@@ -33,4 +32,8 @@ type OpenAPITrait struct {
 
 	// The configmaps holding the spec of the OpenAPI (compatible with > 3.0 spec only).
 	Configmaps []string `json:"configmaps,omitempty" property:"configmaps"`
+	// How to handle the operations of the specs which aren't implemented by any `direct:<operationId>` route:
+	// `fail` to fail the Integration startup (default), `ignore` to skip them, or `mock` to return the examples of the specs.
+	// +kubebuilder:validation:Enum=fail;ignore;mock
+	MissingOperation string `json:"missingOperation,omitempty" property:"missing-operation"`
 }
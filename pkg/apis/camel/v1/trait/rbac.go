@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The RBAC trait creates a dedicated ServiceAccount for the Integration, bound to a Role granting the minimal
+// permissions required by the Camel components used in the Integration routes, so that there is no need to
+// hand-craft a ServiceAccount and pass it with `--service-account`.
+//
+// The rules are derived from the `kubernetes-*` and `openshift-*` endpoints (read-only access for the consumers,
+// and the access required by the `operation` for the producers), and from the resources used by the `master`
+// endpoints for leader election. Additional rules can be granted explicitly. The ServiceAccount, the Role and
+// the RoleBinding are owned by the Integration, and garbage collected by the `gc` trait.
+//
+// NOTE: Kubernetes prevents privilege escalation: the operator can only grant the permissions it holds itself.
+// The derived, or explicit, permissions the operator doesn't hold are left out of the Role, and reported in the
+// trait condition: they must be granted to the Integration ServiceAccount by a cluster administrator.
+//
+// The trait is ignored when the Integration sets its own ServiceAccount. It's disabled by default.
+//
+// +camel-k:trait=rbac.
+//
+//nolint:godoclint
+type RBACTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// Derives the rules from the Camel components used by the Integration (default `true`).
+	Auto *bool `json:"auto,omitempty" property:"auto"`
+	// Additional rules granted to the Integration, in the form `<resource>[.<api-group>]:<verb>[,<verb>...]`,
+	// e.g., `configmaps:get,list` or `deployments.apps:get,patch`.
+	Rules []string `json:"rules,omitempty" property:"rules"`
+}
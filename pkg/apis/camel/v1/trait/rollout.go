@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Rollout trait progressively replaces a running Integration with a new version. When the Integration
+// is re-deployed with a different container image, the previous version keeps serving next to the new one,
+// the traffic is moved to the new version step by step, and the new version is rolled back automatically
+// if it fails to become healthy.
+//
+// The traffic is split by weight when the Integration is exposed by the `route`, `gateway` or `ingress`
+// (NGINX only) traits. The Integration Service only routes the traffic to the previous version during the rollout,
+// and the new version is reachable through the `<service>-canary` Service.
+//
+// NOTE: this trait only applies to Integrations deployed as a Kubernetes Deployment. The Deployments created
+// by former versions of the operator must be recreated for the rollouts to apply.
+//
+// +camel-k:trait=rollout.
+//
+//nolint:godoclint
+type RolloutTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The rollout strategy, either `Canary` (default) or `BlueGreen`.
+	// A `Canary` rollout moves the traffic to the new version following the configured steps,
+	// a `BlueGreen` rollout switches all the traffic at once when the new version is healthy.
+	// +kubebuilder:validation:Enum=Canary;BlueGreen
+	Strategy RolloutStrategy `json:"strategy,omitempty" property:"strategy"`
+	// The percentages of the traffic routed to the new version at each step of a `Canary` rollout
+	// (default `10`, `50`). The new version is promoted once the last step has completed.
+	Steps []int32 `json:"steps,omitempty" property:"steps"`
+	// The time in seconds the new version must stay healthy at each step before
+	// the rollout moves on (default `60`).
+	StepDurationSeconds *int32 `json:"stepDurationSeconds,omitempty" property:"step-duration-seconds"`
+	// The maximum time in seconds the new version has to become healthy at each step before
+	// the rollout is rolled back (default `300`).
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty" property:"progress-deadline-seconds"`
+}
+
+// RolloutStrategy is the strategy used to replace a running Integration with a new version.
+type RolloutStrategy string
+
+const (
+	// RolloutStrategyCanary moves an increasing share of the traffic to the new version.
+	RolloutStrategyCanary RolloutStrategy = "Canary"
+	// RolloutStrategyBlueGreen switches all the traffic to the new version at once.
+	RolloutStrategyBlueGreen RolloutStrategy = "BlueGreen"
+)
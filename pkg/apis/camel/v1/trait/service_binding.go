@@ -17,15 +17,15 @@ limitations under the License.
 
 package trait
 
-// WARNING: This trait is no longer in use.
+// The Service Binding trait binds the Integration to the Secrets following the Service Binding specification
+// (https://servicebinding.io/spec/core/1.0.0/), either directly or through the provisioned services referencing them
+// in their `status.binding.name` field.
 //
-
-// The Service Binding trait allows users to connect to Services in Kubernetes:
-// https://github.com/k8s-service-bindings/spec#service-binding
-// As the specification is still evolving this is subject to change.
+// Each Secret is projected into the `/bindings/<name>` directory of the Integration container, and the
+// `SERVICE_BINDING_ROOT` environment variable is set accordingly. The entries of the well-known binding types
+// (`postgresql`, `kafka` and `amqp`) are also mapped onto the Camel and Quarkus properties of the matching components.
 //
 // +camel-k:trait=service-binding.
-// +camel-k:internal.
 //
 //nolint:godoclint
 type ServiceBindingTrait struct {
@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Sleep trait scales the Integration down to zero replicas during the sleep windows defined by its schedules,
+// and back up when they end, e.g., to save the resources of development and test namespaces out of working hours.
+//
+// The Integration goes to sleep at the times matching the `sleep` schedules, and it wakes up at the times matching
+// the `wake` schedules. The schedules are cron expressions, in the same format as the `cron` trait schedules, that are
+// evaluated in the configured time zone. The state of the schedules is reported by the `Sleeping` condition of the
+// Integration.
+//
+// A sleeping Integration can be woken up on demand with the `kamel wake` command: it then stays awake until its
+// next scheduled sleep.
+//
+// NOTE: this trait only applies to Integrations deployed as a Kubernetes Deployment. It has no effect on Integrations
+// deployed as a Knative Service or as a CronJob, nor on Integrations scaled by KEDA.
+//
+// +camel-k:trait=sleep.
+//
+//nolint:godoclint
+type SleepTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The schedules when the Integration goes to sleep, as cron expressions, e.g., `0 20 * * 1-5`.
+	Sleep []string `json:"sleep,omitempty" property:"sleep"`
+	// The schedules when the Integration wakes up, as cron expressions, e.g., `0 8 * * 1-5`.
+	Wake []string `json:"wake,omitempty" property:"wake"`
+	// The time zone of the schedules, as a name of the IANA Time Zone database, e.g., `Europe/Rome` (default `UTC`).
+	TimeZone *string `json:"timeZone,omitempty" property:"time-zone"`
+}
@@ -19,7 +19,12 @@ package trait
 
 // The Telemetry trait can be used to automatically publish tracing information to an OTLP compatible collector.
 //
-// The trait is able to automatically discover the telemetry OTLP endpoint available in the namespace (supports **Jaerger** in version 1.35+).
+// The trait is able to automatically discover the telemetry OTLP endpoint available in the namespace, among the Services labeled
+// with `camel.apache.org/otlp-endpoint=true`, the collectors of the **OpenTelemetry Operator**, **Grafana Tempo**,
+// and **Jaeger** in version 1.35+.
+//
+// Besides traces, the trait can export metrics and logs to the same endpoint. The export settings, i.e., the protocol,
+// the CA certificate, the metrics and the logs, require a Quarkus runtime 3.15.0 or later, and are ignored otherwise.
 //
 // The Telemetry trait is disabled by default.
 //
@@ -52,4 +57,15 @@ type TelemetryTrait struct {
 	SamplerRatio string `json:"sampler-ratio,omitempty" property:"sampler-ratio"`
 	// The sampler of the telemetry used for tracing is parent based (default "true")
 	SamplerParentBased *bool `json:"sampler-parent-based,omitempty" property:"sampler-parent-based"`
+	// The OTLP protocol used to export the telemetry data, either `grpc` or `http/protobuf`
+	// (automatically discovered along with the endpoint, `grpc` by default)
+	// +kubebuilder:validation:Enum=grpc;http/protobuf
+	Protocol string `json:"protocol,omitempty" property:"protocol"`
+	// Enables the export of metrics to the telemetry endpoint (default "false")
+	Metrics *bool `json:"metrics,omitempty" property:"metrics"`
+	// Enables the export of logs to the telemetry endpoint (default "false")
+	Logs *bool `json:"logs,omitempty" property:"logs"`
+	// Path of the PEM encoded CA certificate used to verify the certificate of a TLS endpoint, e.g., mounted from a Secret
+	// with the `mount` trait (the default truststore is used otherwise)
+	CACert string `json:"caCert,omitempty" property:"ca-cert"`
 }
@@ -39,6 +39,21 @@ func (in *AffinityTrait) DeepCopyInto(out *AffinityTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ZoneSpread != nil {
+		in, out := &in.ZoneSpread, &out.ZoneSpread
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostSpread != nil {
+		in, out := &in.HostSpread, &out.HostSpread
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffinityTrait.
@@ -211,6 +226,11 @@ func (in *ContainerTrait) DeepCopyInto(out *ContainerTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ApplyRecommendations != nil {
+		in, out := &in.ApplyRecommendations, &out.ApplyRecommendations
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]string, len(*in))
@@ -329,6 +349,11 @@ func (in *DeployerTrait) DeepCopyInto(out *DeployerTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerTrait.
@@ -449,6 +474,51 @@ func (in *GatewayTrait) DeepCopyInto(out *GatewayTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TLSCertificates != nil {
+		in, out := &in.TLSCertificates, &out.TLSCertificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Auto != nil {
+		in, out := &in.Auto, &out.Auto
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveRequestHeaders != nil {
+		in, out := &in.RemoveRequestHeaders, &out.RemoveRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveResponseHeaders != nil {
+		in, out := &in.RemoveResponseHeaders, &out.RemoveResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayTrait.
@@ -482,6 +552,52 @@ func (in *GitOpsTrait) DeepCopy() *GitOpsTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPATrait) DeepCopyInto(out *HPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleDownStabilizationWindowSeconds != nil {
+		in, out := &in.ScaleDownStabilizationWindowSeconds, &out.ScaleDownStabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPATrait.
+func (in *HPATrait) DeepCopy() *HPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 	*out = *in
@@ -1114,6 +1230,52 @@ func (in *MountTrait) DeepCopy() *MountTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyTrait) DeepCopyInto(out *NetworkPolicyTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.IngressNamespaces != nil {
+		in, out := &in.IngressNamespaces, &out.IngressNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressCIDRs != nil {
+		in, out := &in.IngressCIDRs, &out.IngressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressNamespaces != nil {
+		in, out := &in.EgressNamespaces, &out.EgressNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KnativeNamespaces != nil {
+		in, out := &in.KnativeNamespaces, &out.KnativeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowDNS != nil {
+		in, out := &in.AllowDNS, &out.AllowDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyTrait.
+func (in *NetworkPolicyTrait) DeepCopy() *NetworkPolicyTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAPITrait) DeepCopyInto(out *OpenAPITrait) {
 	*out = *in
@@ -1346,6 +1508,32 @@ func (in RawMessage) DeepCopy() RawMessage {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACTrait) DeepCopyInto(out *RBACTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Auto != nil {
+		in, out := &in.Auto, &out.Auto
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACTrait.
+func (in *RBACTrait) DeepCopy() *RBACTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryTrait) DeepCopyInto(out *RegistryTrait) {
 	*out = *in
@@ -1362,6 +1550,37 @@ func (in *RegistryTrait) DeepCopy() *RegistryTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTrait) DeepCopyInto(out *RolloutTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.StepDurationSeconds != nil {
+		in, out := &in.StepDurationSeconds, &out.StepDurationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTrait.
+func (in *RolloutTrait) DeepCopy() *RolloutTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTrait) DeepCopyInto(out *RouteTrait) {
 	*out = *in
@@ -1482,6 +1701,37 @@ func (in *ServiceTrait) DeepCopy() *ServiceTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SleepTrait) DeepCopyInto(out *SleepTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Sleep != nil {
+		in, out := &in.Sleep, &out.Sleep
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Wake != nil {
+		in, out := &in.Wake, &out.Wake
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SleepTrait.
+func (in *SleepTrait) DeepCopy() *SleepTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(SleepTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TelemetryTrait) DeepCopyInto(out *TelemetryTrait) {
 	*out = *in
@@ -1496,6 +1746,16 @@ func (in *TelemetryTrait) DeepCopyInto(out *TelemetryTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryTrait.
@@ -62,6 +62,26 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Attestation) DeepCopyInto(out *Attestation) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attestation.
+func (in *Attestation) DeepCopy() *Attestation {
+	if in == nil {
+		return nil
+	}
+	out := new(Attestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseTask) DeepCopyInto(out *BaseTask) {
 	*out = *in
@@ -240,6 +260,13 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]Artifact, len(*in))
 		copy(*out, *in)
 	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]Attestation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Failure != nil {
 		in, out := &in.Failure, &out.Failure
 		*out = new(Failure)
@@ -272,7 +299,7 @@ func (in *BuildStatus) DeepCopy() *BuildStatus {
 func (in *BuildahTask) DeepCopyInto(out *BuildahTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
-	out.PublishTask = in.PublishTask
+	in.PublishTask.DeepCopyInto(&out.PublishTask)
 	if in.Verbose != nil {
 		in, out := &in.Verbose, &out.Verbose
 		*out = new(bool)
@@ -1162,6 +1189,26 @@ func (in *HealthCheckResponse) DeepCopy() *HealthCheckResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSigningSpec) DeepCopyInto(out *ImageSigningSpec) {
+	*out = *in
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSigningSpec.
+func (in *ImageSigningSpec) DeepCopy() *ImageSigningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSigningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integration) DeepCopyInto(out *Integration) {
 	*out = *in
@@ -1344,6 +1391,13 @@ func (in *IntegrationKitStatus) DeepCopyInto(out *IntegrationKitStatus) {
 		*out = make([]Artifact, len(*in))
 		copy(*out, *in)
 	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]Attestation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Failure != nil {
 		in, out := &in.Failure, &out.Failure
 		*out = new(Failure)
@@ -1479,6 +1533,11 @@ func (in *IntegrationPlatformBuildSpec) DeepCopyInto(out *IntegrationPlatformBui
 	*out = *in
 	in.BuildConfiguration.DeepCopyInto(&out.BuildConfiguration)
 	out.Registry = in.Registry
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(ImageSigningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BuildCatalogToolTimeout != nil {
 		in, out := &in.BuildCatalogToolTimeout, &out.BuildCatalogToolTimeout
 		*out = new(metav1.Duration)
@@ -1795,6 +1854,93 @@ func (in *IntegrationProfileStatus) DeepCopy() *IntegrationProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationResourcesRecommendation) DeepCopyInto(out *IntegrationResourcesRecommendation) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	out.RequestCPU = in.RequestCPU.DeepCopy()
+	out.RequestMemory = in.RequestMemory.DeepCopy()
+	out.LimitCPU = in.LimitCPU.DeepCopy()
+	out.LimitMemory = in.LimitMemory.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResourcesRecommendation.
+func (in *IntegrationResourcesRecommendation) DeepCopy() *IntegrationResourcesRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationResourcesRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationResourcesStatus) DeepCopyInto(out *IntegrationResourcesStatus) {
+	*out = *in
+	if in.Recommendation != nil {
+		in, out := &in.Recommendation, &out.Recommendation
+		*out = new(IntegrationResourcesRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(IntegrationResourcesRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResourcesStatus.
+func (in *IntegrationResourcesStatus) DeepCopy() *IntegrationResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRolloutStatus) DeepCopyInto(out *IntegrationRolloutStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRolloutStatus.
+func (in *IntegrationRolloutStatus) DeepCopy() *IntegrationRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationSleepStatus) DeepCopyInto(out *IntegrationSleepStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationSleepStatus.
+func (in *IntegrationSleepStatus) DeepCopy() *IntegrationSleepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationSleepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 	*out = *in
@@ -1924,6 +2070,21 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 		in, out := &in.BuildTimestamp, &out.BuildTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(IntegrationRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(IntegrationResourcesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sleep != nil {
+		in, out := &in.Sleep, &out.Sleep
+		*out = new(IntegrationSleepStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationStatus.
@@ -2079,7 +2240,7 @@ func (in *JSONSchemaProps) DeepCopy() *JSONSchemaProps {
 func (in *JibTask) DeepCopyInto(out *JibTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
-	out.PublishTask = in.PublishTask
+	in.PublishTask.DeepCopyInto(&out.PublishTask)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JibTask.
@@ -2303,7 +2464,7 @@ func (in *KameletStatus) DeepCopy() *KameletStatus {
 func (in *KanikoTask) DeepCopyInto(out *KanikoTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
-	out.PublishTask = in.PublishTask
+	in.PublishTask.DeepCopyInto(&out.PublishTask)
 	if in.Verbose != nil {
 		in, out := &in.Verbose, &out.Verbose
 		*out = new(bool)
@@ -2385,6 +2546,31 @@ func (in *MavenBuildSpec) DeepCopy() *MavenBuildSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenDependencyCacheSpec) DeepCopyInto(out *MavenDependencyCacheSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenDependencyCacheSpec.
+func (in *MavenDependencyCacheSpec) DeepCopy() *MavenDependencyCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenDependencyCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 	*out = *in
@@ -2421,6 +2607,11 @@ func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependencyCache != nil {
+		in, out := &in.DependencyCache, &out.DependencyCache
+		*out = new(MavenDependencyCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenSpec.
@@ -2495,6 +2686,60 @@ func (in *Pipe) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeBranch) DeepCopyInto(out *PipeBranch) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Endpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeBranch.
+func (in *PipeBranch) DeepCopy() *PipeBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeChoice) DeepCopyInto(out *PipeChoice) {
+	*out = *in
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = make([]PipeWhen, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Otherwise != nil {
+		in, out := &in.Otherwise, &out.Otherwise
+		*out = new(PipeBranch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeChoice.
+func (in *PipeChoice) DeepCopy() *PipeChoice {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeChoice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipeCondition) DeepCopyInto(out *PipeCondition) {
 	*out = *in
@@ -2551,6 +2796,28 @@ func (in *PipeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeMulticast) DeepCopyInto(out *PipeMulticast) {
+	*out = *in
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]PipeBranch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeMulticast.
+func (in *PipeMulticast) DeepCopy() *PipeMulticast {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeMulticast)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipeSpec) DeepCopyInto(out *PipeSpec) {
 	*out = *in
@@ -2578,6 +2845,16 @@ func (in *PipeSpec) DeepCopyInto(out *PipeSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Choice != nil {
+		in, out := &in.Choice, &out.Choice
+		*out = new(PipeChoice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Multicast != nil {
+		in, out := &in.Multicast, &out.Multicast
+		*out = new(PipeMulticast)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -2627,6 +2904,22 @@ func (in *PipeStatus) DeepCopy() *PipeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeWhen) DeepCopyInto(out *PipeWhen) {
+	*out = *in
+	in.PipeBranch.DeepCopyInto(&out.PipeBranch)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeWhen.
+func (in *PipeWhen) DeepCopy() *PipeWhen {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeWhen)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfiguration) DeepCopyInto(out *PluginConfiguration) {
 	*out = *in
@@ -2860,6 +3153,11 @@ func (in Properties) DeepCopy() Properties {
 func (in *PublishTask) DeepCopyInto(out *PublishTask) {
 	*out = *in
 	out.Registry = in.Registry
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(ImageSigningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishTask.
@@ -2976,7 +3274,7 @@ func (in *RuntimeSpec) DeepCopy() *RuntimeSpec {
 func (in *S2iTask) DeepCopyInto(out *S2iTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
-	out.PublishTask = in.PublishTask
+	in.PublishTask.DeepCopyInto(&out.PublishTask)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S2iTask.
@@ -3042,7 +3340,7 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 func (in *SpectrumTask) DeepCopyInto(out *SpectrumTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
-	out.PublishTask = in.PublishTask
+	in.PublishTask.DeepCopyInto(&out.PublishTask)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpectrumTask.
@@ -3261,6 +3559,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.HealthTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(trait.HPATrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(trait.IngressTrait)
@@ -3321,6 +3624,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.MountTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(trait.NetworkPolicyTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OpenAPI != nil {
 		in, out := &in.OpenAPI, &out.OpenAPI
 		*out = new(trait.OpenAPITrait)
@@ -3361,11 +3669,21 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.QuarkusTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = new(trait.RBACTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Registry != nil {
 		in, out := &in.Registry, &out.Registry
 		*out = new(trait.RegistryTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(trait.RolloutTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Route != nil {
 		in, out := &in.Route, &out.Route
 		*out = new(trait.RouteTrait)
@@ -3386,6 +3704,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.ServiceBindingTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sleep != nil {
+		in, out := &in.Sleep, &out.Sleep
+		*out = new(trait.SleepTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Telemetry != nil {
 		in, out := &in.Telemetry, &out.Telemetry
 		*out = new(trait.TelemetryTrait)
@@ -0,0 +1,472 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/google/uuid"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+)
+
+const (
+	// SBOMMediaType is the media type of the CycloneDX SBOM documents.
+	SBOMMediaType = "application/vnd.cyclonedx+json"
+	// ProvenanceMediaType is the media type of the in-toto provenance statements.
+	ProvenanceMediaType = "application/vnd.in-toto+json"
+
+	cycloneDXSpecVersion        = "1.5"
+	inTotoStatementType         = "https://in-toto.io/Statement/v1"
+	slsaProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	camelKBuildType             = "https://camel.apache.org/camel-k/build/v1"
+	camelKBuilderID             = "https://camel.apache.org/camel-k/operator"
+	camelKTargetProperty        = "camel.apache.org/target"
+)
+
+// jarVersionRegexp splits the name and the version of a dependency file, e.g. org.apache.camel.camel-core-4.0.0.jar.
+var jarVersionRegexp = regexp.MustCompile(`^(.+?)-(\d[^/]*)\.jar$`)
+
+// AttestationDocument is an attestation of an image, along with the content of its document.
+type AttestationDocument struct {
+	v1.Attestation
+
+	Content []byte
+}
+
+// GenerateAttestations generates the SBOM and the provenance statement of the image produced by the Build.
+// The documents only depend on the Build, so that they can be generated again, with the same digests,
+// once the Build has completed.
+func GenerateAttestations(build *v1.Build, image string, digest string) ([]AttestationDocument, error) {
+	sbom, err := json.Marshal(newSBOM(build, image, digest))
+	if err != nil {
+		return nil, err
+	}
+	provenance, err := json.Marshal(newProvenance(build, image, digest))
+	if err != nil {
+		return nil, err
+	}
+
+	return []AttestationDocument{
+		newAttestationDocument(v1.AttestationTypeSBOM, SBOMMediaType, sbom),
+		newAttestationDocument(v1.AttestationTypeProvenance, ProvenanceMediaType, provenance),
+	}, nil
+}
+
+// attachAttestations pushes the attestation documents to the registry, as OCI artifacts referring to the image
+// (see the OCI distribution specification referrers API). The Image of each attestation is set accordingly.
+func attachAttestations(ctx context.Context, image string, digest string, insecure bool, docs []AttestationDocument) error {
+	var nameOptions []name.Option
+	if insecure {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	remoteOptions := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(authn.DefaultKeychain),
+	}
+
+	subjectRef, err := name.NewDigest(imageRepository(image)+"@"+digest, nameOptions...)
+	if err != nil {
+		return err
+	}
+	subject, err := remote.Head(subjectRef, remoteOptions...)
+	if err != nil {
+		return fmt.Errorf("cannot resolve image %s: %w", subjectRef, err)
+	}
+
+	for i := range docs {
+		layer := static.NewLayer(docs[i].Content, types.MediaType(docs[i].MediaType))
+		artifact, err := mutate.AppendLayers(empty.Image, layer)
+		if err != nil {
+			return err
+		}
+		artifact = mutate.MediaType(artifact, types.OCIManifestSchema1)
+		artifact = mutate.ConfigMediaType(artifact, types.MediaType(docs[i].MediaType))
+		artifact, ok := mutate.Subject(artifact, containerv1.Descriptor{
+			MediaType: subject.MediaType,
+			Digest:    subject.Digest,
+			Size:      subject.Size,
+		}).(containerv1.Image)
+		if !ok {
+			return fmt.Errorf("cannot create %s attestation for image %s", docs[i].Type, subjectRef)
+		}
+		artifactDigest, err := artifact.Digest()
+		if err != nil {
+			return err
+		}
+		ref := subjectRef.Context().Digest(artifactDigest.String())
+		if err := remote.Write(ref, artifact, remoteOptions...); err != nil {
+			return fmt.Errorf("cannot push %s attestation for image %s: %w", docs[i].Type, subjectRef, err)
+		}
+		docs[i].Image = ref.String()
+	}
+
+	return nil
+}
+
+func newAttestationDocument(attestationType v1.AttestationType, mediaType string, content []byte) AttestationDocument {
+	sum := sha256.Sum256(content)
+
+	return AttestationDocument{
+		Attestation: v1.Attestation{
+			Type:      attestationType,
+			MediaType: mediaType,
+			Digest:    "sha256:" + hex.EncodeToString(sum[:]),
+		},
+		Content: content,
+	}
+}
+
+// CycloneDX document model (only the subset of the specification used by the operator).
+type cycloneDXBOM struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp,omitempty"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Hashes     []cycloneDXHash     `json:"hashes,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// newSBOM lists the artifacts added by the Build on top of the base image, together with the base image itself.
+func newSBOM(build *v1.Build, image string, digest string) cycloneDXBOM {
+	components := make([]cycloneDXComponent, 0, len(build.Status.Artifacts)+1)
+	if build.Status.BaseImage != "" {
+		components = append(components, imageComponent(build.Status.BaseImage, imageDigest(build.Status.BaseImage)))
+	}
+	for _, artifact := range build.Status.Artifacts {
+		components = append(components, artifactComponent(artifact))
+	}
+
+	return cycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cycloneDXSpecVersion,
+		SerialNumber: "urn:uuid:" + uuid.NewSHA1(uuid.NameSpaceURL, []byte(image+"@"+digest)).String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: buildStartTime(build),
+			Tools: cycloneDXTools{
+				Components: []cycloneDXComponent{
+					{Type: "application", Name: "camel-k", Version: defaults.Version},
+				},
+			},
+			Component: imageComponent(image, digest),
+		},
+		Components: components,
+	}
+}
+
+func imageComponent(image string, digest string) cycloneDXComponent {
+	component := cycloneDXComponent{
+		Type:    "container",
+		BOMRef:  image,
+		Name:    imageRepository(image),
+		Version: digest,
+	}
+	if algorithm, hash, ok := strings.Cut(digest, ":"); ok && algorithm == "sha256" {
+		component.Hashes = []cycloneDXHash{{Algorithm: "SHA-256", Content: hash}}
+	}
+
+	return component
+}
+
+func artifactComponent(artifact v1.Artifact) cycloneDXComponent {
+	component := cycloneDXComponent{
+		Type:   "file",
+		BOMRef: artifact.Target,
+		Name:   artifact.ID,
+	}
+	if strings.HasSuffix(artifact.ID, ".jar") {
+		component.Type = "library"
+		if match := jarVersionRegexp.FindStringSubmatch(artifact.ID); match != nil {
+			component.Name = match[1]
+			component.Version = match[2]
+		} else {
+			component.Name = strings.TrimSuffix(artifact.ID, ".jar")
+		}
+	}
+	if algorithm, hash, ok := strings.Cut(artifact.Checksum, ":"); ok && algorithm == "sha1" {
+		component.Hashes = []cycloneDXHash{{Algorithm: "SHA-1", Content: hash}}
+	}
+	if artifact.Target != "" {
+		component.Properties = []cycloneDXProperty{{Name: camelKTargetProperty, Value: artifact.Target}}
+	}
+
+	return component
+}
+
+// in-toto statement with a SLSA provenance (v1) predicate.
+type inTotoStatement struct {
+	Type          string         `json:"_type"`
+	Subject       []resourceDesc `json:"subject"`
+	PredicateType string         `json:"predicateType"`
+	Predicate     slsaProvenance `json:"predicate"`
+}
+
+type resourceDesc struct {
+	Name        string            `json:"name,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type slsaProvenance struct {
+	BuildDefinition slsaBuildDefinition `json:"buildDefinition"`
+	RunDetails      slsaRunDetails      `json:"runDetails"`
+}
+
+type slsaBuildDefinition struct {
+	BuildType            string         `json:"buildType"`
+	ExternalParameters   map[string]any `json:"externalParameters"`
+	InternalParameters   map[string]any `json:"internalParameters,omitempty"`
+	ResolvedDependencies []resourceDesc `json:"resolvedDependencies,omitempty"`
+}
+
+type slsaRunDetails struct {
+	Builder  slsaBuilder       `json:"builder"`
+	Metadata slsaBuildMetadata `json:"metadata"`
+}
+
+type slsaBuilder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type slsaBuildMetadata struct {
+	InvocationID string `json:"invocationId"`
+	StartedOn    string `json:"startedOn,omitempty"`
+}
+
+// newProvenance describes the inputs of the Build (sources, base image, runtime) and the steps executed.
+func newProvenance(build *v1.Build, image string, digest string) inTotoStatement {
+	subject := resourceDesc{Name: imageRepository(image)}
+	if algorithm, hash, ok := strings.Cut(digest, ":"); ok {
+		subject.Digest = map[string]string{algorithm: hash}
+	}
+
+	externalParameters := make(map[string]any)
+	internalParameters := make(map[string]any)
+	resolvedDependencies := make([]resourceDesc, 0)
+	if build.Status.BaseImage != "" {
+		resolvedDependencies = append(resolvedDependencies, imageResourceDesc(build.Status.BaseImage))
+	}
+	if build.Status.RootImage != "" && build.Status.RootImage != build.Status.BaseImage {
+		resolvedDependencies = append(resolvedDependencies, imageResourceDesc(build.Status.RootImage))
+	}
+
+	tasks := make([]string, 0, len(build.Spec.Tasks))
+	for _, task := range build.Spec.Tasks {
+		if name := taskName(task); name != "" {
+			tasks = append(tasks, name)
+		}
+	}
+	internalParameters["tasks"] = tasks
+
+	if task := builderTaskOf(build); task != nil {
+		externalParameters["runtime"] = map[string]string{
+			"version":  task.Runtime.Version,
+			"provider": string(task.Runtime.Provider),
+		}
+		if len(task.Dependencies) > 0 {
+			externalParameters["dependencies"] = task.Dependencies
+		}
+		if len(task.Sources) > 0 {
+			externalParameters["sources"] = resourceDesc{
+				Digest: map[string]string{"sha256": sourcesDigest(task.Sources)},
+			}
+		}
+		if task.Git != nil {
+			git := resourceDesc{URI: "git+" + task.Git.URL}
+			if task.Git.Commit != "" {
+				git.Digest = map[string]string{"gitCommit": task.Git.Commit}
+			}
+			annotations := make(map[string]string)
+			if task.Git.Branch != "" {
+				annotations["branch"] = task.Git.Branch
+			}
+			if task.Git.Tag != "" {
+				annotations["tag"] = task.Git.Tag
+			}
+			if task.Git.Path != "" {
+				annotations["path"] = task.Git.Path
+			}
+			if len(annotations) > 0 {
+				git.Annotations = annotations
+			}
+			resolvedDependencies = append(resolvedDependencies, git)
+		}
+		internalParameters["steps"] = task.Steps
+	}
+
+	return inTotoStatement{
+		Type:          inTotoStatementType,
+		Subject:       []resourceDesc{subject},
+		PredicateType: slsaProvenancePredicateType,
+		Predicate: slsaProvenance{
+			BuildDefinition: slsaBuildDefinition{
+				BuildType:            camelKBuildType,
+				ExternalParameters:   externalParameters,
+				InternalParameters:   internalParameters,
+				ResolvedDependencies: resolvedDependencies,
+			},
+			RunDetails: slsaRunDetails{
+				Builder: slsaBuilder{
+					ID:      camelKBuilderID,
+					Version: map[string]string{"camel-k": defaults.Version},
+				},
+				Metadata: slsaBuildMetadata{
+					InvocationID: fmt.Sprintf("%s/%s", build.Namespace, build.Name),
+					StartedOn:    buildStartTime(build),
+				},
+			},
+		},
+	}
+}
+
+func imageResourceDesc(image string) resourceDesc {
+	desc := resourceDesc{URI: "oci://" + imageRepository(image)}
+	if algorithm, hash, ok := strings.Cut(imageDigest(image), ":"); ok {
+		desc.Digest = map[string]string{algorithm: hash}
+	} else {
+		desc.URI = "oci://" + image
+	}
+
+	return desc
+}
+
+// builderTaskOf returns the task in charge of building the application.
+func builderTaskOf(build *v1.Build) *v1.BuilderTask {
+	for _, task := range build.Spec.Tasks {
+		if task.Builder != nil {
+			return task.Builder
+		}
+	}
+
+	return nil
+}
+
+func taskName(task v1.Task) string {
+	switch {
+	case task.Builder != nil:
+		return task.Builder.Name
+	case task.Custom != nil:
+		return task.Custom.Name
+	case task.Package != nil:
+		return task.Package.Name
+	case task.Jib != nil:
+		return task.Jib.Name
+	case task.S2i != nil: //nolint:staticcheck
+		return task.S2i.Name //nolint:staticcheck
+	case task.Spectrum != nil: //nolint:staticcheck
+		return task.Spectrum.Name //nolint:staticcheck
+	}
+
+	return ""
+}
+
+// sourcesDigest computes a digest of the sources added at build time, independently of their order.
+func sourcesDigest(sources []v1.SourceSpec) string {
+	sorted := slices.Clone(sources)
+	slices.SortFunc(sorted, func(a, b v1.SourceSpec) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	hash := sha256.New()
+	for _, source := range sorted {
+		hash.Write([]byte(source.Name))
+		hash.Write([]byte(source.Content))
+		hash.Write(source.RawContent)
+		hash.Write([]byte(source.ContentRef))
+		hash.Write([]byte(source.ContentKey))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func buildStartTime(build *v1.Build) string {
+	if build.Status.StartedAt == nil {
+		return ""
+	}
+
+	return build.Status.StartedAt.UTC().Format(time.RFC3339)
+}
+
+// imageRepository returns the image name without tag nor digest.
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+
+	return image
+}
+
+// imageDigest returns the digest of an image addressed by digest, or an empty string otherwise.
+func imageDigest(image string) string {
+	if _, digest, ok := strings.Cut(image, "@"); ok {
+		return digest
+	}
+
+	return ""
+}
@@ -0,0 +1,156 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newAttestationTestBuild() *v1.Build {
+	build := &v1.Build{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "kit-123"},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{Builder: &v1.BuilderTask{
+					BaseTask:     v1.BaseTask{Name: "builder"},
+					Runtime:      v1.RuntimeSpec{Version: "3.2.3", Provider: v1.RuntimeProviderQuarkus},
+					Dependencies: []string{"camel:timer", "camel:log"},
+					Sources:      []v1.SourceSpec{{DataSpec: v1.DataSpec{Name: "Route.java", Content: "from(...)"}}},
+					Git:          &v1.GitConfigSpec{URL: "https://github.com/acme/routes.git", Commit: "f00"},
+				}},
+				{Package: &v1.BuilderTask{BaseTask: v1.BaseTask{Name: "package"}}},
+				{Jib: &v1.JibTask{BaseTask: v1.BaseTask{Name: "jib"}}},
+			},
+		},
+	}
+	build.Status.StartedAt = &metav1.Time{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	build.Status.BaseImage = "eclipse-temurin:17@sha256:1234"
+	build.Status.Artifacts = []v1.Artifact{
+		{ID: "org.apache.camel.camel-core-engine-4.4.0.jar", Checksum: "sha1:abcd", Target: "dependencies/lib/main/org.apache.camel.camel-core-engine-4.4.0.jar"},
+		{ID: "quarkus-run.jar", Checksum: "sha1:ef01", Target: "dependencies/quarkus-run.jar"},
+		{ID: "quarkus-application.dat", Target: "dependencies/quarkus/quarkus-application.dat"},
+	}
+
+	return build
+}
+
+func TestGenerateAttestations(t *testing.T) {
+	build := newAttestationTestBuild()
+	docs, err := GenerateAttestations(build, "registry/ns/camel-k-kit-123:1", "sha256:5678")
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+
+	// generation is reproducible
+	again, err := GenerateAttestations(build, "registry/ns/camel-k-kit-123:1", "sha256:5678")
+	require.NoError(t, err)
+	assert.Equal(t, docs, again)
+
+	sbom := docs[0]
+	assert.Equal(t, v1.AttestationTypeSBOM, sbom.Type)
+	assert.Equal(t, SBOMMediaType, sbom.MediaType)
+	assert.True(t, strings.HasPrefix(sbom.Digest, "sha256:"))
+	var bom cycloneDXBOM
+	require.NoError(t, json.Unmarshal(sbom.Content, &bom))
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, "registry/ns/camel-k-kit-123", bom.Metadata.Component.Name)
+	assert.Equal(t, "sha256:5678", bom.Metadata.Component.Version)
+	assert.Equal(t, "2024-01-02T03:04:05Z", bom.Metadata.Timestamp)
+	require.Len(t, bom.Components, 4)
+	assert.Equal(t, "container", bom.Components[0].Type)
+	assert.Equal(t, "eclipse-temurin", bom.Components[0].Name)
+	assert.Equal(t, []cycloneDXHash{{Algorithm: "SHA-256", Content: "1234"}}, bom.Components[0].Hashes)
+	assert.Equal(t, "library", bom.Components[1].Type)
+	assert.Equal(t, "org.apache.camel.camel-core-engine", bom.Components[1].Name)
+	assert.Equal(t, "4.4.0", bom.Components[1].Version)
+	assert.Equal(t, []cycloneDXHash{{Algorithm: "SHA-1", Content: "abcd"}}, bom.Components[1].Hashes)
+	assert.Equal(t, "quarkus-run", bom.Components[2].Name)
+	assert.Empty(t, bom.Components[2].Version)
+	assert.Equal(t, "file", bom.Components[3].Type)
+	assert.Equal(t, "quarkus-application.dat", bom.Components[3].Name)
+	assert.Empty(t, bom.Components[3].Hashes)
+
+	provenance := docs[1]
+	assert.Equal(t, v1.AttestationTypeProvenance, provenance.Type)
+	assert.Equal(t, ProvenanceMediaType, provenance.MediaType)
+	var statement inTotoStatement
+	require.NoError(t, json.Unmarshal(provenance.Content, &statement))
+	assert.Equal(t, []resourceDesc{{Name: "registry/ns/camel-k-kit-123", Digest: map[string]string{"sha256": "5678"}}}, statement.Subject)
+	assert.Equal(t, slsaProvenancePredicateType, statement.PredicateType)
+	assert.Equal(t, "ns/kit-123", statement.Predicate.RunDetails.Metadata.InvocationID)
+	assert.Equal(t, []any{"builder", "package", "jib"}, statement.Predicate.BuildDefinition.InternalParameters["tasks"])
+	assert.Equal(t, map[string]any{"version": "3.2.3", "provider": "quarkus"}, statement.Predicate.BuildDefinition.ExternalParameters["runtime"])
+	assert.Contains(t, statement.Predicate.BuildDefinition.ExternalParameters, "sources")
+	assert.Equal(t, []resourceDesc{
+		{URI: "oci://eclipse-temurin", Digest: map[string]string{"sha256": "1234"}},
+		{URI: "git+https://github.com/acme/routes.git", Digest: map[string]string{"gitCommit": "f00"}},
+	}, statement.Predicate.BuildDefinition.ResolvedDependencies)
+}
+
+func TestAttachAttestations(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	image := strings.TrimPrefix(server.URL, "http://") + "/ns/camel-k-kit-123:1"
+
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	docs, err := GenerateAttestations(newAttestationTestBuild(), image, digest.String())
+	require.NoError(t, err)
+	require.NoError(t, attachAttestations(context.Background(), image, digest.String(), true, docs))
+	for _, doc := range docs {
+		assert.True(t, strings.HasPrefix(doc.Image, ref.Context().String()+"@sha256:"))
+	}
+
+	referrers, err := remote.Referrers(ref.Context().Digest(digest.String()))
+	require.NoError(t, err)
+	manifest, err := referrers.IndexManifest()
+	require.NoError(t, err)
+	require.Len(t, manifest.Manifests, 2)
+	artifactTypes := []string{string(manifest.Manifests[0].ArtifactType), string(manifest.Manifests[1].ArtifactType)}
+	assert.ElementsMatch(t, []string{SBOMMediaType, ProvenanceMediaType}, artifactTypes)
+}
+
+func TestImageRepository(t *testing.T) {
+	assert.Equal(t, "registry:5000/ns/image", imageRepository("registry:5000/ns/image:1.0"))
+	assert.Equal(t, "registry:5000/ns/image", imageRepository("registry:5000/ns/image@sha256:1234"))
+	assert.Equal(t, "registry:5000/ns/image", imageRepository("registry:5000/ns/image"))
+	assert.Equal(t, "sha256:1234", imageDigest("registry:5000/ns/image:1.0@sha256:1234"))
+	assert.Empty(t, imageDigest("registry:5000/ns/image:1.0"))
+}
@@ -117,6 +117,10 @@ steps:
 		return result
 	}
 
+	if c.DependencyCache != nil {
+		result.SetConditions(c.DependencyCache.condition())
+	}
+
 	result.BaseImage = c.BaseImage
 	result.Artifacts = make([]v1.Artifact, 0, len(c.Artifacts))
 	result.Artifacts = append(result.Artifacts, c.Artifacts...)
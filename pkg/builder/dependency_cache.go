@@ -0,0 +1,386 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+const (
+	// DependencyCacheDefaultPath is the directory where the dependency cache is stored when not configured.
+	DependencyCacheDefaultPath = "/tmp/camel-k-dependency-cache"
+
+	dependencyCacheRepositoryDir    = "repository"
+	dependencyCacheSizeFile         = ".size"
+	dependencyCacheLastUsedFile     = ".last-used"
+	dependencyCacheStagingDirPrefix = ".staging-"
+	// staging directories left over by interrupted builds are removed after this period.
+	dependencyCacheStagingTimeout = 24 * time.Hour
+)
+
+// dependencyCache is a content-addressed cache of Maven local repositories. Each entry contains the artifacts
+// resolved by a build, and is identified by a digest of the dependencies and of the runtime of the build, so
+// that any later build requiring the same dependencies can run offline.
+type dependencyCache struct {
+	root    string
+	maxSize int64
+	ttl     time.Duration
+}
+
+// dependencyCacheResult reports how the dependency cache has been used by a build.
+type dependencyCacheResult struct {
+	key string
+	hit bool
+}
+
+// newDependencyCache returns the dependency cache configured by the given spec, or nil if it is not enabled.
+func newDependencyCache(spec *v1.MavenDependencyCacheSpec) (*dependencyCache, error) {
+	if spec == nil || !ptr.Deref(spec.Enabled, false) {
+		return nil, nil
+	}
+
+	c := dependencyCache{
+		root: spec.Path,
+	}
+	if c.root == "" {
+		c.root = DependencyCacheDefaultPath
+	}
+	if spec.MaxSize != "" {
+		size, err := k8sresource.ParseQuantity(spec.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dependency cache max size %s: %w", spec.MaxSize, err)
+		}
+		c.maxSize = size.Value()
+	}
+	if spec.TTL != nil {
+		c.ttl = spec.TTL.Duration
+	}
+
+	return &c, nil
+}
+
+// dependencyCacheKey computes the digest identifying the Maven artifacts required by the given task, resolved
+// with the given Maven settings.
+func dependencyCacheKey(task v1.BuilderTask, settings maven.Context) (string, error) {
+	dependencies := slices.Clone(task.Dependencies)
+	slices.Sort(dependencies)
+
+	content, err := json.Marshal(struct {
+		Runtime          v1.RuntimeSpec    `json:"runtime"`
+		Dependencies     []string          `json:"dependencies,omitempty"`
+		Repositories     []v1.Repository   `json:"repositories,omitempty"`
+		Properties       map[string]string `json:"properties,omitempty"`
+		Profiles         []v1.ValueSource  `json:"profiles,omitempty"`
+		CLIOptions       []string          `json:"cliOptions,omitempty"`
+		GlobalSettings   string            `json:"globalSettings,omitempty"`
+		UserSettings     string            `json:"userSettings,omitempty"`
+		SettingsSecurity string            `json:"settingsSecurity,omitempty"`
+	}{
+		Runtime:          task.Runtime,
+		Dependencies:     dependencies,
+		Repositories:     task.Maven.Repositories,
+		Properties:       task.Maven.Properties,
+		Profiles:         task.Maven.Profiles,
+		CLIOptions:       task.Maven.CLIOptions,
+		GlobalSettings:   contentDigest(settings.GlobalSettings),
+		UserSettings:     contentDigest(settings.UserSettings),
+		SettingsSecurity: contentDigest(settings.SettingsSecurity),
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// contentDigest returns the digest of the given content, if any.
+func contentDigest(content []byte) string {
+	if len(content) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *dependencyCache) entryDir(key string) string {
+	return filepath.Join(c.root, key)
+}
+
+// lookup returns the local repository stored for the given key, if any, and marks it as recently used.
+func (c *dependencyCache) lookup(key string) (string, bool) {
+	dir := c.entryDir(key)
+	if _, err := os.Stat(filepath.Join(dir, dependencyCacheSizeFile)); err != nil {
+		return "", false
+	}
+	now := time.Now()
+	if err := os.Chtimes(filepath.Join(dir, dependencyCacheLastUsedFile), now, now); err != nil {
+		log.Debugf("cannot mark dependency cache entry %s as used: %v", key, err)
+	}
+
+	return filepath.Join(dir, dependencyCacheRepositoryDir), true
+}
+
+// stage creates the directory where the artifacts of a build are resolved before being committed to the cache.
+func (c *dependencyCache) stage(key string) (string, error) {
+	if err := os.MkdirAll(c.root, os.ModePerm); err != nil {
+		return "", err
+	}
+	staging, err := os.MkdirTemp(c.root, dependencyCacheStagingDirPrefix+key+"-")
+	if err != nil {
+		return "", err
+	}
+	if err := os.Mkdir(filepath.Join(staging, dependencyCacheRepositoryDir), os.ModePerm); err != nil {
+		return "", err
+	}
+
+	return staging, nil
+}
+
+// commit atomically moves a staging directory into the cache, then evicts the entries exceeding the cache limits.
+func (c *dependencyCache) commit(key string, staging string) error {
+	size, err := directorySize(staging)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(staging, dependencyCacheSizeFile), []byte(strconv.FormatInt(size, 10)), 0o600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(staging, dependencyCacheLastUsedFile), nil, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(staging, c.entryDir(key)); err != nil {
+		if _, found := c.lookup(key); !found {
+			return err
+		}
+		// A concurrent build has already stored the same entry
+		log.Debugf("dependency cache entry %s already stored", key)
+		if err := os.RemoveAll(staging); err != nil {
+			return err
+		}
+	}
+
+	return c.evict(time.Now(), key)
+}
+
+// remove deletes an entry from the cache.
+func (c *dependencyCache) remove(key string) error {
+	return os.RemoveAll(c.entryDir(key))
+}
+
+type dependencyCacheEntry struct {
+	key      string
+	size     int64
+	lastUsed time.Time
+}
+
+// evict removes the entries which have not been used for longer than the TTL, and the least recently used
+// entries until the cache fits into its maximum size. The entry identified by keep is never evicted.
+func (c *dependencyCache) evict(now time.Time, keep string) error {
+	files, err := os.ReadDir(c.root)
+	if err != nil {
+		return err
+	}
+
+	var entries []dependencyCacheEntry
+	var total int64
+	for _, file := range files {
+		path := filepath.Join(c.root, file.Name())
+		if strings.HasPrefix(file.Name(), dependencyCacheStagingDirPrefix) {
+			if info, err := file.Info(); err == nil && now.Sub(info.ModTime()) > dependencyCacheStagingTimeout {
+				log.Debugf("removing stale dependency cache staging directory %s", file.Name())
+				_ = os.RemoveAll(path)
+			}
+
+			continue
+		}
+		entry, ok := readDependencyCacheEntry(path)
+		if !ok || entry.key == keep {
+			total += entry.size
+
+			continue
+		}
+		if c.ttl > 0 && now.Sub(entry.lastUsed) > c.ttl {
+			log.Infof("evicting dependency cache entry %s, unused since %s", entry.key, entry.lastUsed)
+			if err := c.remove(entry.key); err != nil {
+				return err
+			}
+
+			continue
+		}
+		entries = append(entries, entry)
+		total += entry.size
+	}
+
+	if c.maxSize <= 0 {
+		return nil
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+	for _, entry := range entries {
+		if total <= c.maxSize {
+			break
+		}
+		log.Infof("evicting dependency cache entry %s, the cache exceeds its maximum size", entry.key)
+		if err := c.remove(entry.key); err != nil {
+			return err
+		}
+		total -= entry.size
+	}
+
+	return nil
+}
+
+func readDependencyCacheEntry(dir string) (dependencyCacheEntry, bool) {
+	entry := dependencyCacheEntry{
+		key: filepath.Base(dir),
+	}
+	content, err := os.ReadFile(filepath.Join(dir, dependencyCacheSizeFile))
+	if err != nil {
+		return entry, false
+	}
+	if entry.size, err = strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64); err != nil {
+		return entry, false
+	}
+	info, err := os.Stat(filepath.Join(dir, dependencyCacheLastUsedFile))
+	if err != nil {
+		return entry, false
+	}
+	entry.lastUsed = info.ModTime()
+
+	return entry, true
+}
+
+// build runs the Maven build offline against the local repository cached for the build dependencies. When the
+// cache has no such entry, or when it cannot be used, the dependencies are resolved into a new entry instead.
+func (c *dependencyCache) build(ctx *builderContext, mc maven.Context) error {
+	key, err := dependencyCacheKey(ctx.Build, mc)
+	if err != nil {
+		return err
+	}
+
+	if repository, found := c.lookup(key); found {
+		err := runMavenBuild(ctx, mc, repository, true)
+		if err == nil {
+			ctx.DependencyCache = &dependencyCacheResult{key: key, hit: true}
+
+			return nil
+		}
+		if ctx.C.Err() != nil {
+			return err
+		}
+		log.Infof("build with cached dependencies %s failed, resolving the dependencies again: %v", key, err)
+		if err := c.remove(key); err != nil {
+			return err
+		}
+	}
+
+	ctx.DependencyCache = &dependencyCacheResult{key: key, hit: false}
+	staging, err := c.stage(key)
+	if err != nil {
+		return err
+	}
+	// Only effective when the staging directory has not been committed
+	defer os.RemoveAll(staging)
+
+	if err := runMavenBuild(ctx, mc, filepath.Join(staging, dependencyCacheRepositoryDir), false); err != nil {
+		return err
+	}
+	if err := c.commit(key, staging); err != nil {
+		// The cache is an optimization, it must not fail the build
+		log.Errorf(err, "cannot store the dependency cache entry %s", key)
+	}
+
+	return nil
+}
+
+// runMavenBuild builds the project using the given local repository.
+func runMavenBuild(ctx *builderContext, mc maven.Context, repository string, offline bool) error {
+	mc.LocalRepository = repository
+	mc.AdditionalArguments = slices.Clone(mc.AdditionalArguments)
+	if offline {
+		mc.AddArgument("--offline")
+	}
+	// Regenerate the Maven configuration, so that it points to the local repository
+	if err := ctx.Maven.Project.Command(mc).DoSettings(ctx.C); err != nil {
+		return err
+	}
+
+	return BuildQuarkusRunnerCommon(ctx.C, mc, ctx.Maven.Project, ctx.Build.Maven.Properties)
+}
+
+func (r *dependencyCacheResult) condition() v1.BuildCondition {
+	condition := v1.BuildCondition{
+		Type:    v1.BuildConditionDependencyCache,
+		Status:  corev1.ConditionFalse,
+		Reason:  v1.BuildConditionDependencyCacheMissReason,
+		Message: "dependencies resolved and stored in the cache entry " + r.key,
+	}
+	if r.hit {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = v1.BuildConditionDependencyCacheHitReason
+		condition.Message = "dependencies loaded from the cache entry " + r.key
+	}
+
+	return condition
+}
+
+func directorySize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+
+	return size, err
+}
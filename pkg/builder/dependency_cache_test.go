@@ -0,0 +1,194 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+func TestNewDependencyCache(t *testing.T) {
+	cache, err := newDependencyCache(nil)
+	require.NoError(t, err)
+	assert.Nil(t, cache)
+
+	cache, err = newDependencyCache(&v1.MavenDependencyCacheSpec{MaxSize: "1Gi"})
+	require.NoError(t, err)
+	assert.Nil(t, cache)
+
+	cache, err = newDependencyCache(&v1.MavenDependencyCacheSpec{
+		Enabled: ptr.To(true),
+		MaxSize: "1Gi",
+		TTL:     &metav1.Duration{Duration: time.Hour},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cache)
+	assert.Equal(t, DependencyCacheDefaultPath, cache.root)
+	assert.Equal(t, int64(1024*1024*1024), cache.maxSize)
+	assert.Equal(t, time.Hour, cache.ttl)
+
+	_, err = newDependencyCache(&v1.MavenDependencyCacheSpec{
+		Enabled: ptr.To(true),
+		MaxSize: "a lot",
+	})
+	require.Error(t, err)
+}
+
+func TestDependencyCacheKey(t *testing.T) {
+	task := v1.BuilderTask{
+		Runtime: v1.RuntimeSpec{
+			Version:  "1.0.0",
+			Provider: v1.RuntimeProviderQuarkus,
+		},
+		Dependencies: []string{"camel:log", "camel:timer"},
+	}
+	settings := maven.Context{GlobalSettings: []byte("<settings/>")}
+	key, err := dependencyCacheKey(task, settings)
+	require.NoError(t, err)
+
+	// The order of the dependencies does not matter
+	reordered := task
+	reordered.Dependencies = []string{"camel:timer", "camel:log"}
+	reorderedKey, err := dependencyCacheKey(reordered, settings)
+	require.NoError(t, err)
+	assert.Equal(t, key, reorderedKey)
+
+	other := task
+	other.Dependencies = []string{"camel:log"}
+	otherKey, err := dependencyCacheKey(other, settings)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+
+	other = task
+	other.Runtime.Version = "1.0.1"
+	otherKey, err = dependencyCacheKey(other, settings)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+
+	other = task
+	other.Maven.Properties = map[string]string{"quarkus.version": "1.0.0"}
+	otherKey, err = dependencyCacheKey(other, settings)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+
+	otherKey, err = dependencyCacheKey(task, maven.Context{UserSettings: []byte("<settings/>")})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+}
+
+func newTestDependencyCacheEntry(t *testing.T, cache *dependencyCache, key string, size int) {
+	t.Helper()
+
+	staging, err := cache.stage(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(staging, dependencyCacheRepositoryDir, "artifact.jar"), make([]byte, size), 0o600))
+	require.NoError(t, cache.commit(key, staging))
+}
+
+func TestDependencyCacheCommitAndLookup(t *testing.T) {
+	cache := &dependencyCache{root: t.TempDir()}
+
+	_, found := cache.lookup("key")
+	assert.False(t, found)
+
+	newTestDependencyCacheEntry(t, cache, "key", 100)
+	repository, found := cache.lookup("key")
+	assert.True(t, found)
+	assert.FileExists(t, filepath.Join(repository, "artifact.jar"))
+
+	entry, ok := readDependencyCacheEntry(cache.entryDir("key"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), entry.size)
+
+	// Committing the same entry concurrently is not an error
+	newTestDependencyCacheEntry(t, cache, "key", 100)
+	files, err := os.ReadDir(cache.root)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestDependencyCacheEviction(t *testing.T) {
+	cache := &dependencyCache{root: t.TempDir()}
+	newTestDependencyCacheEntry(t, cache, "old", 100)
+	newTestDependencyCacheEntry(t, cache, "recent", 100)
+	newTestDependencyCacheEntry(t, cache, "current", 100)
+
+	now := time.Now()
+	past := now.Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(cache.entryDir("old"), dependencyCacheLastUsedFile), past, past))
+	past = now.Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(cache.entryDir("recent"), dependencyCacheLastUsedFile), past, past))
+
+	// The least recently used entries are evicted first
+	cache.maxSize = 250
+	require.NoError(t, cache.evict(now, "current"))
+	assert.NoDirExists(t, cache.entryDir("old"))
+	assert.DirExists(t, cache.entryDir("recent"))
+	assert.DirExists(t, cache.entryDir("current"))
+
+	// Entries not used within the TTL are evicted
+	cache.maxSize = 0
+	cache.ttl = 30 * time.Minute
+	require.NoError(t, cache.evict(now, "current"))
+	assert.NoDirExists(t, cache.entryDir("recent"))
+	assert.DirExists(t, cache.entryDir("current"))
+
+	// The entry in use is never evicted
+	cache.maxSize = 1
+	require.NoError(t, cache.evict(now, "current"))
+	assert.DirExists(t, cache.entryDir("current"))
+}
+
+func TestDependencyCacheStaleStaging(t *testing.T) {
+	cache := &dependencyCache{root: t.TempDir()}
+	staging, err := cache.stage("key")
+	require.NoError(t, err)
+
+	require.NoError(t, cache.evict(time.Now(), ""))
+	assert.DirExists(t, staging)
+
+	require.NoError(t, cache.evict(time.Now().Add(2*dependencyCacheStagingTimeout), ""))
+	assert.NoDirExists(t, staging)
+}
+
+func TestDependencyCacheCondition(t *testing.T) {
+	status := v1.BuildStatus{}
+	status.SetConditions((&dependencyCacheResult{key: "key", hit: true}).condition())
+	condition := status.GetCondition(v1.BuildConditionDependencyCache)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.BuildConditionDependencyCacheHitReason, condition.Reason)
+
+	status = v1.BuildStatus{}
+	status.SetConditions((&dependencyCacheResult{key: "key"}).condition())
+	condition = status.GetCondition(v1.BuildConditionDependencyCache)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.BuildConditionDependencyCacheMissReason, condition.Reason)
+}
@@ -26,6 +26,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/util"
@@ -120,6 +123,20 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 			return status.Failed(errDigest)
 		}
 		status.Digest = string(mavenDigest)
+
+		if err := signImage(ctx, t.c, t.build.Namespace, t.task.PublishTask, status.Image, status.Digest,
+			remote.WithAuthFromKeychain(authn.DefaultKeychain)); err != nil {
+			_ = cleanRegistryConfig(registryConfigDir)
+
+			return status.Failed(fmt.Errorf("cannot sign image %s: %w", status.Image, err))
+		}
+
+		// attestations are best effort, a registry not supporting OCI artifacts should not fail the build
+		if attestations, err := t.attest(ctx, status); err != nil {
+			log.Errorf(err, "cannot attach attestations to image %s", status.Image)
+		} else {
+			status.Attestations = attestations
+		}
 	}
 
 	if registryConfigDir != "" {
@@ -131,6 +148,25 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 	return *status
 }
 
+// attest generates the SBOM and provenance of the image and attaches them to the image in the registry.
+func (t *jibTask) attest(ctx context.Context, status *v1.BuildStatus) ([]v1.Attestation, error) {
+	build := t.build.DeepCopy()
+	build.Status.BaseImage = status.BaseImage
+	docs, err := GenerateAttestations(build, status.Image, status.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if err := attachAttestations(ctx, status.Image, status.Digest, t.task.Registry.Insecure, docs); err != nil {
+		return nil, err
+	}
+	attestations := make([]v1.Attestation, 0, len(docs))
+	for _, doc := range docs {
+		attestations = append(attestations, doc.Attestation)
+	}
+
+	return attestations, nil
+}
+
 func cleanRegistryConfig(registryConfigDir string) error {
 	if err := os.Unsetenv(jib.JibRegistryConfigEnvVar); err != nil {
 		return err
@@ -114,7 +114,14 @@ func loadCamelQuarkusCatalog(ctx *builderContext) error {
 		runtime.Version = defaults.CamelKRuntimeCatalogVersion
 	}
 
-	catalog, err := camel.LoadCatalog(ctx.C, ctx.Client, ctx.Namespace, *runtime)
+	var catalog *camel.RuntimeCatalog
+	var err error
+	if ctx.Client == nil {
+		// Builds executed outside of the cluster (ie, kamel run --local) rely on the embedded catalog
+		catalog, err = camel.DefaultCatalog()
+	} else {
+		catalog, err = camel.LoadCatalog(ctx.C, ctx.Client, ctx.Namespace, *runtime)
+	}
 	if err != nil {
 		return err
 	}
@@ -218,6 +225,14 @@ func generateQuarkusProjectCommon(runtimeProvider v1.RuntimeProvider, runtimeVer
 func buildMavenProject(ctx *builderContext) error {
 	mc := newMavenContext(ctx)
 
+	cache, err := newDependencyCache(ctx.Build.Maven.DependencyCache)
+	if err != nil {
+		return err
+	}
+	if cache != nil {
+		return cache.build(ctx, *mc)
+	}
+
 	return BuildQuarkusRunnerCommon(ctx.C, *mc, ctx.Maven.Project, ctx.Build.Maven.Properties)
 }
 
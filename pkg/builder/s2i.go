@@ -222,6 +222,11 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 		return status.Failed(err)
 	}
 
+	if err := signImage(ctx, t.c, t.build.Namespace, t.task.PublishTask, status.Image, status.Digest,
+		serviceAccountRemoteOptions()...); err != nil {
+		return status.Failed(fmt.Errorf("cannot sign image %s: %w", status.Image, err))
+	}
+
 	return *status
 }
 
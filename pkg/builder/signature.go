@@ -0,0 +1,129 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/signature"
+)
+
+const (
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+	// SigningSecretDir is the directory the signing secret is mounted into, when the build runs in a Pod.
+	SigningSecretDir = "/etc/camel/signing"
+)
+
+// signingSecretDir can be overridden by the tests.
+var signingSecretDir = SigningSecretDir
+
+// signImage signs the published image with the private key of the signing secret, when signing is configured.
+func signImage(ctx context.Context, c client.Client, namespace string, task v1.PublishTask, image string, digest string,
+	options ...remote.Option) error {
+	if task.Signing == nil || task.Signing.Secret == "" {
+		return nil
+	}
+	if digest == "" {
+		return fmt.Errorf("cannot sign image %s: unknown digest", image)
+	}
+
+	key, password, err := signingKey(ctx, c, namespace, task.Signing.Secret)
+	if err != nil {
+		return err
+	}
+	signer, err := signature.LoadPrivateKey(key, password)
+	if err != nil {
+		return err
+	}
+
+	var nameOptions []name.Option
+	if task.Registry.Insecure {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	ref, err := name.NewDigest(imageRepository(image)+"@"+digest, nameOptions...)
+	if err != nil {
+		return err
+	}
+
+	return signature.Sign(ref, signer, append([]remote.Option{remote.WithContext(ctx)}, options...)...)
+}
+
+// signingKey returns the private key, and its password, of the signing secret, either read from the secret mounted
+// into the builder Pod, or else from the API, when the build runs in the operator.
+func signingKey(ctx context.Context, c client.Client, namespace string, secretName string) ([]byte, []byte, error) {
+	key, err := os.ReadFile(filepath.Join(signingSecretDir, signature.PrivateKeySecretKey))
+	if err == nil {
+		password, err := os.ReadFile(filepath.Join(signingSecretDir, signature.PasswordSecretKey))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, err
+		}
+
+		return key, password, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+
+	secret, err := c.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get signing secret %s/%s: %w", namespace, secretName, err)
+	}
+	key, ok := secret.Data[signature.PrivateKeySecretKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("no %s key found in signing secret %s/%s", signature.PrivateKeySecretKey, namespace, secretName)
+	}
+
+	return key, secret.Data[signature.PasswordSecretKey], nil
+}
+
+// serviceAccountRemoteOptions authenticates against the OpenShift internal registry with the service account
+// of the operator, trusting the service CA.
+func serviceAccountRemoteOptions() []remote.Option {
+	var options []remote.Option
+	if token, err := os.ReadFile(serviceAccountTokenFile); err == nil {
+		options = append(options, remote.WithAuth(&authn.Basic{Username: "serviceaccount", Password: string(token)}))
+	} else {
+		options = append(options, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+	if ca, err := os.ReadFile(serviceAccountCAFile); err == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pool.AppendCertsFromPEM(ca)
+		transport := remote.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		options = append(options, remote.WithTransport(transport))
+	}
+
+	return options
+}
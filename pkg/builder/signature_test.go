@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/signature"
+)
+
+func TestSignImage(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	image := strings.TrimPrefix(server.URL, "http://") + "/ns/camel-k-kit-123:1"
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "signing"},
+		Data: map[string][]byte{
+			signature.PrivateKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		},
+	}
+	c, err := internal.NewFakeClient(secret)
+	require.NoError(t, err)
+
+	task := v1.PublishTask{Registry: v1.RegistrySpec{Insecure: true}}
+	// no signing configured
+	require.NoError(t, signImage(context.TODO(), c, "ns", task, image, digest.String()))
+	require.ErrorIs(t, signature.Verify(ref.Context().Digest(digest.String()), key.Public()), signature.ErrNoSignature)
+
+	task.Signing = &v1.ImageSigningSpec{Secret: "signing"}
+	require.NoError(t, signImage(context.TODO(), c, "ns", task, image, digest.String()))
+	require.NoError(t, signature.Verify(ref.Context().Digest(digest.String()), key.Public()))
+
+	task.Signing = &v1.ImageSigningSpec{Secret: "missing"}
+	require.Error(t, signImage(context.TODO(), c, "ns", task, image, digest.String()))
+
+	// the signing secret mounted into the builder Pod is read without the API
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, signature.PrivateKeySecretKey), secret.Data[signature.PrivateKeySecretKey], 0o600))
+	signingSecretDir = dir
+	defer func() { signingSecretDir = SigningSecretDir }()
+	empty, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	task.Signing = &v1.ImageSigningSpec{Secret: "mounted"}
+	require.NoError(t, signImage(context.TODO(), empty, "ns", task, image, digest.String()))
+}
@@ -85,6 +85,7 @@ type builderContext struct {
 	Artifacts         []v1.Artifact
 	SelectedArtifacts []v1.Artifact
 	Resources         []resource
+	DependencyCache   *dependencyCacheResult
 	Maven             struct {
 		Project          maven.Project
 		UserSettings     []byte
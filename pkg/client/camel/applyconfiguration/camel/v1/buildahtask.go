@@ -90,6 +90,14 @@ func (b *BuildahTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyCon
 	return b
 }
 
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *BuildahTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *BuildahTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Signing = value
+	return b
+}
+
 // WithPlatform sets the Platform field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Platform field is set to the value of the last call.
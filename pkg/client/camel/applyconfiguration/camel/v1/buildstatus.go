@@ -43,6 +43,8 @@ type BuildStatusApplyConfiguration struct {
 	BaseImage *string `json:"baseImage,omitempty"`
 	// a list of artifacts contained in the build
 	Artifacts []ArtifactApplyConfiguration `json:"artifacts,omitempty"`
+	// the SBOM and provenance attestations attached to the image built (if any)
+	Attestations []AttestationApplyConfiguration `json:"attestations,omitempty"`
 	// the error description (if any)
 	Error *string `json:"error,omitempty"`
 	// the reason of the failure (if any)
@@ -124,6 +126,19 @@ func (b *BuildStatusApplyConfiguration) WithArtifacts(values ...*ArtifactApplyCo
 	return b
 }
 
+// WithAttestations adds the given value to the Attestations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attestations field.
+func (b *BuildStatusApplyConfiguration) WithAttestations(values ...*AttestationApplyConfiguration) *BuildStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttestations")
+		}
+		b.Attestations = append(b.Attestations, *values[i])
+	}
+	return b
+}
+
 // WithError sets the Error field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Error field is set to the value of the last call.
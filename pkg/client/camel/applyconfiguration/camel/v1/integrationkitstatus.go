@@ -42,6 +42,8 @@ type IntegrationKitStatusApplyConfiguration struct {
 	Digest *string `json:"digest,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []ArtifactApplyConfiguration `json:"artifacts,omitempty"`
+	// the SBOM and provenance attestations of the kit image
+	Attestations []AttestationApplyConfiguration `json:"attestations,omitempty"`
 	// failure reason (if any)
 	Failure *FailureApplyConfiguration `json:"failure,omitempty"`
 	// the runtime version for which this kit was configured
@@ -125,6 +127,19 @@ func (b *IntegrationKitStatusApplyConfiguration) WithArtifacts(values ...*Artifa
 	return b
 }
 
+// WithAttestations adds the given value to the Attestations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attestations field.
+func (b *IntegrationKitStatusApplyConfiguration) WithAttestations(values ...*AttestationApplyConfiguration) *IntegrationKitStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttestations")
+		}
+		b.Attestations = append(b.Attestations, *values[i])
+	}
+	return b
+}
+
 // WithFailure sets the Failure field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Failure field is set to the value of the last call.
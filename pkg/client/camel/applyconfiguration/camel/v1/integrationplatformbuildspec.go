@@ -46,6 +46,8 @@ type IntegrationPlatformBuildSpecApplyConfiguration struct {
 	BaseImage *string `json:"baseImage,omitempty"`
 	// the image registry used to push/pull Integration images
 	Registry *RegistrySpecApplyConfiguration `json:"registry,omitempty"`
+	// the keys used to sign the Integration images built by the operator and to verify them before deployment
+	Signing *ImageSigningSpecApplyConfiguration `json:"signing,omitempty"`
 	// the timeout (in seconds) to use when creating the build tools container image.
 	//
 	// Deprecated: no longer in use
@@ -122,6 +124,14 @@ func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithRegistry(value *Reg
 	return b
 }
 
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.Signing = value
+	return b
+}
+
 // WithBuildCatalogToolTimeout sets the BuildCatalogToolTimeout field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the BuildCatalogToolTimeout field is set to the value of the last call.
@@ -38,6 +38,9 @@ type IntegrationStatusApplyConfiguration struct {
 	Digest *string `json:"digest,omitempty"`
 	// the container image used
 	Image *string `json:"image,omitempty"`
+	// the container image, addressed by digest, the signature of which has been verified.
+	// The Integration is deployed from it, in place of the image.
+	VerifiedImage *string `json:"verifiedImage,omitempty"`
 	// the Java jar dependency to execute (if available)
 	Jar *string `json:"jar,omitempty"`
 	// a list of dependencies needed by the application
@@ -80,6 +83,14 @@ type IntegrationStatusApplyConfiguration struct {
 	DeploymentTimestamp *metav1.Time `json:"lastDeploymentTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was built.
 	BuildTimestamp *metav1.Time `json:"lastBuildTimestamp,omitempty"`
+	// the progress of the last rollout of a new version of the Integration (see the rollout trait).
+	Rollout *IntegrationRolloutStatusApplyConfiguration `json:"rollout,omitempty"`
+	// the requests and limits recommended for the Integration container, from its resource usage.
+	Resources *IntegrationResourcesStatusApplyConfiguration `json:"resources,omitempty"`
+	// the state of the sleep schedules of the Integration (see the sleep trait).
+	Sleep *IntegrationSleepStatusApplyConfiguration `json:"sleep,omitempty"`
+	// the revision of the Integration currently deployed, as recorded in its revision history (see the deployer trait).
+	Revision *int64 `json:"revision,omitempty"`
 }
 
 // IntegrationStatusApplyConfiguration constructs a declarative configuration of the IntegrationStatus type for use with
@@ -120,6 +131,14 @@ func (b *IntegrationStatusApplyConfiguration) WithImage(value string) *Integrati
 	return b
 }
 
+// WithVerifiedImage sets the VerifiedImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifiedImage field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithVerifiedImage(value string) *IntegrationStatusApplyConfiguration {
+	b.VerifiedImage = &value
+	return b
+}
+
 // WithJar sets the Jar field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Jar field is set to the value of the last call.
@@ -290,3 +309,35 @@ func (b *IntegrationStatusApplyConfiguration) WithBuildTimestamp(value metav1.Ti
 	b.BuildTimestamp = &value
 	return b
 }
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithRollout(value *IntegrationRolloutStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithResources(value *IntegrationResourcesStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithSleep sets the Sleep field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sleep field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithSleep(value *IntegrationSleepStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Sleep = value
+	return b
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithRevision(value int64) *IntegrationStatusApplyConfiguration {
+	b.Revision = &value
+	return b
+}
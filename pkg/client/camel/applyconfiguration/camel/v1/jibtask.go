@@ -81,3 +81,11 @@ func (b *JibTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyConfigu
 	b.PublishTaskApplyConfiguration.Registry = value
 	return b
 }
+
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *JibTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *JibTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Signing = value
+	return b
+}
@@ -24,7 +24,7 @@ package v1
 //
 // KameletRepositorySpec defines the location of the Kamelet catalog to use.
 type KameletRepositorySpecApplyConfiguration struct {
-	// the remote repository in the format github:ORG/REPO/PATH_TO_KAMELETS_FOLDER
+	// the repository in the format github:ORG/REPO/PATH_TO_KAMELETS_FOLDER[@VERSION], file:PATH[@VERSION] or oci:REGISTRY/REPOSITORY[:TAG|@DIGEST]
 	URI *string `json:"uri,omitempty"`
 }
 
@@ -90,6 +90,14 @@ func (b *KanikoTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyConf
 	return b
 }
 
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *KanikoTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *KanikoTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Signing = value
+	return b
+}
+
 // WithVerbose sets the Verbose field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Verbose field is set to the value of the last call.
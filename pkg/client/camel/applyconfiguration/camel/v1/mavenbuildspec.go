@@ -128,6 +128,14 @@ func (b *MavenBuildSpecApplyConfiguration) WithCLIOptions(values ...string) *Mav
 	return b
 }
 
+// WithDependencyCache sets the DependencyCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DependencyCache field is set to the value of the last call.
+func (b *MavenBuildSpecApplyConfiguration) WithDependencyCache(value *MavenDependencyCacheSpecApplyConfiguration) *MavenBuildSpecApplyConfiguration {
+	b.MavenSpecApplyConfiguration.DependencyCache = value
+	return b
+}
+
 // WithRepositories adds the given value to the Repositories field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Repositories field.
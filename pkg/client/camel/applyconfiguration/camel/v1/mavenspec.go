@@ -54,6 +54,8 @@ type MavenSpecApplyConfiguration struct {
 	// e.g., `-V,--no-transfer-progress,-Dstyle.color=never`.
 	// See https://maven.apache.org/ref/3.9.14/maven-embedder/cli.html.
 	CLIOptions []string `json:"cliOptions,omitempty"`
+	// The cache of the Maven dependencies, shared across the builds.
+	DependencyCache *MavenDependencyCacheSpecApplyConfiguration `json:"dependencyCache,omitempty"`
 }
 
 // MavenSpecApplyConfiguration constructs a declarative configuration of the MavenSpec type for use with
@@ -145,3 +147,11 @@ func (b *MavenSpecApplyConfiguration) WithCLIOptions(values ...string) *MavenSpe
 	}
 	return b
 }
+
+// WithDependencyCache sets the DependencyCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DependencyCache field is set to the value of the last call.
+func (b *MavenSpecApplyConfiguration) WithDependencyCache(value *MavenDependencyCacheSpecApplyConfiguration) *MavenSpecApplyConfiguration {
+	b.DependencyCache = value
+	return b
+}
@@ -29,7 +29,8 @@ type PipeSpecApplyConfiguration struct {
 	Integration *IntegrationSpecApplyConfiguration `json:"integration,omitempty"`
 	// Source is the starting point of the integration defined by this Pipe
 	Source *EndpointApplyConfiguration `json:"source,omitempty"`
-	// Sink is the destination of the integration defined by this Pipe
+	// Sink is the destination of the integration defined by this Pipe. It is optional when the Pipe
+	// declares a Choice or a Multicast, in which case it is executed after the branches
 	Sink *EndpointApplyConfiguration `json:"sink,omitempty"`
 	// ErrorHandler is an optional handler called upon an error occurring in the integration
 	ErrorHandler *ErrorHandlerSpecApplyConfiguration `json:"errorHandler,omitempty"`
@@ -37,6 +38,12 @@ type PipeSpecApplyConfiguration struct {
 	Traits *TraitsApplyConfiguration `json:"traits,omitempty"`
 	// Steps contains an optional list of intermediate steps that are executed between the Source and the Sink
 	Steps []EndpointApplyConfiguration `json:"steps,omitempty"`
+	// Choice is an optional content-based router executed after the Steps: the message is routed to the first
+	// branch whose condition matches, or to the otherwise branch. It cannot be used together with Multicast
+	Choice *PipeChoiceApplyConfiguration `json:"choice,omitempty"`
+	// Multicast is an optional list of branches executed after the Steps: a copy of the message is sent to
+	// every branch. It cannot be used together with Choice
+	Multicast *PipeMulticastApplyConfiguration `json:"multicast,omitempty"`
 	// Replicas is the number of desired replicas for the Pipe
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Custom SA to use for the Pipe
@@ -104,6 +111,22 @@ func (b *PipeSpecApplyConfiguration) WithSteps(values ...*EndpointApplyConfigura
 	return b
 }
 
+// WithChoice sets the Choice field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Choice field is set to the value of the last call.
+func (b *PipeSpecApplyConfiguration) WithChoice(value *PipeChoiceApplyConfiguration) *PipeSpecApplyConfiguration {
+	b.Choice = value
+	return b
+}
+
+// WithMulticast sets the Multicast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multicast field is set to the value of the last call.
+func (b *PipeSpecApplyConfiguration) WithMulticast(value *PipeMulticastApplyConfiguration) *PipeSpecApplyConfiguration {
+	b.Multicast = value
+	return b
+}
+
 // WithReplicas sets the Replicas field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Replicas field is set to the value of the last call.
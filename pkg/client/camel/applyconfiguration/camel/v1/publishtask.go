@@ -32,6 +32,8 @@ type PublishTaskApplyConfiguration struct {
 	Image *string `json:"image,omitempty"`
 	// where to publish the final image
 	Registry *RegistrySpecApplyConfiguration `json:"registry,omitempty"`
+	// how to sign the final image
+	Signing *ImageSigningSpecApplyConfiguration `json:"signing,omitempty"`
 }
 
 // PublishTaskApplyConfiguration constructs a declarative configuration of the PublishTask type for use with
@@ -71,3 +73,11 @@ func (b *PublishTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyCon
 	b.Registry = value
 	return b
 }
+
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *PublishTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *PublishTaskApplyConfiguration {
+	b.Signing = value
+	return b
+}
@@ -84,6 +84,14 @@ func (b *S2iTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyConfigu
 	return b
 }
 
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *S2iTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *S2iTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Signing = value
+	return b
+}
+
 // WithTag sets the Tag field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Tag field is set to the value of the last call.
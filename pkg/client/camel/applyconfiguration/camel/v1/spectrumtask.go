@@ -83,3 +83,11 @@ func (b *SpectrumTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyCo
 	b.PublishTaskApplyConfiguration.Registry = value
 	return b
 }
+
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *SpectrumTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *SpectrumTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Signing = value
+	return b
+}
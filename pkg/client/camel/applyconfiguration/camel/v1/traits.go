@@ -58,6 +58,8 @@ type TraitsApplyConfiguration struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty"`
 	// The configuration of Init Containers trait
@@ -84,9 +86,9 @@ type TraitsApplyConfiguration struct {
 	Master *trait.MasterTrait `json:"master,omitempty"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty"`
-	// The configuration of OpenAPI trait.
-	//
-	// Deprecated: no longer in use.
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty"`
+	// The configuration of OpenAPI trait
 	OpenAPI *trait.OpenAPITrait `json:"openapi,omitempty"`
 	// The configuration of Owner trait
 	Owner *trait.OwnerTrait `json:"owner,omitempty"`
@@ -104,10 +106,14 @@ type TraitsApplyConfiguration struct {
 	PullSecret *trait.PullSecretTrait `json:"pull-secret,omitempty"`
 	// The configuration of Quarkus trait
 	Quarkus *trait.QuarkusTrait `json:"quarkus,omitempty"`
+	// The configuration of RBAC trait
+	RBAC *trait.RBACTrait `json:"rbac,omitempty"`
 	// The configuration of Registry trait (support removed since version 2.5.0).
 	//
 	// Deprecated: use jvm trait or read documentation.
 	Registry *trait.RegistryTrait `json:"registry,omitempty"`
+	// The configuration of Rollout trait
+	Rollout *trait.RolloutTrait `json:"rollout,omitempty"`
 	// The configuration of Route trait.
 	//
 	// Deprecated: use ingress instead.
@@ -116,10 +122,10 @@ type TraitsApplyConfiguration struct {
 	SecurityContext *trait.SecurityContextTrait `json:"security-context,omitempty"`
 	// The configuration of Service trait
 	Service *trait.ServiceTrait `json:"service,omitempty"`
-	// The configuration of Service Binding trait.
-	//
-	// Deprecated: no longer in use.
+	// The configuration of Service Binding trait
 	ServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty"`
+	// The configuration of Sleep trait
+	Sleep *trait.SleepTrait `json:"sleep,omitempty"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty"`
 	// The configuration of Toleration trait
@@ -252,6 +258,14 @@ func (b *TraitsApplyConfiguration) WithHealth(value trait.HealthTrait) *TraitsAp
 	return b
 }
 
+// WithHPA sets the HPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHPA(value trait.HPATrait) *TraitsApplyConfiguration {
+	b.HPA = &value
+	return b
+}
+
 // WithIngress sets the Ingress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Ingress field is set to the value of the last call.
@@ -348,6 +362,14 @@ func (b *TraitsApplyConfiguration) WithMount(value trait.MountTrait) *TraitsAppl
 	return b
 }
 
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithNetworkPolicy(value trait.NetworkPolicyTrait) *TraitsApplyConfiguration {
+	b.NetworkPolicy = &value
+	return b
+}
+
 // WithOpenAPI sets the OpenAPI field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OpenAPI field is set to the value of the last call.
@@ -412,6 +434,14 @@ func (b *TraitsApplyConfiguration) WithQuarkus(value trait.QuarkusTrait) *Traits
 	return b
 }
 
+// WithRBAC sets the RBAC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RBAC field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithRBAC(value trait.RBACTrait) *TraitsApplyConfiguration {
+	b.RBAC = &value
+	return b
+}
+
 // WithRegistry sets the Registry field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Registry field is set to the value of the last call.
@@ -420,6 +450,14 @@ func (b *TraitsApplyConfiguration) WithRegistry(value trait.RegistryTrait) *Trai
 	return b
 }
 
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithRollout(value trait.RolloutTrait) *TraitsApplyConfiguration {
+	b.Rollout = &value
+	return b
+}
+
 // WithRoute sets the Route field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Route field is set to the value of the last call.
@@ -452,6 +490,14 @@ func (b *TraitsApplyConfiguration) WithServiceBinding(value trait.ServiceBinding
 	return b
 }
 
+// WithSleep sets the Sleep field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sleep field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithSleep(value trait.SleepTrait) *TraitsApplyConfiguration {
+	b.Sleep = &value
+	return b
+}
+
 // WithTelemetry sets the Telemetry field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Telemetry field is set to the value of the last call.
@@ -37,6 +37,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.AddonTraitApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Artifact"):
 		return &camelv1.ArtifactApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Attestation"):
+		return &camelv1.AttestationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BaseTask"):
 		return &camelv1.BaseTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Build"):
@@ -111,6 +113,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.HeaderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HealthCheckResponse"):
 		return &camelv1.HealthCheckResponseApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImageSigningSpec"):
+		return &camelv1.ImageSigningSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Integration"):
 		return &camelv1.IntegrationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationCondition"):
@@ -149,6 +153,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.IntegrationProfileSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationProfileStatus"):
 		return &camelv1.IntegrationProfileStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationResourcesRecommendation"):
+		return &camelv1.IntegrationResourcesRecommendationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationResourcesStatus"):
+		return &camelv1.IntegrationResourcesStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationRolloutStatus"):
+		return &camelv1.IntegrationRolloutStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationSleepStatus"):
+		return &camelv1.IntegrationSleepStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationSpec"):
 		return &camelv1.IntegrationSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationStatus"):
@@ -183,16 +195,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.MavenArtifactApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenBuildSpec"):
 		return &camelv1.MavenBuildSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MavenDependencyCacheSpec"):
+		return &camelv1.MavenDependencyCacheSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenSpec"):
 		return &camelv1.MavenSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Pipe"):
 		return &camelv1.PipeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PipeBranch"):
+		return &camelv1.PipeBranchApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PipeChoice"):
+		return &camelv1.PipeChoiceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeCondition"):
 		return &camelv1.PipeConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PipeMulticast"):
+		return &camelv1.PipeMulticastApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeSpec"):
 		return &camelv1.PipeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeStatus"):
 		return &camelv1.PipeStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PipeWhen"):
+		return &camelv1.PipeWhenApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodCondition"):
 		return &camelv1.PodConditionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodSpec"):
@@ -71,6 +71,12 @@ func Run(namespace string, buildName string, taskName string) {
 	status := builder.New(c).Build(build).TaskByName(taskName).Do(cancelOnSignals)
 	target := build.DeepCopy()
 	target.Status = status
+	// Retain the conditions reported by the previous tasks
+	for _, condition := range build.Status.Conditions {
+		if target.Status.GetCondition(condition.Type) == nil {
+			target.Status.Conditions = append(target.Status.Conditions, condition)
+		}
+	}
 	// Let the owning controller decide the resulting phase based on the Pod state.
 	// The Pod status acts as the interface with the controller, so that no assumptions
 	// is made on the build containers.
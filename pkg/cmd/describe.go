@@ -0,0 +1,211 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+)
+
+func newCmdDescribe(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "describe",
+		Short: "Describe a resource",
+		Long:  `Describe a Camel K resource, showing its status, conditions and related events.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newDescribeIntegrationCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newDescribePipeCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newDescribeKitCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newDescribeBuildCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+func validateDescribeArgs(kind string) cobra.PositionalArgs {
+	return func(_ *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("describe %s expects the %s name as argument", kind, kind)
+		}
+
+		return nil
+	}
+}
+
+func describeObjectMeta(w *indentedwriter.Writer, om metav1.ObjectMeta) {
+	w.Writef(0, "Name:\t%s\n", om.Name)
+	w.Writef(0, "Namespace:\t%s\n", om.Namespace)
+
+	if len(om.GetLabels()) > 0 {
+		w.Writef(0, "Labels:\n")
+		for _, k := range util.SortedMapKeys(om.Labels) {
+			w.Writef(1, "%s=%s\n", k, om.Labels[k])
+		}
+	}
+
+	if len(om.GetAnnotations()) > 0 {
+		w.Writef(0, "Annotations:\n")
+		for _, k := range util.SortedMapKeys(om.Annotations) {
+			w.Writef(1, "%s=%s\n", k, om.Annotations[k])
+		}
+	}
+
+	w.Writef(0, "Creation Timestamp:\t%s\n", om.CreationTimestamp.Format(time.RFC1123Z))
+}
+
+// describeConditions prints the conditions, together with the history of their transitions,
+// which is the closest thing to a phase history stored in the resource status.
+func describeConditions(w *indentedwriter.Writer, conditions []v1.ResourceCondition) {
+	if len(conditions) == 0 {
+		return
+	}
+
+	w.Writef(0, "Conditions:\n")
+	w.Writef(1, "Type\tStatus\tReason\tMessage\n")
+	for _, condition := range conditions {
+		w.Writef(1, "%s\t%s\t%s\t%s\n",
+			condition.GetType(),
+			condition.GetStatus(),
+			condition.GetReason(),
+			condition.GetMessage())
+	}
+
+	history := slices.Clone(conditions)
+	sort.SliceStable(history, func(i, j int) bool {
+		ti := history[i].GetLastTransitionTime()
+		tj := history[j].GetLastTransitionTime()
+
+		return ti.Before(&tj)
+	})
+	w.Writef(0, "History:\n")
+	w.Writef(1, "Last Transition\tType\tStatus\tReason\n")
+	for _, condition := range history {
+		w.Writef(1, "%s\t%s\t%s\t%s\n",
+			describeTime(condition.GetLastTransitionTime()),
+			condition.GetType(),
+			condition.GetStatus(),
+			condition.GetReason())
+	}
+}
+
+func describeTraits(w *indentedwriter.Writer, traits any) error {
+	traitMap, err := trait.ToTraitMap(traits)
+	if err != nil {
+		return err
+	}
+	if len(traitMap) == 0 {
+		return nil
+	}
+
+	w.Writef(0, "Traits:\n")
+	for _, id := range util.SortedMapKeys(traitMap) {
+		w.Writef(1, "%s:\n", id)
+		properties := traitMap[id]
+		for _, k := range util.SortedMapKeys(properties) {
+			w.Writef(2, "%s:\t%v\n", k, properties[k])
+		}
+	}
+
+	return nil
+}
+
+func describeStrings(w *indentedwriter.Writer, title string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	w.Writef(0, "%s:\n", title)
+	for _, value := range values {
+		w.Writef(1, "%s\n", value)
+	}
+}
+
+// describeEvents prints the Kubernetes events whose involved object is the given resource.
+func describeEvents(ctx context.Context, c client.Client, w *indentedwriter.Writer, kind string, om metav1.ObjectMeta) error {
+	events, err := c.CoreV1().Events(om.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, om.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]corev1.Event, 0, len(events.Items))
+	for _, event := range events.Items {
+		if event.InvolvedObject.Kind == kind && event.InvolvedObject.Name == om.Name {
+			filtered = append(filtered, event)
+		}
+	}
+	if len(filtered) == 0 {
+		w.Writef(0, "Events:\t<none>\n")
+
+		return nil
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return eventTime(filtered[i]).Before(eventTime(filtered[j]))
+	})
+	w.Writef(0, "Events:\n")
+	w.Writef(1, "Type\tReason\tLast Seen\tCount\tMessage\n")
+	for _, event := range filtered {
+		count := event.Count
+		if count == 0 {
+			count = 1
+		}
+		w.Writef(1, "%s\t%s\t%s\t%d\t%s\n",
+			event.Type,
+			event.Reason,
+			eventTime(event).Format(time.RFC3339),
+			count,
+			strings.TrimSpace(event.Message))
+	}
+
+	return nil
+}
+
+func eventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+
+	return event.CreationTimestamp.Time
+}
+
+func describeTime(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,139 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func newDescribeBuildCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *describeBuildCommandOptions) {
+	options := describeBuildCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "build [build name]",
+		Short:   "Describe a Build",
+		Long:    `Describe a Build, showing its tasks, duration, failure reason and related events.`,
+		Args:    validateDescribeArgs("build"),
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	return &cmd, &options
+}
+
+type describeBuildCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *describeBuildCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	build, err := kubernetes.GetBuild(command.Context, c, name, command.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return fmt.Errorf("build %q not found in namespace %q", name, command.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	out, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		return command.describeBuild(c, build, out)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), out)
+
+	return nil
+}
+
+func (command *describeBuildCommandOptions) describeBuild(c client.Client, build *v1.Build, out io.Writer) error {
+	w := indentedwriter.NewWriter(out)
+
+	describeObjectMeta(w, build.ObjectMeta)
+	w.Writef(0, "Phase:\t%s\n", build.Status.Phase)
+	if build.Status.StartedAt != nil {
+		w.Writef(0, "Started:\t%s\n", describeTime(*build.Status.StartedAt))
+	}
+	w.Writef(0, "Duration:\t%s\n", build.Status.Duration)
+	w.Writef(0, "Root Image:\t%s\n", build.Status.RootImage)
+	w.Writef(0, "Base Image:\t%s\n", build.Status.BaseImage)
+	w.Writef(0, "Image:\t%s\n", build.Status.Image)
+	w.Writef(0, "Digest:\t%s\n", build.Status.Digest)
+	if build.Status.Error != "" {
+		w.Writef(0, "Error:\t%s\n", build.Status.Error)
+	}
+	if build.Status.Failure != nil {
+		w.Writef(0, "Failure:\t%s (attempt %d/%d)\n",
+			build.Status.Failure.Reason,
+			build.Status.Failure.Recovery.Attempt,
+			build.Status.Failure.Recovery.AttemptMax)
+	}
+
+	if len(build.Spec.Tasks) > 0 {
+		w.Writef(0, "Tasks:\n")
+		for _, task := range build.Spec.Tasks {
+			w.Writef(1, "%s\n", describeBuildTaskName(task))
+		}
+	}
+
+	describeConditions(w, build.Status.GetConditions())
+	if err := describeEvents(command.Context, c, w, v1.BuildKind, build.ObjectMeta); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+//nolint:staticcheck
+func describeBuildTaskName(task v1.Task) string {
+	switch {
+	case task.Builder != nil:
+		return task.Builder.Name
+	case task.Custom != nil:
+		return task.Custom.Name
+	case task.Package != nil:
+		return task.Package.Name
+	case task.Buildah != nil:
+		return task.Buildah.Name
+	case task.Kaniko != nil:
+		return task.Kaniko.Name
+	case task.Spectrum != nil:
+		return task.Spectrum.Name
+	case task.S2i != nil:
+		return task.S2i.Name
+	case task.Jib != nil:
+		return task.Jib.Name
+	}
+
+	return "<unknown>"
+}
@@ -0,0 +1,238 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+func newDescribeIntegrationCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *describeIntegrationCommandOptions) {
+	options := describeIntegrationCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "integration [integration name]",
+		Aliases: []string{"it"},
+		Short:   "Describe an Integration",
+		Long:    `Describe an Integration, showing its status, the resolved kit and build, the applied traits and the related events.`,
+		Args:    validateDescribeArgs("integration"),
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	return &cmd, &options
+}
+
+type describeIntegrationCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *describeIntegrationCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	it, err := getIntegration(command.Context, c, name, command.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return fmt.Errorf("integration %q not found in namespace %q", name, command.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	out, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		return command.describeIntegration(c, it, out)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), out)
+
+	return nil
+}
+
+func (command *describeIntegrationCommandOptions) describeIntegration(c client.Client, it *v1.Integration, out io.Writer) error {
+	w := indentedwriter.NewWriter(out)
+
+	describeObjectMeta(w, it.ObjectMeta)
+	w.Writef(0, "Phase:\t%s\n", it.Status.Phase)
+	if it.Status.Replicas != nil {
+		w.Writef(0, "Replicas:\t%d\n", *it.Status.Replicas)
+	}
+	w.Writef(0, "Runtime Version:\t%s\n", it.Status.RuntimeVersion)
+	w.Writef(0, "Runtime Provider:\t%s\n", it.Status.RuntimeProvider)
+	w.Writef(0, "Platform:\t%s\n", it.Status.Platform)
+	w.Writef(0, "Operator Version:\t%s\n", it.Status.Version)
+	w.Writef(0, "Image:\t%s\n", it.Status.Image)
+	if it.Status.InitializationTimestamp != nil {
+		w.Writef(0, "Initialized:\t%s\n", describeTime(*it.Status.InitializationTimestamp))
+	}
+	if it.Status.BuildTimestamp != nil {
+		w.Writef(0, "Built:\t%s\n", describeTime(*it.Status.BuildTimestamp))
+	}
+	if it.Status.DeploymentTimestamp != nil {
+		w.Writef(0, "Deployed:\t%s\n", describeTime(*it.Status.DeploymentTimestamp))
+	}
+	if it.Status.Revision != 0 {
+		w.Writef(0, "Revision:\t%d\n", it.Status.Revision)
+	}
+
+	if err := command.describeIntegrationKit(c, it, w); err != nil {
+		return err
+	}
+	if err := command.describeRevisions(c, it, w); err != nil {
+		return err
+	}
+
+	if rollout := it.Status.Rollout; rollout != nil {
+		w.Writef(0, "Rollout:\t%s\n", rollout.Phase)
+		w.Writef(1, "Strategy:\t%s\n", rollout.Strategy)
+		w.Writef(1, "Stable Image:\t%s\n", rollout.StableImage)
+		w.Writef(1, "Canary Image:\t%s\n", rollout.CanaryImage)
+		w.Writef(1, "Weight:\t%d%%\n", rollout.Weight)
+		if rollout.Message != "" {
+			w.Writef(1, "Message:\t%s\n", rollout.Message)
+		}
+	}
+
+	if sleep := it.Status.Sleep; sleep != nil {
+		w.Writef(0, "Sleeping:\t%t\n", sleep.Sleeping)
+		if sleep.NextTransitionTime != nil {
+			w.Writef(1, "Next Transition:\t%s\n", describeTime(*sleep.NextTransitionTime))
+		}
+	}
+
+	if resources := it.Status.Resources; resources != nil && resources.Recommendation != nil {
+		describeResourcesRecommendation(w, "Recommended Resources", resources.Recommendation)
+		if resources.Applied != nil {
+			describeResourcesRecommendation(w, "Applied Resources", resources.Applied)
+		}
+	}
+
+	describeStrings(w, "Dependencies", it.Status.Dependencies)
+	if it.Status.Traits != nil {
+		if it.Status.Traits.Kamelets != nil && it.Status.Traits.Kamelets.List != "" {
+			describeStrings(w, "Kamelets", strings.Split(it.Status.Traits.Kamelets.List, ","))
+		}
+		if err := describeTraits(w, *it.Status.Traits); err != nil {
+			return err
+		}
+	}
+
+	describeConditions(w, it.Status.GetConditions())
+	if err := describeEvents(command.Context, c, w, v1.IntegrationKind, it.ObjectMeta); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// describeResourcesRecommendation prints the requests and limits of a resources recommendation.
+func describeResourcesRecommendation(w *indentedwriter.Writer, title string, recommendation *v1.IntegrationResourcesRecommendation) {
+	w.Writef(0, "%s:\n", title)
+	w.Writef(1, "Computed:\t%s\n", describeTime(recommendation.Timestamp))
+	w.Writef(1, "Samples:\t%d\n", recommendation.Samples)
+	w.Writef(1, "Request CPU:\t%s\n", recommendation.RequestCPU.String())
+	w.Writef(1, "Request Memory:\t%s\n", recommendation.RequestMemory.String())
+	w.Writef(1, "Limit CPU:\t%s\n", recommendation.LimitCPU.String())
+	w.Writef(1, "Limit Memory:\t%s\n", recommendation.LimitMemory.String())
+}
+
+// describeIntegrationKit prints the kit and the build resolved for the Integration, if any.
+func (command *describeIntegrationCommandOptions) describeIntegrationKit(c client.Client, it *v1.Integration, w *indentedwriter.Writer) error {
+	if it.Status.IntegrationKit == nil {
+		return nil
+	}
+
+	ns := it.GetIntegrationKitNamespace("")
+	kitName := it.Status.IntegrationKit.Name
+	w.Writef(0, "Kit:\t%s/%s\n", ns, kitName)
+
+	kit, err := kubernetes.GetIntegrationKit(command.Context, c, kitName, ns)
+	if err != nil && k8serrors.IsNotFound(err) {
+		w.Writef(1, "Phase:\t<not found>\n")
+
+		return nil
+	} else if err != nil {
+		return err
+	}
+	w.Writef(1, "Phase:\t%s\n", kit.Status.Phase)
+	w.Writef(1, "Image:\t%s\n", kit.Status.Image)
+
+	build, err := kubernetes.GetBuild(command.Context, c, kit.Name, kit.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	w.Writef(0, "Build:\t%s/%s\n", build.Namespace, build.Name)
+	w.Writef(1, "Phase:\t%s\n", build.Status.Phase)
+	if build.Status.Duration != "" {
+		w.Writef(1, "Duration:\t%s\n", build.Status.Duration)
+	}
+	if build.Status.Error != "" {
+		w.Writef(1, "Error:\t%s\n", build.Status.Error)
+	}
+
+	return nil
+}
+
+// describeRevisions prints the revision history of the Integration, if any.
+func (command *describeIntegrationCommandOptions) describeRevisions(c client.Client, it *v1.Integration, w *indentedwriter.Writer) error {
+	revisions, err := revision.List(command.Context, c, it)
+	if err != nil && k8serrors.IsForbidden(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	w.Writef(0, "Revisions:\n")
+	for i := range revisions {
+		rev := &revisions[i]
+		data, err := revision.Decode(rev)
+		if err != nil {
+			return err
+		}
+		deployed := data.Image
+		if kit := data.IntegrationKit; kit != nil {
+			deployed = kit.Namespace + "/" + kit.Name
+		}
+		if rev.Revision == it.Status.Revision {
+			deployed += " (deployed)"
+		}
+		w.Writef(1, "%d:\t%s\t%s\n", rev.Revision, describeTime(rev.CreationTimestamp), deployed)
+	}
+
+	return nil
+}
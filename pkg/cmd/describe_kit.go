@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func newDescribeKitCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *describeKitCommandOptions) {
+	options := describeKitCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "kit [kit name]",
+		Aliases: []string{"ik"},
+		Short:   "Describe an Integration Kit",
+		Long:    `Describe an Integration Kit, showing its image, artifacts, conditions and related events.`,
+		Args:    validateDescribeArgs("kit"),
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	return &cmd, &options
+}
+
+type describeKitCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *describeKitCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	kit, err := kubernetes.GetIntegrationKit(command.Context, c, name, command.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return fmt.Errorf("kit %q not found in namespace %q", name, command.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	out, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		return command.describeKit(c, kit, out)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), out)
+
+	return nil
+}
+
+func (command *describeKitCommandOptions) describeKit(c client.Client, kit *v1.IntegrationKit, out io.Writer) error {
+	w := indentedwriter.NewWriter(out)
+
+	describeObjectMeta(w, kit.ObjectMeta)
+	w.Writef(0, "Phase:\t%s\n", kit.Status.Phase)
+	w.Writef(0, "Type:\t%s\n", kit.Labels[v1.IntegrationKitTypeLabel])
+	w.Writef(0, "Runtime Version:\t%s\n", kit.Status.RuntimeVersion)
+	w.Writef(0, "Runtime Provider:\t%s\n", kit.Status.RuntimeProvider)
+	w.Writef(0, "Root Image:\t%s\n", kit.Status.RootImage)
+	w.Writef(0, "Base Image:\t%s\n", kit.Status.BaseImage)
+	w.Writef(0, "Image:\t%s\n", kit.Status.Image)
+	w.Writef(0, "Digest:\t%s\n", kit.Status.Digest)
+	w.Writef(0, "Platform:\t%s\n", kit.Status.Platform)
+	w.Writef(0, "Operator Version:\t%s\n", kit.Status.Version)
+	if kit.Status.Failure != nil {
+		w.Writef(0, "Failure:\t%s\n", kit.Status.Failure.Reason)
+	}
+
+	describeStrings(w, "Dependencies", kit.Spec.Dependencies)
+	if len(kit.Status.Artifacts) > 0 {
+		w.Writef(0, "Artifacts:\n")
+		for _, artifact := range kit.Status.Artifacts {
+			w.Writef(1, "%s\n", artifact.ID)
+		}
+	}
+	if err := describeTraits(w, kit.Spec.Traits); err != nil {
+		return err
+	}
+
+	describeConditions(w, kit.Status.GetConditions())
+	if err := describeEvents(command.Context, c, w, v1.IntegrationKitKind, kit.ObjectMeta); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
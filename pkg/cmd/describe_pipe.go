@@ -0,0 +1,174 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+)
+
+func newDescribePipeCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *describePipeCommandOptions) {
+	options := describePipeCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "pipe [pipe name]",
+		Short:   "Describe a Pipe",
+		Long:    `Describe a Pipe, showing its endpoints, its status and the status of the Integration it generates.`,
+		Args:    validateDescribeArgs("pipe"),
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	return &cmd, &options
+}
+
+type describePipeCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *describePipeCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	pipe := v1.NewPipe(command.Namespace, name)
+	key := k8sclient.ObjectKey{
+		Name:      name,
+		Namespace: command.Namespace,
+	}
+	if err := c.Get(command.Context, key, &pipe); err != nil && k8serrors.IsNotFound(err) {
+		return fmt.Errorf("pipe %q not found in namespace %q", name, command.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	out, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		return command.describePipe(c, &pipe, out)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), out)
+
+	return nil
+}
+
+func (command *describePipeCommandOptions) describePipe(c client.Client, pipe *v1.Pipe, out io.Writer) error {
+	w := indentedwriter.NewWriter(out)
+
+	describeObjectMeta(w, pipe.ObjectMeta)
+	w.Writef(0, "Phase:\t%s\n", pipe.Status.Phase)
+	if pipe.Status.Replicas != nil {
+		w.Writef(0, "Replicas:\t%d\n", *pipe.Status.Replicas)
+	}
+
+	describeEndpoint(w, "Source", pipe.Spec.Source)
+	for i, step := range pipe.Spec.Steps {
+		describeEndpoint(w, fmt.Sprintf("Step %d", i), step)
+	}
+	if pipe.Spec.Choice != nil {
+		for i, when := range pipe.Spec.Choice.When {
+			language := when.Language
+			if language == "" {
+				language = "simple"
+			}
+			describeBranch(w, fmt.Sprintf("When %d (%s: %s)", i, language, when.Expression), when.PipeBranch)
+		}
+		if pipe.Spec.Choice.Otherwise != nil {
+			describeBranch(w, "Otherwise", *pipe.Spec.Choice.Otherwise)
+		}
+	}
+	if pipe.Spec.Multicast != nil {
+		for i, branch := range pipe.Spec.Multicast.Branches {
+			describeBranch(w, fmt.Sprintf("Multicast %d", i), branch)
+		}
+	}
+	if !pipe.Spec.HasBranches() || !pipe.Spec.Sink.IsEmpty() {
+		describeEndpoint(w, "Sink", pipe.Spec.Sink)
+	}
+
+	if err := command.describePipeIntegration(c, pipe, w); err != nil {
+		return err
+	}
+
+	describeConditions(w, pipe.Status.GetConditions())
+	if err := describeEvents(command.Context, c, w, v1.PipeKind, pipe.ObjectMeta); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// describePipeIntegration prints a summary of the Integration generated by the Pipe.
+func (command *describePipeCommandOptions) describePipeIntegration(c client.Client, pipe *v1.Pipe, w *indentedwriter.Writer) error {
+	it, err := getIntegration(command.Context, c, pipe.Name, pipe.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		w.Writef(0, "Integration:\t<not found>\n")
+
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	w.Writef(0, "Integration:\t%s\n", it.Name)
+	w.Writef(1, "Phase:\t%s\n", it.Status.Phase)
+	if it.Status.IntegrationKit != nil {
+		w.Writef(1, "Kit:\t%s/%s\n", it.GetIntegrationKitNamespace(""), it.Status.IntegrationKit.Name)
+	}
+	w.Writef(1, "Image:\t%s\n", it.Status.Image)
+	if it.Status.Traits != nil && it.Status.Traits.Kamelets != nil && it.Status.Traits.Kamelets.List != "" {
+		w.Writef(1, "Kamelets:\t%s\n", strings.ReplaceAll(it.Status.Traits.Kamelets.List, ",", ", "))
+	}
+
+	return nil
+}
+
+func describeBranch(w *indentedwriter.Writer, title string, branch v1.PipeBranch) {
+	for i, step := range branch.Steps {
+		describeEndpoint(w, fmt.Sprintf("%s Step %d", title, i), step)
+	}
+	if branch.Sink != nil {
+		describeEndpoint(w, title+" Sink", *branch.Sink)
+	}
+}
+
+func describeEndpoint(w *indentedwriter.Writer, title string, endpoint v1.Endpoint) {
+	w.Writef(0, "%s:\n", title)
+	if endpoint.Ref != nil {
+		w.Writef(1, "Ref:\t%s/%s %s\n", endpoint.Ref.APIVersion, endpoint.Ref.Kind, endpoint.Ref.Name)
+	}
+	if endpoint.URI != nil {
+		w.Writef(1, "URI:\t%s\n", *endpoint.URI)
+	}
+	if endpoint.Properties != nil && len(endpoint.Properties.RawMessage) > 0 {
+		w.Writef(1, "Properties:\t%s\n", string(endpoint.Properties.RawMessage))
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdDescribe = "describe"
+
+func initializeDescribeCmd(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdDescribe(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func TestDescribeIntegrationNoArgs(t *testing.T) {
+	cmd := initializeDescribeCmd(t)
+	_, err := ExecuteCommand(cmd, cmdDescribe, "integration")
+	require.Error(t, err)
+	assert.Equal(t, "describe integration expects the integration name as argument", err.Error())
+}
+
+func TestDescribeIntegrationNotFound(t *testing.T) {
+	cmd := initializeDescribeCmd(t)
+	_, err := ExecuteCommand(cmd, cmdDescribe, "integration", "missing")
+	require.Error(t, err)
+	assert.Equal(t, `integration "missing" not found in namespace "default"`, err.Error())
+}
+
+func TestDescribeIntegration(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseError
+	it.Status.Image = "my-image"
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "default", Name: "kit-123"}
+	it.Status.Traits = &v1.Traits{
+		Kamelets: &traitv1.KameletsTrait{List: "timer-source,log-sink"},
+		Container: &traitv1.ContainerTrait{
+			Name: "my-container",
+		},
+	}
+	it.Status.Conditions = []v1.IntegrationCondition{
+		{
+			Type:               v1.IntegrationConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             v1.IntegrationConditionErrorReason,
+			Message:            "boom",
+			LastTransitionTime: metav1.Now(),
+		},
+	}
+	kit := v1.NewIntegrationKit("default", "kit-123")
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	build := v1.NewBuild("default", "kit-123")
+	build.Status.Phase = v1.BuildPhaseSucceeded
+	build.Status.Duration = "1m2s"
+	event := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-it.1"},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      v1.IntegrationKind,
+			Namespace: "default",
+			Name:      "my-it",
+		},
+		Type:    corev1.EventTypeWarning,
+		Reason:  "IntegrationError",
+		Message: "cannot deploy",
+	}
+
+	cmd := initializeDescribeCmd(t, &it, kit, build, &event)
+	output, err := ExecuteCommand(cmd, cmdDescribe, "integration", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Phase:")
+	assert.Contains(t, output, "Error")
+	assert.Regexp(t, "Kit:\\s+default/kit-123", output)
+	assert.Regexp(t, "Build:\\s+default/kit-123", output)
+	assert.Contains(t, output, "1m2s")
+	assert.Contains(t, output, "timer-source")
+	assert.Contains(t, output, "log-sink")
+	assert.Contains(t, output, "my-container")
+	assert.Contains(t, output, "boom")
+	assert.Contains(t, output, "cannot deploy")
+}
+
+func TestDescribeIntegrationResources(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Resources = &v1.IntegrationResourcesStatus{
+		Recommendation: &v1.IntegrationResourcesRecommendation{
+			Timestamp:     metav1.Now(),
+			Samples:       12,
+			RequestCPU:    resource.MustParse("230m"),
+			RequestMemory: resource.MustParse("230Mi"),
+			LimitCPU:      resource.MustParse("460m"),
+			LimitMemory:   resource.MustParse("260Mi"),
+		},
+	}
+
+	cmd := initializeDescribeCmd(t, &it)
+	output, err := ExecuteCommand(cmd, cmdDescribe, "integration", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Recommended Resources:")
+	assert.Regexp(t, "Samples:\\s+12", output)
+	assert.Regexp(t, "Request CPU:\\s+230m", output)
+	assert.Regexp(t, "Limit Memory:\\s+260Mi", output)
+	assert.NotContains(t, output, "Applied Resources:")
+}
+
+func TestDescribePipe(t *testing.T) {
+	pipe := v1.NewPipe("default", "my-pipe")
+	pipe.Spec.Source = v1.Endpoint{
+		Ref: &corev1.ObjectReference{Kind: "Kamelet", APIVersion: v1.SchemeGroupVersion.String(), Name: "timer-source"},
+	}
+	pipe.Spec.Sink = v1.Endpoint{
+		URI: new("log:info"),
+	}
+	pipe.Status.Phase = v1.PipePhaseError
+	pipe.Status.Conditions = []v1.PipeCondition{
+		{
+			Type:    v1.PipeConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  "KameletNotFound",
+			Message: "kamelet timer-source not found",
+		},
+	}
+
+	cmd := initializeDescribeCmd(t, &pipe)
+	output, err := ExecuteCommand(cmd, cmdDescribe, "pipe", "my-pipe")
+	require.NoError(t, err)
+	assert.Contains(t, output, "timer-source")
+	assert.Contains(t, output, "log:info")
+	assert.Contains(t, output, "KameletNotFound")
+	assert.Regexp(t, "Integration:\\s+<not found>", output)
+	assert.Regexp(t, "Events:\\s+<none>", output)
+}
+
+func TestDescribeKitAndBuild(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-123")
+	kit.Status.Phase = v1.IntegrationKitPhaseError
+	kit.Status.Artifacts = []v1.Artifact{{ID: "org.apache.camel:camel-core:4.0.0"}}
+	build := v1.NewBuild("default", "kit-123")
+	build.Status.Phase = v1.BuildPhaseFailed
+	build.Status.Error = "maven failure"
+	build.Spec.Tasks = []v1.Task{
+		{Builder: &v1.BuilderTask{BaseTask: v1.BaseTask{Name: "builder"}}},
+		{Jib: &v1.JibTask{BaseTask: v1.BaseTask{Name: "jib"}}},
+	}
+
+	cmd := initializeDescribeCmd(t, kit, build)
+	output, err := ExecuteCommand(cmd, cmdDescribe, "kit", "kit-123")
+	require.NoError(t, err)
+	assert.Contains(t, output, "org.apache.camel:camel-core:4.0.0")
+
+	output, err = ExecuteCommand(cmd, cmdDescribe, "build", "kit-123")
+	require.NoError(t, err)
+	assert.Contains(t, output, "maven failure")
+	assert.Contains(t, output, "builder")
+	assert.Contains(t, output, "jib")
+}
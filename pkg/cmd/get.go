@@ -30,8 +30,12 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 )
 
+const outputWide = "wide"
+
 type getCmdOptions struct {
 	*RootCmdOptions
+
+	OutputFormat string `mapstructure:"output"`
 }
 
 func newCmdGet(rootCmdOptions *RootCmdOptions) (*cobra.Command, *getCmdOptions) {
@@ -47,10 +51,16 @@ func newCmdGet(rootCmdOptions *RootCmdOptions) (*cobra.Command, *getCmdOptions)
 		RunE:       options.run,
 	}
 
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: wide")
+
 	return &cmd, &options
 }
 
 func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if o.OutputFormat != "" && o.OutputFormat != outputWide {
+		return fmt.Errorf("invalid output format %q, the only supported format is %q", o.OutputFormat, outputWide)
+	}
+
 	c, err := o.GetCmdClient()
 	if err != nil {
 		return err
@@ -80,14 +90,29 @@ func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 	}
 
 	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
-	fmt.Fprintln(w, "NAME\tPHASE\tKIT")
+	wide := o.OutputFormat == outputWide
+	if wide {
+		fmt.Fprintln(w, "NAME\tPHASE\tKIT\tRECOMMENDED CPU\tRECOMMENDED MEMORY")
+	} else {
+		fmt.Fprintln(w, "NAME\tPHASE\tKIT")
+	}
 	for _, integration := range integrationList.Items {
 		kit := ""
 		if integration.Status.IntegrationKit != nil {
 			ns := integration.GetIntegrationKitNamespace("")
 			kit = fmt.Sprintf("%s/%s", ns, integration.Status.IntegrationKit.Name)
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit)
+		if !wide {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit)
+
+			continue
+		}
+		cpu, memory := "", ""
+		if resources := integration.Status.Resources; resources != nil && resources.Recommendation != nil {
+			cpu = resources.Recommendation.RequestCPU.String()
+			memory = resources.Recommendation.RequestMemory.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit, cpu, memory)
 	}
 
 	return w.Flush()
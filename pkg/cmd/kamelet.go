@@ -0,0 +1,108 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
+)
+
+func newCmdKamelet(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "kamelet",
+		Short: "Manage Kamelets",
+		Long:  `List, inspect, scaffold, validate and publish Kamelets.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newKameletListCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletGetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletDescribeCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletInitCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletValidateCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletPushCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+// newKameletRepository returns the repository made of the Kamelets installed in the namespace,
+// followed by the given external repositories and by the default remote repository.
+func newKameletRepository(ctx context.Context, c client.Client, namespace string, uris []string) (repository.KameletRepository, error) {
+	repositories := make([]v1.KameletRepositorySpec, 0, len(uris))
+	for _, uri := range uris {
+		repositories = append(repositories, v1.KameletRepositorySpec{URI: uri})
+	}
+
+	return repository.NewWithURIs(ctx, c, repositories, namespace)
+}
+
+func getKamelet(ctx context.Context, repo repository.KameletRepository, name string) (*v1.Kamelet, error) {
+	kamelet, err := repo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if kamelet == nil {
+		return nil, fmt.Errorf("kamelet %q not found in %s", name, repo)
+	}
+	if kamelet.Kind == "" {
+		kamelet.APIVersion = v1.SchemeGroupVersion.String()
+		kamelet.Kind = v1.KameletKind
+	}
+
+	return kamelet, nil
+}
+
+// readKameletFiles reads the given Kamelet files, expanding the directories to the Kamelet files they contain.
+func readKameletFiles(paths []string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		fileNames := []string{path}
+		if info.IsDir() {
+			entries, err := os.ReadDir(path)
+			if err != nil {
+				return nil, err
+			}
+			fileNames = fileNames[:0]
+			for _, entry := range entries {
+				if !entry.IsDir() && isKameletFile(entry.Name()) {
+					fileNames = append(fileNames, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+		for _, fileName := range fileNames {
+			content, err := os.ReadFile(fileName)
+			if err != nil {
+				return nil, err
+			}
+			files[fileName] = content
+		}
+	}
+
+	return files, nil
+}
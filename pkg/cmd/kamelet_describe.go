@@ -0,0 +1,199 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+)
+
+func newKameletDescribeCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletDescribeCommandOptions) {
+	options := kameletDescribeCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "describe [kamelet]",
+		Short:   "Describe a Kamelet",
+		Long:    `Describe a Kamelet, showing the properties of its definition, its data types, dependencies and versions.`,
+		Args:    validateDescribeArgs("kamelet"),
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("version", "", "The version of the Kamelet to describe, as declared in its versions")
+	cmd.Flags().StringArray("repository", nil, "An additional Kamelet repository, e.g. github:apache/camel-kamelets/kamelets, file:/path or oci:registry/repository:tag")
+
+	return &cmd, &options
+}
+
+type kameletDescribeCommandOptions struct {
+	*RootCmdOptions
+
+	Version      string   `mapstructure:"version"`
+	Repositories []string `mapstructure:"repository"`
+}
+
+func (command *kameletDescribeCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	repo, err := newKameletRepository(command.Context, c, command.Namespace, command.Repositories)
+	if err != nil {
+		return err
+	}
+	kamelet, err := getKamelet(command.Context, repo, args[0])
+	if err != nil {
+		return err
+	}
+	versions := util.SortedMapKeys(kamelet.Spec.Versions)
+	if command.Version != "" {
+		if kamelet, err = kamelet.CloneWithVersion(command.Version); err != nil {
+			return err
+		}
+	}
+
+	out, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		return describeKamelet(kamelet, versions, out)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), out)
+
+	return nil
+}
+
+func describeKamelet(kamelet *v1.Kamelet, versions []string, out io.Writer) error {
+	w := indentedwriter.NewWriter(out)
+
+	describeObjectMeta(w, kamelet.ObjectMeta)
+	w.Writef(0, "Type:\t%s\n", kamelet.Labels[v1.KameletTypeLabel])
+	w.Writef(0, "Phase:\t%s\n", kamelet.Status.Phase)
+	if definition := kamelet.Spec.Definition; definition != nil {
+		w.Writef(0, "Title:\t%s\n", definition.Title)
+		w.Writef(0, "Description:\t%s\n", strings.TrimSpace(definition.Description))
+	}
+	describeStrings(w, "Versions", versions)
+
+	describeKameletProperties(w, kamelet)
+	describeKameletDataTypes(w, kamelet)
+	describeStrings(w, "Dependencies", kamelet.Spec.Dependencies)
+	describeConditions(w, kamelet.Status.GetConditions())
+
+	return w.Flush()
+}
+
+func describeKameletProperties(w *indentedwriter.Writer, kamelet *v1.Kamelet) {
+	keys := kamelet.SortedDefinitionPropertiesKeys()
+	if len(keys) == 0 {
+		return
+	}
+
+	w.Writef(0, "Properties:\n")
+	for _, key := range keys {
+		property := kamelet.Spec.Definition.Properties[key]
+		w.Writef(1, "%s:\n", key)
+		if property.Title != "" {
+			w.Writef(2, "Title:\t%s\n", property.Title)
+		}
+		w.Writef(2, "Type:\t%s\n", property.Type)
+		if property.Format != "" {
+			w.Writef(2, "Format:\t%s\n", property.Format)
+		}
+		w.Writef(2, "Required:\t%t\n", slices.Contains(kamelet.Spec.Definition.Required, key))
+		if property.Default != nil {
+			w.Writef(2, "Default:\t%s\n", jsonValue(property.Default))
+		}
+		if len(property.Enum) > 0 {
+			values := make([]string, 0, len(property.Enum))
+			for i := range property.Enum {
+				values = append(values, jsonValue(&property.Enum[i]))
+			}
+			w.Writef(2, "Enum:\t%s\n", strings.Join(values, ", "))
+		}
+		if property.Example != nil {
+			w.Writef(2, "Example:\t%s\n", jsonValue(property.Example))
+		}
+		if property.Deprecated {
+			w.Writef(2, "Deprecated:\t%t\n", property.Deprecated)
+		}
+		if property.Description != "" {
+			w.Writef(2, "Description:\t%s\n", strings.TrimSpace(property.Description))
+		}
+	}
+}
+
+func describeKameletDataTypes(w *indentedwriter.Writer, kamelet *v1.Kamelet) {
+	slots := kamelet.SortedTypesKeys()
+	if len(slots) == 0 {
+		return
+	}
+
+	w.Writef(0, "Data Types:\n")
+	for _, slot := range slots {
+		dataTypes := kamelet.Spec.DataTypes[slot]
+		w.Writef(1, "%s:\n", slot)
+		if dataTypes.Default != "" {
+			w.Writef(2, "Default:\t%s\n", dataTypes.Default)
+		}
+		for _, name := range util.SortedMapKeys(dataTypes.Types) {
+			dataType := dataTypes.Types[name]
+			w.Writef(2, "%s:\n", name)
+			if dataType.Scheme != "" {
+				w.Writef(3, "Scheme:\t%s\n", dataType.Scheme)
+			}
+			if dataType.Format != "" {
+				w.Writef(3, "Format:\t%s\n", dataType.Format)
+			}
+			if dataType.MediaType != "" {
+				w.Writef(3, "Media Type:\t%s\n", dataType.MediaType)
+			}
+			if dataType.Description != "" {
+				w.Writef(3, "Description:\t%s\n", strings.TrimSpace(dataType.Description))
+			}
+			if dataType.Schema != nil && len(dataType.Schema.Properties) > 0 {
+				w.Writef(3, "Schema:\t%s\n", strings.Join(util.SortedMapKeys(dataType.Schema.Properties), ", "))
+			}
+		}
+		for _, name := range util.SortedMapKeys(dataTypes.Headers) {
+			header := dataTypes.Headers[name]
+			w.Writef(2, "Header %s:\t%s (required: %t)\n", name, header.Type, header.Required)
+		}
+	}
+}
+
+// jsonValue renders a JSON value, without the quotes when it is a string.
+func jsonValue(value *v1.JSON) string {
+	var s string
+	if err := json.Unmarshal(value.RawMessage, &s); err == nil {
+		return s
+	}
+
+	return string(value.RawMessage)
+}
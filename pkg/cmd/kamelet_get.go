@@ -0,0 +1,86 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func newKameletGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletGetCommandOptions) {
+	options := kameletGetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "get [kamelet]",
+		Short:   "Get the definition of a Kamelet",
+		Long:    `Get the definition of a Kamelet, looking it up in the namespace and in the Kamelet repositories.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().StringP("output", "o", "yaml", "Output format. One of: json|yaml")
+	cmd.Flags().String("version", "", "The version of the Kamelet to get, as declared in its versions")
+	cmd.Flags().StringArray("repository", nil, "An additional Kamelet repository, e.g. github:apache/camel-kamelets/kamelets, file:/path or oci:registry/repository:tag")
+
+	return &cmd, &options
+}
+
+type kameletGetCommandOptions struct {
+	*RootCmdOptions
+
+	OutputFormat string   `mapstructure:"output"`
+	Version      string   `mapstructure:"version"`
+	Repositories []string `mapstructure:"repository"`
+}
+
+func (command *kameletGetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("kamelet get expects a kamelet name argument")
+	}
+
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	repo, err := newKameletRepository(command.Context, c, command.Namespace, command.Repositories)
+	if err != nil {
+		return err
+	}
+	kamelet, err := getKamelet(command.Context, repo, args[0])
+	if err != nil {
+		return err
+	}
+	if command.Version != "" {
+		if kamelet, err = kamelet.CloneWithVersion(command.Version); err != nil {
+			return err
+		}
+	}
+
+	printer := printers.NewTypeSetter(c.GetScheme())
+	printer.Delegate = &kubernetes.CLIPrinter{
+		Format: command.OutputFormat,
+	}
+
+	return printer.PrintObj(kamelet, cmd.OutOrStdout())
+}
@@ -0,0 +1,230 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/io"
+)
+
+// kameletTemplates contains the skeleton of the Kamelets scaffolded by `kamel kamelet init`, by type.
+var kameletTemplates = map[string]string{
+	v1.KameletTypeSource: `    from:
+      uri: "timer:tick"
+      parameters:
+        period: "{{period}}"
+      steps:
+        - setBody:
+            constant: "{{message}}"
+        - to: "kamelet:sink"
+`,
+	v1.KameletTypeSink: `    from:
+      uri: "kamelet:source"
+      steps:
+        - to:
+            uri: "log:{{loggerName}}"
+`,
+	v1.KameletTypeAction: `    from:
+      uri: "kamelet:source"
+      steps:
+        - setHeader:
+            name: "{{headerName}}"
+            constant: "{{headerValue}}"
+`,
+}
+
+// kameletDefinitionTemplates contains the definition properties matching the skeleton templates, by type.
+var kameletDefinitionTemplates = map[string]string{
+	v1.KameletTypeSource: `      period:
+        title: Period
+        description: The interval between two messages, in milliseconds
+        type: integer
+        default: 1000
+      message:
+        title: Message
+        description: The message to generate
+        type: string
+        example: hello world`,
+	v1.KameletTypeSink: `      loggerName:
+        title: Logger Name
+        description: The name of the logger
+        type: string
+        default: {{ .Name }}`,
+	v1.KameletTypeAction: `      headerName:
+        title: Header Name
+        description: The name of the header to set
+        type: string
+      headerValue:
+        title: Header Value
+        description: The value of the header to set
+        type: string`,
+}
+
+var kameletRequiredTemplates = map[string][]string{
+	v1.KameletTypeSource: {"message"},
+	v1.KameletTypeSink:   {},
+	v1.KameletTypeAction: {"headerName", "headerValue"},
+}
+
+const kameletTemplate = `apiVersion: camel.apache.org/v1
+kind: Kamelet
+metadata:
+  name: {{ .Name }}
+  labels:
+    camel.apache.org/kamelet.type: {{ .Type }}
+spec:
+  definition:
+    title: {{ .Title }}
+    description: {{ .Description }}
+{{- if .Required }}
+    required:
+{{- range .Required }}
+      - {{ . }}
+{{- end }}
+{{- end }}
+    type: object
+    properties:
+{{ .Properties }}
+  dataTypes:
+    {{ .Slot }}:
+      default: text
+      types:
+        text:
+          format: text
+          mediaType: text/plain
+  template:
+{{ .Template }}`
+
+func newKameletInitCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletInitCommandOptions) {
+	options := kameletInitCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:         "init [kamelet]",
+		Short:       "Scaffold a new Kamelet",
+		Long:        `Scaffold a new Kamelet of the given type, as a file named after the Kamelet, ready to be customized.`,
+		PreRunE:     decode(&options, options.Flags),
+		RunE:        options.run,
+		Annotations: map[string]string{offlineCommandLabel: "true"},
+	}
+
+	cmd.Flags().String("type", v1.KameletTypeSource, "The type of the Kamelet. One of: source|sink|action")
+	cmd.Flags().String("title", "", "The title of the Kamelet")
+	cmd.Flags().String("dir", ".", "The directory where the Kamelet file is created")
+
+	return &cmd, &options
+}
+
+type kameletInitCommandOptions struct {
+	*RootCmdOptions
+
+	Type  string `mapstructure:"type"`
+	Title string `mapstructure:"title"`
+	Dir   string `mapstructure:"dir"`
+}
+
+func (command *kameletInitCommandOptions) run(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("kamelet init expects a kamelet name argument")
+	}
+	name := args[0]
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid kamelet name %q: %s", name, strings.Join(errs, ", "))
+	}
+	if !v1.ValidKameletName(name) {
+		return fmt.Errorf("kamelet name %q is reserved", name)
+	}
+	if _, ok := kameletTemplates[command.Type]; !ok {
+		return fmt.Errorf("invalid kamelet type %q, expected one of: source|sink|action", command.Type)
+	}
+
+	content, err := scaffoldKamelet(name, command.Type, command.Title)
+	if err != nil {
+		return err
+	}
+
+	fileName := filepath.Join(command.Dir, name+".kamelet.yaml")
+	if _, err := os.Stat(fileName); err == nil {
+		return fmt.Errorf("file %s already exists", fileName)
+	}
+	if err := os.MkdirAll(command.Dir, io.FilePerm755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(fileName, content, io.FilePerm644); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s kamelet %q created in %s\n", command.Type, name, fileName)
+
+	return nil
+}
+
+func scaffoldKamelet(name, kameletType, title string) ([]byte, error) {
+	if title == "" {
+		title = name
+	}
+	slot := v1.TypeSlotOut
+	if kameletType != v1.KameletTypeSource {
+		slot = v1.TypeSlotIn
+	}
+	data := map[string]any{
+		"Name":        name,
+		"Type":        kameletType,
+		"Title":       title,
+		"Description": fmt.Sprintf("A %s Kamelet", kameletType),
+		"Required":    kameletRequiredTemplates[kameletType],
+		"Slot":        slot,
+	}
+
+	var err error
+	if data["Properties"], err = executeTemplate(kameletDefinitionTemplates[kameletType], data); err != nil {
+		return nil, err
+	}
+	// the route templates are not processed, as they contain the Kamelet property placeholders
+	data["Template"] = kameletTemplates[kameletType]
+	content, err := executeTemplate(kameletTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
+func executeTemplate(text string, data map[string]any) (string, error) {
+	tmpl, err := template.New("kamelet").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
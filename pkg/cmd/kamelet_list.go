@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+func newKameletListCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletListCommandOptions) {
+	options := kameletListCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the available Kamelets",
+		Long:    `List the Kamelets available in the namespace and in the Kamelet repositories, with their type, versions and properties.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("type", "", "Only list the Kamelets of the given type. One of: source|sink|action")
+	cmd.Flags().StringArray("repository", nil, "An additional Kamelet repository, e.g. github:apache/camel-kamelets/kamelets, file:/path or oci:registry/repository:tag")
+
+	return &cmd, &options
+}
+
+type kameletListCommandOptions struct {
+	*RootCmdOptions
+
+	Type         string   `mapstructure:"type"`
+	Repositories []string `mapstructure:"repository"`
+}
+
+func (command *kameletListCommandOptions) run(cmd *cobra.Command, _ []string) error {
+	switch command.Type {
+	case "", v1.KameletTypeSource, v1.KameletTypeSink, v1.KameletTypeAction:
+	default:
+		return fmt.Errorf("invalid kamelet type %q, expected one of: source|sink|action", command.Type)
+	}
+
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	repo, err := newKameletRepository(command.Context, c, command.Namespace, command.Repositories)
+	if err != nil {
+		return err
+	}
+	names, err := repo.List(command.Context)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tVERSIONS\tPROPERTIES")
+	for _, name := range names {
+		kamelet, err := getKamelet(command.Context, repo, name)
+		if err != nil {
+			return err
+		}
+		kameletType := kamelet.Labels[v1.KameletTypeLabel]
+		if command.Type != "" && kameletType != command.Type {
+			continue
+		}
+		versions := util.SortedMapKeys(kamelet.Spec.Versions)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, kameletType, strings.Join(versions, ","), strings.Join(kameletProperties(kamelet), ","))
+	}
+
+	return w.Flush()
+}
+
+// kameletProperties returns the properties resolved by the operator, or the ones declared by the
+// definition when the Kamelet has not been reconciled (e.g., when it comes from a remote repository).
+func kameletProperties(kamelet *v1.Kamelet) []string {
+	properties := make([]string, 0)
+	if len(kamelet.Status.Properties) > 0 {
+		for _, property := range kamelet.Status.Properties {
+			if property.Default != "" {
+				properties = append(properties, property.Name+"="+property.Default)
+			} else {
+				properties = append(properties, property.Name)
+			}
+		}
+		slices.Sort(properties)
+
+		return properties
+	}
+
+	return append(properties, kamelet.SortedDefinitionPropertiesKeys()...)
+}
@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func newKameletPushCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletPushCommandOptions) {
+	options := kameletPushCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "push [oci:registry/repository:tag] [files or directories]",
+		Short: "Publish Kamelets to an OCI registry",
+		Long: `Publish Kamelet files as an OCI artifact, which can then be used as an oci: Kamelet repository. ` +
+			`The Kamelets are validated before being pushed. The registry credentials are taken from the ` +
+			`KAMELET_REPOSITORY_OCI_USERNAME and KAMELET_REPOSITORY_OCI_PASSWORD environment variables, or from the Docker configuration.`,
+		PreRunE:     decode(&options, options.Flags),
+		RunE:        options.run,
+		Annotations: map[string]string{offlineCommandLabel: "true"},
+	}
+
+	cmd.Flags().Bool("skip-validation", false, "Push the Kamelets without validating them")
+
+	return &cmd, &options
+}
+
+type kameletPushCommandOptions struct {
+	*RootCmdOptions
+
+	SkipValidation bool `mapstructure:"skip-validation"`
+}
+
+func (command *kameletPushCommandOptions) run(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return errors.New("kamelet push expects an OCI reference and at least a kamelet file or directory")
+	}
+	files, err := readKameletFiles(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if !command.SkipValidation {
+		catalog, err := camel.DefaultCatalog()
+		if err != nil {
+			return err
+		}
+		report := validationReport{
+			Findings: make([]validationFinding, 0),
+		}
+		for _, fileName := range util.SortedMapKeys(files) {
+			validateKamelet(&report, catalog, fileName, files[fileName])
+		}
+		if report.errors() > 0 {
+			if err := printValidationReport(cmd, "text", report); err != nil {
+				return err
+			}
+
+			return fmt.Errorf("validation failed with %d error(s), nothing pushed", report.errors())
+		}
+	}
+
+	artifactFiles := make(map[string][]byte, len(files))
+	for fileName, content := range files {
+		base := filepath.Base(fileName)
+		if _, ok := artifactFiles[base]; ok {
+			return fmt.Errorf("duplicate kamelet file name %s", base)
+		}
+		artifactFiles[base] = content
+	}
+
+	digest, err := repository.PushOCI(command.Context, args[0], artifactFiles)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%d kamelet(s) pushed to oci:%s\n", len(artifactFiles), digest)
+
+	return nil
+}
@@ -0,0 +1,209 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdKamelet = "kamelet"
+
+func initializeKameletCmd(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdKamelet(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func testKamelet(name, kameletType string) *v1.Kamelet {
+	kamelet := v1.NewKamelet("default", name)
+	kamelet.Labels = map[string]string{v1.KameletTypeLabel: kameletType}
+	kamelet.Spec.Definition = &v1.JSONSchemaProps{
+		Title:    "My " + kameletType,
+		Required: []string{"topic"},
+		Properties: map[string]v1.JSONSchemaProp{
+			"topic": {
+				Title:       "Topic",
+				Type:        "string",
+				Description: "The topic name",
+			},
+			"period": {
+				Type:    "integer",
+				Default: &v1.JSON{RawMessage: []byte("1000")},
+			},
+		},
+	}
+	kamelet.Spec.DataTypes = map[v1.TypeSlot]v1.DataTypesSpec{
+		v1.TypeSlotOut: {
+			Default: "json",
+			Types: map[string]v1.DataTypeSpec{
+				"json": {Format: "json", MediaType: "application/json"},
+			},
+		},
+	}
+	kamelet.Spec.Versions = map[string]v1.KameletSpecBase{"v1": {}, "v2": {}}
+	kamelet.Status.Properties = []v1.KameletProperty{{Name: "topic"}, {Name: "period", Default: "1000"}}
+
+	return &kamelet
+}
+
+func TestKameletList(t *testing.T) {
+	cmd := initializeKameletCmd(t, testKamelet("my-source", v1.KameletTypeSource), testKamelet("my-sink", v1.KameletTypeSink))
+
+	output, err := ExecuteCommand(cmd, cmdKamelet, "list")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "TYPE", "VERSIONS", "PROPERTIES"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"my-sink", "sink", "v1,v2", "period=1000,topic"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"my-source", "source", "v1,v2", "period=1000,topic"}, strings.Fields(lines[2]))
+
+	output, err = ExecuteCommand(cmd, cmdKamelet, "list", "--type", "source")
+	require.NoError(t, err)
+	assert.Contains(t, output, "my-source")
+	assert.NotContains(t, output, "my-sink")
+
+	_, err = ExecuteCommand(cmd, cmdKamelet, "list", "--type", "processor")
+	require.Error(t, err)
+	assert.Equal(t, `invalid kamelet type "processor", expected one of: source|sink|action`, err.Error())
+}
+
+func TestKameletGet(t *testing.T) {
+	cmd := initializeKameletCmd(t, testKamelet("my-source", v1.KameletTypeSource))
+
+	output, err := ExecuteCommand(cmd, cmdKamelet, "get", "my-source")
+	require.NoError(t, err)
+	assert.Contains(t, output, "kind: Kamelet")
+	assert.Contains(t, output, "name: my-source")
+
+	output, err = ExecuteCommand(cmd, cmdKamelet, "get", "my-source", "-o", "json", "--version", "v1")
+	require.NoError(t, err)
+	assert.Contains(t, output, `"name":"my-source"`)
+	assert.NotContains(t, output, `"versions"`)
+
+	_, err = ExecuteCommand(cmd, cmdKamelet, "get", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `kamelet "missing" not found`)
+}
+
+func TestKameletDescribe(t *testing.T) {
+	cmd := initializeKameletCmd(t, testKamelet("my-source", v1.KameletTypeSource))
+
+	output, err := ExecuteCommand(cmd, cmdKamelet, "describe", "my-source")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Type:")
+	assert.Contains(t, output, "Title:")
+	assert.Contains(t, output, "My source")
+	assert.Contains(t, output, "Versions:")
+	assert.Contains(t, output, "Properties:")
+	assert.Contains(t, output, "topic:")
+	assert.Contains(t, output, "The topic name")
+	assert.Contains(t, output, "Data Types:")
+	assert.Contains(t, output, "application/json")
+}
+
+func TestKameletInitAndValidate(t *testing.T) {
+	dir := t.TempDir()
+	cmd := initializeKameletCmd(t)
+
+	for _, kameletType := range []string{v1.KameletTypeSource, v1.KameletTypeSink, v1.KameletTypeAction} {
+		output, err := ExecuteCommand(cmd, cmdKamelet, "init", "my-"+kameletType, "--type", kameletType, "--dir", dir)
+		require.NoError(t, err)
+		assert.Contains(t, output, filepath.Join(dir, "my-"+kameletType+".kamelet.yaml"))
+	}
+
+	_, err := ExecuteCommand(cmd, cmdKamelet, "init", "my-source", "--dir", dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	output, err := ExecuteCommand(cmd, cmdKamelet, "validate", dir)
+	require.NoError(t, err)
+	assert.Equal(t, "No problems found\n", output)
+}
+
+func TestKameletValidateErrors(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "broken.kamelet.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+apiVersion: camel.apache.org/v1
+kind: Kamelet
+metadata:
+  name: broken-sink
+  labels:
+    camel.apache.org/kamelet.type: sink
+spec:
+  definition:
+    required:
+      - topic
+    properties:
+      period:
+        type: integer
+  template:
+    from:
+      uri: "timer:tick"
+      steps:
+        - to: "log:{{loggerName}}"
+`), 0o600))
+	cmd := initializeKameletCmd(t)
+
+	output, err := ExecuteCommand(cmd, cmdKamelet, "validate", file, "-o", "json")
+	require.Error(t, err)
+	assert.Equal(t, "validation failed with 2 error(s)", err.Error())
+	assert.Contains(t, output, `required property \"topic\" is not declared by the definition`)
+	assert.Contains(t, output, `"ruleId": "kamelet-undeclared-property"`)
+	assert.Contains(t, output, "sink kamelet does not consume from kamelet:source")
+}
+
+func TestKameletPush(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	dir := t.TempDir()
+	cmd := initializeKameletCmd(t)
+
+	_, err := ExecuteCommand(cmd, cmdKamelet, "init", "my-source", "--dir", dir)
+	require.NoError(t, err)
+
+	ref := "oci:" + strings.TrimPrefix(server.URL, "http://") + "/camel/kamelets:1.0"
+	output, err := ExecuteCommand(cmd, cmdKamelet, "push", ref, dir)
+	require.NoError(t, err)
+	assert.Contains(t, output, "1 kamelet(s) pushed to oci:"+strings.TrimPrefix(server.URL, "http://")+"/camel/kamelets@sha256:")
+
+	output, err = ExecuteCommand(cmd, cmdKamelet, "list", "--repository", ref)
+	require.NoError(t, err)
+	assert.Contains(t, output, "my-source")
+}
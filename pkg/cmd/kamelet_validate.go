@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	utilkamelet "github.com/apache/camel-k/v2/pkg/kamelet"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func newKameletValidateCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletValidateCommandOptions) {
+	options := kameletValidateCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "validate [files or directories]",
+		Short: "Validate Kamelets without a cluster",
+		Long: `Validate Kamelet files offline: the definition, the type, the property placeholders and the endpoints ` +
+			`of the template are checked against the Camel runtime catalog. The command fails when an error is found.`,
+		PreRunE:     decode(&options, options.Flags),
+		RunE:        options.run,
+		Annotations: map[string]string{offlineCommandLabel: "true"},
+	}
+
+	cmd.Flags().StringP("output", "o", "text", "Output format. One of: text|json|sarif")
+
+	return &cmd, &options
+}
+
+type kameletValidateCommandOptions struct {
+	*RootCmdOptions
+
+	OutputFormat string `mapstructure:"output"`
+}
+
+func (command *kameletValidateCommandOptions) run(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("kamelet validate expects at least a kamelet file or directory")
+	}
+	switch command.OutputFormat {
+	case "text", "json", "sarif":
+	default:
+		return fmt.Errorf("invalid output format %q, expected one of: text|json|sarif", command.OutputFormat)
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+	files, err := readKameletFiles(args)
+	if err != nil {
+		return err
+	}
+
+	report := validationReport{
+		Findings: make([]validationFinding, 0),
+	}
+	for _, fileName := range util.SortedMapKeys(files) {
+		validateKamelet(&report, catalog, fileName, files[fileName])
+	}
+
+	report.Valid = report.errors() == 0
+	if err := printValidationReport(cmd, command.OutputFormat, report); err != nil {
+		return err
+	}
+	if !report.Valid {
+		return fmt.Errorf("validation failed with %d error(s)", report.errors())
+	}
+
+	return nil
+}
+
+func validateKamelet(report *validationReport, catalog *camel.RuntimeCatalog, location string, content []byte) {
+	kamelet := v1.Kamelet{}
+	data, err := yaml.ToJSON(content)
+	if err == nil {
+		err = json.Unmarshal(data, &kamelet)
+	}
+	if err != nil {
+		report.add(validationRuleKamelet, validationLevelError, location, "cannot parse kamelet: %s", err.Error())
+
+		return
+	}
+	if kamelet.Kind != v1.KameletKind {
+		report.add(validationRuleKamelet, validationLevelError, location, "expected kind %s, got %q", v1.KameletKind, kamelet.Kind)
+
+		return
+	}
+
+	for _, issue := range utilkamelet.Validate(catalog, &kamelet) {
+		ruleID := validationRuleKamelet
+		switch issue.Reason {
+		case utilkamelet.ReasonUndeclaredProperty:
+			ruleID = validationRuleKameletPlaceholder
+		case utilkamelet.ReasonUnknownComponent:
+			ruleID = validationRuleUnknownComponent
+		}
+		issueLocation := location
+		if issue.Version != "" {
+			issueLocation += "@" + issue.Version
+		}
+		report.add(ruleID, string(issue.Severity), issueLocation, "%s", issue.Message)
+	}
+}
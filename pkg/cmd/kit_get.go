@@ -18,10 +18,15 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,15 +39,19 @@ func newKitGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitGetComman
 	}
 
 	cmd := cobra.Command{
-		Use:     "get",
+		Use:     "get [kit]",
 		Short:   "Get defined Integration Kit",
-		Long:    `Get defined Integration Kit.`,
+		Long:    `Get defined Integration Kit, or the software bill of materials of a kit with --sbom.`,
 		PreRunE: decode(&options, options.Flags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := options.validate(cmd, args); err != nil {
 				return err
 			}
 
+			if options.SBOM {
+				return options.runSBOM(cmd, args[0])
+			}
+
 			return options.run(cmd)
 		},
 	}
@@ -50,6 +59,8 @@ func newKitGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitGetComman
 	cmd.Flags().Bool(v1.IntegrationKitTypeUser, true, "Includes user Kits")
 	cmd.Flags().Bool(v1.IntegrationKitTypeExternal, true, "Includes external Kits")
 	cmd.Flags().Bool(v1.IntegrationKitTypePlatform, true, "Includes platform Kits")
+	cmd.Flags().Bool("sbom", false, "Print the software bill of materials of the given Kit image")
+	cmd.Flags().StringP("output", "o", "", "Output format of the software bill of materials. One of: json")
 
 	return &cmd, &options
 }
@@ -57,12 +68,24 @@ func newKitGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitGetComman
 type kitGetCommandOptions struct {
 	*RootCmdOptions
 
-	User     bool `mapstructure:"user"`
-	External bool `mapstructure:"external"`
-	Platform bool `mapstructure:"platform"`
+	User     bool   `mapstructure:"user"`
+	External bool   `mapstructure:"external"`
+	Platform bool   `mapstructure:"platform"`
+	SBOM     bool   `mapstructure:"sbom"`
+	Output   string `mapstructure:"output"`
 }
 
 func (command *kitGetCommandOptions) validate(cmd *cobra.Command, args []string) error {
+	if !command.SBOM {
+		return nil
+	}
+	if len(args) != 1 {
+		return errors.New("kit get --sbom expects a kit name argument")
+	}
+	if command.Output != "" && command.Output != "json" {
+		return fmt.Errorf("invalid output format option '%s', should be json", command.Output)
+	}
+
 	return nil
 }
 
@@ -91,3 +114,71 @@ func (command *kitGetCommandOptions) run(cmd *cobra.Command) error {
 
 	return w.Flush()
 }
+
+// sbomDocument is the subset of a CycloneDX document printed by the command.
+type sbomDocument struct {
+	Components []struct {
+		Type    string `json:"type"`
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		Hashes  []struct {
+			Algorithm string `json:"alg"`
+			Content   string `json:"content"`
+		} `json:"hashes"`
+	} `json:"components"`
+}
+
+func (command *kitGetCommandOptions) runSBOM(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	kit := v1.NewIntegrationKit(command.Namespace, name)
+	if err := c.Get(command.Context, k8sclient.ObjectKeyFromObject(kit), kit); err != nil {
+		return err
+	}
+	content, err := getAttestationContent(command.Context, c, kit, v1.AttestationTypeSBOM)
+	if err != nil {
+		return err
+	}
+
+	if command.Output == "json" {
+		fmt.Fprintln(cmd.OutOrStdout(), content)
+
+		return nil
+	}
+
+	var sbom sbomDocument
+	if err := json.Unmarshal([]byte(content), &sbom); err != nil {
+		return fmt.Errorf("cannot parse software bill of materials of kit %s: %w", name, err)
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tTYPE\tHASH")
+	for _, component := range sbom.Components {
+		hashes := make([]string, 0, len(component.Hashes))
+		for _, hash := range component.Hashes {
+			hashes = append(hashes, fmt.Sprintf("%s:%s", strings.ToLower(hash.Algorithm), hash.Content))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", component.Name, component.Version, component.Type, strings.Join(hashes, ","))
+	}
+
+	return w.Flush()
+}
+
+func getAttestationContent(ctx context.Context, c k8sclient.Reader, kit *v1.IntegrationKit, attestationType v1.AttestationType) (string, error) {
+	attestation := kit.Status.GetAttestation(attestationType)
+	if attestation == nil || attestation.ConfigMapKeyRef == nil {
+		return "", fmt.Errorf("no %s attestation found for kit %s", attestationType, kit.Name)
+	}
+	cm := corev1.ConfigMap{}
+	key := k8sclient.ObjectKey{Namespace: kit.Namespace, Name: attestation.ConfigMapKeyRef.Name}
+	if err := c.Get(ctx, key, &cm); err != nil {
+		return "", err
+	}
+	content, ok := cm.Data[attestation.ConfigMapKeyRef.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in configmap %s", attestation.ConfigMapKeyRef.Key, cm.Name)
+	}
+
+	return content, nil
+}
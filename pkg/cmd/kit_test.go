@@ -18,7 +18,17 @@ limitations under the License.
 package cmd
 
 import (
+	"testing"
+
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
 )
 
 //nolint:deadcode,unused
@@ -29,3 +39,64 @@ func newTestCmdKit(options *RootCmdOptions) *cobra.Command {
 
 	return kitCmd
 }
+
+const cmdKitGet = "get"
+
+func initializeKitGetCmd(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(cmdOnly(newKitGetCmd(options)))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func TestKitGetSBOMNoArgs(t *testing.T) {
+	cmd := initializeKitGetCmd(t)
+	_, err := ExecuteCommand(cmd, cmdKitGet, "--sbom")
+	require.Error(t, err)
+	assert.Equal(t, "kit get --sbom expects a kit name argument", err.Error())
+}
+
+func TestKitGetSBOMMissingAttestation(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	cmd := initializeKitGetCmd(t, kit)
+	_, err := ExecuteCommand(cmd, cmdKitGet, "--sbom", "my-kit")
+	require.Error(t, err)
+	assert.Equal(t, "no SBOM attestation found for kit my-kit", err.Error())
+}
+
+func TestKitGetSBOM(t *testing.T) {
+	sbom := `{"components":[` +
+		`{"type":"container","name":"eclipse-temurin","version":"sha256:123","hashes":[{"alg":"SHA-256","content":"123"}]},` +
+		`{"type":"library","name":"org.apache.camel.camel-core","version":"4.0.0","hashes":[{"alg":"SHA-1","content":"abc"}]}]}`
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	kit.Status.Attestations = []v1.Attestation{{
+		Type:      v1.AttestationTypeSBOM,
+		MediaType: "application/vnd.cyclonedx+json",
+		ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "my-kit-attestations"},
+			Key:                  "sbom.cdx.json",
+		},
+	}}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-kit-attestations"},
+		Data:       map[string]string{"sbom.cdx.json": sbom},
+	}
+	cmd := initializeKitGetCmd(t, kit, cm)
+
+	output, err := ExecuteCommand(cmd, cmdKitGet, "--sbom", "my-kit")
+	require.NoError(t, err)
+	assert.Contains(t, output, "NAME")
+	assert.Contains(t, output, "eclipse-temurin")
+	assert.Contains(t, output, "sha256:123")
+	assert.Contains(t, output, "org.apache.camel.camel-core\t4.0.0")
+	assert.Contains(t, output, "sha-1:abc")
+
+	output, err = ExecuteCommand(cmd, cmdKitGet, "--sbom", "my-kit", "-o", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, sbom, output)
+}
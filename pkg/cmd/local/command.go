@@ -0,0 +1,316 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/io"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+// DefaultContainerEngine is the container engine used to run the Integration container locally.
+const DefaultContainerEngine = "docker"
+
+var shellSafeRegexp = regexp.MustCompile(`^[\w@%+=:,./\-*]+$`)
+
+// Command is the command executing an Integration on the local host.
+type Command struct {
+	// Name is the executable
+	Name string
+	// Args are the executable arguments
+	Args []string
+	// Env are the environment variables, in the key=value form, added to the current process ones
+	Env []string
+	// Dir is the working directory
+	Dir string
+}
+
+// String returns the shell representation of the command.
+func (c *Command) String() string {
+	items := make([]string, 0, len(c.Env)+len(c.Args)+1)
+	for _, e := range c.Env {
+		items = append(items, quote(e))
+	}
+	items = append(items, quote(c.Name))
+	for _, a := range c.Args {
+		items = append(items, quote(a))
+	}
+
+	return strings.Join(items, " ")
+}
+
+// Exec returns the process executing the command.
+func (c *Command) Exec(ctx context.Context) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, c.Name, c.Args...)
+	cmd.Dir = c.Dir
+	cmd.Env = append(os.Environ(), c.Env...)
+
+	return cmd
+}
+
+func quote(s string) string {
+	if shellSafeRegexp.MatchString(s) {
+		return s
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// NewCommand returns the command running the Integration as a Java process on the local host. The volumes of the
+// integration container are materialized under the local working directory and any reference to them is relocated
+// accordingly.
+func NewCommand(env *trait.Environment, dir string, configMaps []*corev1.ConfigMap) (*Command, error) {
+	container, err := integrationContainer(env)
+	if err != nil {
+		return nil, err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	root := filepath.Join(dir, RootDir)
+	relocate := newRelocator(root, container.VolumeMounts)
+	if _, err := materialize(env, container, root, configMaps, relocate); err != nil {
+		return nil, err
+	}
+
+	cmd := Command{
+		Name: container.Command[0],
+		Dir:  filepath.Join(dir, DeploymentsDir),
+	}
+	for _, a := range container.Command[1:] {
+		cmd.Args = append(cmd.Args, relocate.Replace(a))
+	}
+	for _, a := range container.Args {
+		cmd.Args = append(cmd.Args, relocate.Replace(a))
+	}
+	for _, e := range container.Env {
+		cmd.Env = append(cmd.Env, e.Name+"="+relocate.Replace(e.Value))
+	}
+
+	return &cmd, nil
+}
+
+// NewContainerCommand returns the command running the Integration container with the given container engine and image.
+// The volumes of the integration container are materialized under the local working directory and bind mounted.
+func NewContainerCommand(env *trait.Environment, dir string, configMaps []*corev1.ConfigMap, engine string, image string) (*Command, error) {
+	container, err := integrationContainer(env)
+	if err != nil {
+		return nil, err
+	}
+	mounts, err := materialize(env, container, filepath.Join(dir, RootDir), configMaps, nil)
+	if err != nil {
+		return nil, err
+	}
+	deploymentsDir, err := filepath.Abs(filepath.Join(dir, DeploymentsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"run", "--rm", "--network", "host"}
+	args = append(args, "-v", deploymentsDir+":"+container.WorkingDir)
+	for _, m := range mounts {
+		args = append(args, "-v", m.host+":"+m.path)
+	}
+	if container.WorkingDir != "" {
+		args = append(args, "-w", container.WorkingDir)
+	}
+	for _, e := range container.Env {
+		args = append(args, "-e", e.Name+"="+e.Value)
+	}
+	args = append(args, image)
+	args = append(args, container.Command...)
+	args = append(args, container.Args...)
+
+	return &Command{
+		Name: engine,
+		Args: args,
+	}, nil
+}
+
+func integrationContainer(env *trait.Environment) (*corev1.Container, error) {
+	container := env.GetIntegrationContainer()
+	if container == nil {
+		return nil, fmt.Errorf("unable to find a container for %s Integration", env.Integration.Name)
+	}
+	if len(container.Command) == 0 {
+		return nil, fmt.Errorf("no command to run the %s Integration locally, make sure the jvm trait is enabled", env.Integration.Name)
+	}
+
+	return container, nil
+}
+
+// newRelocator returns a replacer moving the Camel base path and any volume mount path under the given root directory.
+func newRelocator(root string, mounts []corev1.VolumeMount) *strings.Replacer {
+	paths := []string{camel.BasePath}
+	for _, m := range mounts {
+		if !strings.HasPrefix(m.MountPath, camel.BasePath+"/") {
+			paths = append(paths, m.MountPath)
+		}
+	}
+	// The longest paths first so that nested mount paths get precedence
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) > len(paths[j])
+	})
+	pairs := make([]string, 0, 2*len(paths))
+	for _, p := range paths {
+		pairs = append(pairs, p, filepath.Join(root, p))
+	}
+
+	return strings.NewReplacer(pairs...)
+}
+
+type mount struct {
+	host string
+	path string
+}
+
+// materialize writes the content of the integration container volumes under the given root directory.
+// When a relocator is provided, it is applied to the Camel properties content as well.
+func materialize(
+	env *trait.Environment,
+	container *corev1.Container,
+	root string,
+	configMaps []*corev1.ConfigMap,
+	relocate *strings.Replacer,
+) ([]mount, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(root); err != nil {
+		return nil, err
+	}
+
+	var volumes []corev1.Volume
+	env.Resources.VisitDeployment(func(deployment *appsv1.Deployment) {
+		volumes = deployment.Spec.Template.Spec.Volumes
+	})
+
+	mounts := make([]mount, 0, len(container.VolumeMounts))
+	for _, m := range container.VolumeMounts {
+		var volume *corev1.Volume
+		for i := range volumes {
+			if volumes[i].Name == m.Name {
+				volume = &volumes[i]
+
+				break
+			}
+		}
+		if volume == nil {
+			return nil, fmt.Errorf("unable to find volume %s", m.Name)
+		}
+
+		host := filepath.Join(root, m.MountPath)
+		switch {
+		case volume.ConfigMap != nil:
+			cm := lookupConfigMap(env, configMaps, volume.ConfigMap.Name)
+			if cm == nil {
+				return nil, fmt.Errorf("configmap %s is not available when running locally, use a local file instead", volume.ConfigMap.Name)
+			}
+			files := configMapFiles(cm, volume.ConfigMap.Items)
+			if relocate != nil && cm.Labels[kubernetes.ConfigMapTypeLabel] == trait.CamelPropertiesType {
+				for p, content := range files {
+					files[p] = []byte(relocate.Replace(string(content)))
+				}
+			}
+			if m.SubPath != "" {
+				content, ok := files[m.SubPath]
+				if !ok {
+					return nil, fmt.Errorf("unable to find %s in configmap %s", m.SubPath, cm.Name)
+				}
+				if err := writeFile(host, content); err != nil {
+					return nil, err
+				}
+			} else {
+				for p, content := range files {
+					if err := writeFile(filepath.Join(host, p), content); err != nil {
+						return nil, err
+					}
+				}
+			}
+		case volume.EmptyDir != nil:
+			if err := os.MkdirAll(host, io.FilePerm755); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New("volume " + volume.Name + " is not supported when running locally, only configmaps and empty directories are")
+		}
+
+		mounts = append(mounts, mount{host: host, path: m.MountPath})
+	}
+
+	return mounts, nil
+}
+
+func lookupConfigMap(env *trait.Environment, configMaps []*corev1.ConfigMap, name string) *corev1.ConfigMap {
+	for _, cm := range configMaps {
+		if cm.Name == name {
+			return cm
+		}
+	}
+
+	return env.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == name
+	})
+}
+
+// configMapFiles returns the files projected by a configmap volume, indexed by path.
+func configMapFiles(cm *corev1.ConfigMap, items []corev1.KeyToPath) map[string][]byte {
+	data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		data[k] = []byte(v)
+	}
+	for k, v := range cm.BinaryData {
+		data[k] = v
+	}
+	if len(items) == 0 {
+		return data
+	}
+
+	files := make(map[string][]byte, len(items))
+	for _, item := range items {
+		if content, ok := data[item.Key]; ok {
+			files[item.Path] = content
+		}
+	}
+
+	return files
+}
+
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), io.FilePerm755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, io.FilePerm644)
+}
@@ -0,0 +1,134 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func newLocalEnvironment(t *testing.T, configs ...string) *trait.Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it := v1.NewIntegration("default", "local")
+	it.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "routes.yaml",
+				Content: "- from:\n    uri: timer:tick\n    steps:\n    - to: log:info\n",
+			},
+		},
+	}
+	it.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: configs,
+	}
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	kit := v1.NewIntegrationKit("default", "local")
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	kit.Status.Artifacts = []v1.Artifact{
+		{ID: "camel-core.jar", Target: "dependencies/lib/main/camel-core.jar"},
+	}
+
+	env, err := trait.ApplyLocal(context.TODO(), &it, kit, catalog)
+	require.NoError(t, err)
+
+	return env
+}
+
+func TestNewCommand(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(t.TempDir(), "app.properties")
+	require.NoError(t, os.WriteFile(file, []byte("my.key=my-value\n"), 0o600))
+	it := v1.NewIntegration("default", "local")
+	cm, err := NewConfigMap(&it, "local-config", file, false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.properties": "my.key=my-value\n"}, cm.Data)
+
+	env := newLocalEnvironment(t, "configmap:local-config/app.properties")
+	cmd, err := NewCommand(env, dir, []*corev1.ConfigMap{cm})
+	require.NoError(t, err)
+
+	root := filepath.Join(dir, RootDir)
+	assert.Equal(t, "java", cmd.Name)
+	assert.Equal(t, filepath.Join(dir, DeploymentsDir), cmd.Dir)
+	assert.Contains(t, cmd.Args, env.CamelCatalog.Runtime.ApplicationClass)
+	assert.Contains(t, cmd.Env, "CAMEL_K_CONF="+filepath.Join(root, "/etc/camel/application.properties"))
+	assert.NotContains(t, cmd.String(), " /etc/camel")
+
+	source, err := os.ReadFile(filepath.Join(root, "/etc/camel/sources/routes.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(source), "timer:tick")
+	config, err := os.ReadFile(filepath.Join(root, "/etc/camel/conf.d/_configmaps/local-config/app.properties"))
+	require.NoError(t, err)
+	assert.Equal(t, "my.key=my-value\n", string(config))
+	// The Camel properties refer to the materialized files
+	props, err := os.ReadFile(filepath.Join(root, "/etc/camel/application.properties"))
+	require.NoError(t, err)
+	assert.Contains(t, string(props), "file:"+filepath.Join(root, "/etc/camel/sources/routes.yaml"))
+}
+
+func TestNewCommandMissingConfigMap(t *testing.T) {
+	env := newLocalEnvironment(t, "configmap:my-cm")
+
+	_, err := NewCommand(env, t.TempDir(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "configmap my-cm is not available when running locally")
+}
+
+func TestNewContainerCommand(t *testing.T) {
+	dir := t.TempDir()
+	env := newLocalEnvironment(t)
+
+	cmd, err := NewContainerCommand(env, dir, nil, DefaultContainerEngine, "my-image:1.0")
+	require.NoError(t, err)
+
+	root := filepath.Join(dir, RootDir)
+	assert.Equal(t, "docker", cmd.Name)
+	assert.Equal(t, []string{"run", "--rm", "--network", "host", "-v", filepath.Join(dir, DeploymentsDir) + ":/deployments"}, cmd.Args[:6])
+	assert.Contains(t, cmd.Args, filepath.Join(root, "/etc/camel/sources/routes.yaml")+":/etc/camel/sources/routes.yaml")
+	assert.Contains(t, cmd.Args, "CAMEL_K_CONF=/etc/camel/application.properties")
+	assert.Contains(t, cmd.Args, "my-image:1.0")
+	assert.Equal(t, env.CamelCatalog.Runtime.ApplicationClass, cmd.Args[len(cmd.Args)-1])
+	// The Camel properties are not relocated within the container
+	props, err := os.ReadFile(filepath.Join(root, "/etc/camel/application.properties"))
+	require.NoError(t, err)
+	assert.Contains(t, string(props), "file:/etc/camel/sources/routes.yaml")
+}
+
+func TestCommandString(t *testing.T) {
+	cmd := Command{
+		Name: "java",
+		Args: []string{"-Dmy.prop=a value", "-cp", "./resources:dependencies/*", "org.acme.Main"},
+		Env:  []string{"MY_VAR=it's"},
+	}
+
+	assert.Equal(t, `'MY_VAR=it'\''s' java '-Dmy.prop=a value' -cp ./resources:dependencies/* org.acme.Main`, cmd.String())
+}
@@ -0,0 +1,162 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package local provides the support to build and run an Integration on the developer machine.
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+)
+
+const (
+	// BuildDir is the directory, relative to the local working directory, where the Maven project is built.
+	BuildDir = "build"
+	// DeploymentsDir is the directory, relative to the local working directory, holding the application artifacts.
+	DeploymentsDir = "deployments"
+	// RootDir is the directory, relative to the local working directory, where the Integration volumes are materialized.
+	RootDir = "root"
+)
+
+// Build executes the builder steps required to package the Integration, using the Maven installation of the local host.
+// It returns a ready IntegrationKit whose artifacts are copied into the deployments directory, with the same layout
+// expected by the Integration container image.
+func Build(ctx context.Context, it *v1.Integration, catalog *camel.RuntimeCatalog, dir string) (*v1.IntegrationKit, error) {
+	if catalog == nil {
+		return nil, errors.New("camel catalog is not set")
+	}
+	task, err := builderTask(it, catalog, dir)
+	if err != nil {
+		return nil, err
+	}
+	// The dependencies are computed by a distinct task, as they require the project to be built first
+	packageTask := task.DeepCopy()
+	packageTask.Name = "package"
+	packageTask.Steps = builder.StepIDsFor(builder.Quarkus.LoadCamelQuarkusCatalog, builder.Quarkus.ComputeQuarkusDependencies)
+
+	build := v1.NewBuild(it.Namespace, it.Name)
+	build.Spec.Tasks = []v1.Task{{Builder: task}, {Package: packageTask}}
+
+	var status v1.BuildStatus
+	b := builder.New(nil).Build(build)
+	for _, t := range build.Spec.Tasks {
+		status = b.Task(t).Do(ctx)
+		if status.Phase == v1.BuildPhaseFailed || status.Phase == v1.BuildPhaseError || status.Phase == v1.BuildPhaseInterrupted {
+			return nil, fmt.Errorf("local build of integration %s failed: %s", it.Name, status.Error)
+		}
+	}
+
+	deploymentsDir := filepath.Join(dir, DeploymentsDir)
+	if err := os.RemoveAll(deploymentsDir); err != nil {
+		return nil, err
+	}
+	for _, a := range status.Artifacts {
+		if _, err := util.CopyFile(a.Location, filepath.Join(deploymentsDir, a.Target)); err != nil {
+			return nil, fmt.Errorf("cannot copy artifact %s: %w", a.ID, err)
+		}
+	}
+
+	kit := v1.NewIntegrationKit(it.Namespace, it.Name)
+	kit.Spec.Dependencies = task.Dependencies
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	kit.Status.RuntimeVersion = catalog.Runtime.Version
+	kit.Status.RuntimeProvider = catalog.Runtime.Provider
+	kit.Status.Artifacts = status.Artifacts
+
+	return kit, nil
+}
+
+// NewConfigMap returns a configmap holding the content of a local file, stored under the file base name.
+// Binary content is stored as binary data, in order to be materialized as is.
+func NewConfigMap(it *v1.Integration, name string, path string, binary bool) (*corev1.ConfigMap, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: it.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: it.Name,
+			},
+		},
+	}
+	key := filepath.Base(path)
+	if binary {
+		cm.BinaryData = map[string][]byte{key: content}
+	} else {
+		cm.Data = map[string]string{key: string(content)}
+	}
+
+	return &cm, nil
+}
+
+func builderTask(it *v1.Integration, catalog *camel.RuntimeCatalog, dir string) (*v1.BuilderTask, error) {
+	task := v1.BuilderTask{
+		BaseTask: v1.BaseTask{
+			Name: "builder",
+		},
+		Runtime:      catalog.Runtime,
+		Dependencies: it.Status.Dependencies,
+		BuildDir:     filepath.Join(dir, BuildDir),
+		Maven: v1.MavenBuildSpec{
+			MavenSpec: v1.MavenSpec{
+				Properties: map[string]string{
+					"quarkus.package.jar.type": "fast-jar",
+				},
+			},
+		},
+	}
+	for _, repo := range it.Spec.Repositories {
+		task.Maven.Repositories = append(task.Maven.Repositories, maven.NewRepository(repo))
+	}
+	// User provided build-time properties
+	if it.Spec.Traits.Builder != nil {
+		for _, v := range it.Spec.Traits.Builder.Properties {
+			key, value := property.SplitPropertyFileEntry(v)
+			if len(key) == 0 || len(value) == 0 {
+				return nil, fmt.Errorf("maven property must have key=value format, it was %v", v)
+			}
+			task.Maven.Properties[key] = value
+		}
+	}
+
+	steps := make([]builder.Step, 0, len(builder.Project.CommonSteps)+len(builder.Quarkus.CommonSteps))
+	steps = append(steps, builder.Project.CommonSteps...)
+	steps = append(steps, builder.Quarkus.CommonSteps...)
+	task.Steps = builder.StepIDsFor(steps...)
+
+	return &task, nil
+}
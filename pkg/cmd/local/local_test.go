@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestBuilderTask(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it := v1.NewIntegration("default", "local")
+	it.Spec.Repositories = []string{"https://repo.acme.org/maven2@id=acme"}
+	it.Spec.Traits.Builder = &traitv1.BuilderTrait{
+		Properties: []string{"my.build.prop=value"},
+	}
+	it.Status.Dependencies = []string{"camel:timer", "camel:log"}
+
+	task, err := builderTask(&it, catalog, "/tmp/local")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/tmp/local/build", task.BuildDir)
+	assert.Equal(t, catalog.Runtime, task.Runtime)
+	assert.Equal(t, it.Status.Dependencies, task.Dependencies)
+	assert.Equal(t, "value", task.Maven.Properties["my.build.prop"])
+	assert.Equal(t, "fast-jar", task.Maven.Properties["quarkus.package.jar.type"])
+	require.Len(t, task.Maven.Repositories, 1)
+	assert.Equal(t, "acme", task.Maven.Repositories[0].ID)
+	assert.Contains(t, task.Steps, builder.Quarkus.BuildQuarkusMavenProject.ID())
+	assert.NotContains(t, task.Steps, builder.Quarkus.ComputeQuarkusDependencies.ID())
+}
+
+func TestBuilderTaskInvalidProperty(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it := v1.NewIntegration("default", "local")
+	it.Spec.Traits.Builder = &traitv1.BuilderTrait{
+		Properties: []string{"my.build.prop"},
+	}
+
+	_, err = builderTask(&it, catalog, "/tmp/local")
+	require.Error(t, err)
+}
@@ -39,6 +39,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	clientscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +55,7 @@ import (
 
 	"github.com/apache/camel-k/v2/pkg/apis"
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	metricsv1beta1 "github.com/apache/camel-k/v2/pkg/apis/duck/metrics/v1beta1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/controller"
 	"github.com/apache/camel-k/v2/pkg/controller/synthetic"
@@ -63,6 +65,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	logutil "github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 )
 
 var log = logutil.Log.WithName("cmd")
@@ -131,6 +134,8 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	watchNamespace, err := getWatchNamespace()
 	exitOnError(err, "failed to get watch namespace")
 
+	exitOnError(secrets.RegisterKubernetesSecretProviders(os.Getenv(secrets.ProvidersEnvVariable)), "invalid secret providers")
+
 	ctx := signals.SetupSignalHandler()
 
 	cfg, err := config.GetConfig()
@@ -166,6 +171,12 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	exitOnError(err, "cannot create Integration label selector")
 	labelsSelector := labels.NewSelector().Add(*hasIntegrationLabel)
 
+	hasRevisionLabel, err := labels.NewRequirement(v1.IntegrationRevisionLabel, selection.Exists, []string{})
+	exitOnError(err, "cannot create Integration revision label selector")
+	revisionsSelector := cache.ByObject{
+		Label: labels.NewSelector().Add(*hasRevisionLabel),
+	}
+
 	selector := cache.ByObject{
 		Label: labelsSelector,
 	}
@@ -177,14 +188,16 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 			Label:      labelsSelector,
 			Namespaces: cacheConfigs,
 		}
+		revisionsSelector.Namespaces = cacheConfigs
 	} else {
 		log.Info("This operator is global and will watch all namespaces!")
 	}
 
 	selectors := map[ctrl.Object]cache.ByObject{
-		&corev1.Pod{}:        selector,
-		&appsv1.Deployment{}: selector,
-		&batchv1.Job{}:       selector,
+		&corev1.Pod{}:                selector,
+		&appsv1.Deployment{}:         selector,
+		&appsv1.ControllerRevision{}: revisionsSelector,
+		&batchv1.Job{}:               selector,
 	}
 
 	if ok, err := kubernetes.IsAPIResourceInstalled(bootstrapClient, servingv1.SchemeGroupVersion.String(),
@@ -205,6 +218,10 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		options.DefaultNamespaces = cacheConfigs
 	}
 
+	// The resource metrics can't be watched, so they must be known to the scheme before the manager
+	// is created, in order to be read from the API server instead of the cache
+	exitOnError(metricsv1beta1.AddToScheme(clientscheme.Scheme), "")
+
 	mgr, err := manager.New(cfg, manager.Options{
 		LeaderElection:                leaderElection,
 		LeaderElectionNamespace:       operatorNamespace,
@@ -214,6 +231,11 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		HealthProbeBindAddress:        ":" + strconv.Itoa(int(healthPort)),
 		Metrics:                       metricsserver.Options{BindAddress: ":" + strconv.Itoa(int(monitoringPort))},
 		Cache:                         options,
+		Client: ctrl.Options{
+			Cache: &ctrl.CacheOptions{
+				DisableFor: []ctrl.Object{&metricsv1beta1.PodMetrics{}},
+			},
+		},
 	})
 	exitOnError(err, "")
 
@@ -0,0 +1,180 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+func newCmdRollback(rootCmdOptions *RootCmdOptions) (*cobra.Command, *rollbackCmdOptions) {
+	options := rollbackCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "rollback <integration> [--to-revision N]",
+		Short: "Roll back an Integration to a previous revision.",
+		Long: `Roll back an Integration to a previous revision recorded in its revision history (see the deployer trait). ` +
+			`The Integration is deployed again with the kit of the revision, without being rebuilt.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Int64("to-revision", 0, "The revision to roll back to. Defaults to the revision previous to the deployed one")
+
+	return &cmd, &options
+}
+
+type rollbackCmdOptions struct {
+	*RootCmdOptions
+
+	ToRevision int64 `mapstructure:"to-revision"`
+}
+
+func (o *rollbackCmdOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("rollback requires an Integration name argument")
+	}
+	if o.ToRevision < 0 {
+		return fmt.Errorf("invalid revision %d: must be a positive number", o.ToRevision)
+	}
+
+	return nil
+}
+
+func (o *rollbackCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return fmt.Errorf("integration %q not found in namespace %q", args[0], o.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	rolledBack, err := o.rollbackIntegration(c, it)
+	if err != nil {
+		return err
+	}
+	if rolledBack == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Integration %s is already deployed at revision %d\n", it.Name, it.Status.Revision)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Integration %s rolled back to revision %d\n", it.Name, rolledBack)
+	}
+
+	return nil
+}
+
+// rollbackIntegration restores the specification of the Integration recorded in the target revision, and annotates
+// the Integration with the revision, so that the kit of the revision is reused and not rebuilt. It returns the number of the revision rolled back to, or 0 if it is
+// already deployed.
+func (o *rollbackCmdOptions) rollbackIntegration(c client.Client, it *v1.Integration) (int64, error) {
+	for _, owner := range it.GetOwnerReferences() {
+		if owner.Kind == v1.PipeKind {
+			return 0, fmt.Errorf("integration %s is managed by Pipe %s: roll back the Pipe instead", it.Name, owner.Name)
+		}
+	}
+
+	revisions, err := revision.List(o.Context, c, it)
+	if err != nil {
+		return 0, err
+	}
+	target, err := o.targetRevision(it, revisions)
+	if err != nil {
+		return 0, err
+	}
+	if target.Revision == it.Status.Revision {
+		return 0, nil
+	}
+	data, err := revision.Decode(target)
+	if err != nil {
+		return 0, err
+	}
+
+	if ref := data.IntegrationKit; ref != nil {
+		kit, err := kubernetes.GetIntegrationKit(o.Context, c, ref.Name, ref.Namespace)
+		if err != nil && k8serrors.IsNotFound(err) {
+			return 0, fmt.Errorf("integration kit %s/%s of revision %d no longer exists", ref.Namespace, ref.Name, target.Revision)
+		} else if err != nil {
+			return 0, err
+		}
+		if kit.Status.Phase != v1.IntegrationKitPhaseReady {
+			return 0, fmt.Errorf("integration kit %s/%s of revision %d is not ready", ref.Namespace, ref.Name, target.Revision)
+		}
+	}
+
+	rolledBack := it.DeepCopy()
+	rolledBack.Spec = *data.Spec.DeepCopy()
+	// The operator reuses the kit of the revision until the Integration is changed
+	v1.SetAnnotation(&rolledBack.ObjectMeta, v1.IntegrationRollbackRevisionAnnotation, strconv.FormatInt(target.Revision, 10))
+	if err := c.Patch(o.Context, rolledBack, k8sclient.MergeFrom(it)); err != nil {
+		return 0, fmt.Errorf("could not roll back integration %s in namespace %s: %w", it.Name, o.Namespace, err)
+	}
+
+	return target.Revision, nil
+}
+
+// targetRevision returns the revision requested with the --to-revision flag, or by default the revision previous
+// to the deployed one, or the latest revision when the current version of the Integration is not deployed.
+func (o *rollbackCmdOptions) targetRevision(it *v1.Integration, revisions []appsv1.ControllerRevision) (*appsv1.ControllerRevision, error) {
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision history found for integration %s", it.Name)
+	}
+	if o.ToRevision > 0 {
+		for i := range revisions {
+			if revisions[i].Revision == o.ToRevision {
+				return &revisions[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("revision %d not found for integration %s", o.ToRevision, it.Name)
+	}
+
+	current := it.Status.Revision
+	if current == 0 {
+		// The current version of the Integration is not deployed yet, e.g., it fails to build
+		return &revisions[len(revisions)-1], nil
+	}
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if revisions[i].Revision < current {
+			return &revisions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no revision previous to revision %d found for integration %s", current, it.Name)
+}
@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const cmdRollback = "rollback"
+
+func initializeRollbackCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, *rollbackCmdOptions) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rollbackCmdOptions := addTestRollbackCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, rollbackCmdOptions
+}
+
+func addTestRollbackCmd(options RootCmdOptions, rootCmd *cobra.Command) *rollbackCmdOptions {
+	rollbackCmd, rollbackOptions := newCmdRollback(&options)
+	rollbackCmd.Args = ArbitraryArgs
+	rootCmd.AddCommand(rollbackCmd)
+	return rollbackOptions
+}
+
+// revisionsEnvironment returns an Integration deployed at revision 2, along with its revisions and kits.
+func revisionsEnvironment(t *testing.T) (*v1.Integration, []runtime.Object) {
+	t.Helper()
+	it := v1.NewIntegration("default", "my-it")
+	it.UID = "my-it-uid"
+	objects := []runtime.Object{&it}
+	for i, version := range []string{"v1", "v2"} {
+		kit := v1.NewIntegrationKit("default", "kit-"+version)
+		kit.Status.Phase = v1.IntegrationKitPhaseReady
+		it.Spec.Sources = []v1.SourceSpec{v1.NewSourceSpec("route.yaml", "timer:"+version, v1.LanguageYaml)}
+		it.Status.Digest = "digest-" + version
+		it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: kit.Namespace, Name: kit.Name}
+		rev, err := revision.New(&it, int64(i+1))
+		require.NoError(t, err)
+		objects = append(objects, kit, rev)
+	}
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Revision = 2
+
+	return &it, objects
+}
+
+func TestRollbackNoArgs(t *testing.T) {
+	cmd, _ := initializeRollbackCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdRollback)
+	require.Error(t, err)
+	assert.Equal(t, "rollback requires an Integration name argument", err.Error())
+}
+
+func TestRollbackNoHistory(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	cmd, _ := initializeRollbackCmdOptions(t, &it)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "no revision history found for integration my-it", err.Error())
+}
+
+func TestRollbackToPreviousRevision(t *testing.T) {
+	_, objects := revisionsEnvironment(t)
+	cmd, options := initializeRollbackCmdOptions(t, objects...)
+	output, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Integration my-it rolled back to revision 1")
+
+	c, err := options.GetCmdClient()
+	require.NoError(t, err)
+	rolledBack := v1.NewIntegration("default", "my-it")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&rolledBack), &rolledBack))
+	require.Len(t, rolledBack.Spec.Sources, 1)
+	assert.Equal(t, "timer:v1", rolledBack.Spec.Sources[0].Content)
+	assert.Nil(t, rolledBack.Spec.IntegrationKit)
+	assert.Equal(t, "1", rolledBack.Annotations[v1.IntegrationRollbackRevisionAnnotation])
+}
+
+func TestRollbackToRevision(t *testing.T) {
+	_, objects := revisionsEnvironment(t)
+	cmd, _ := initializeRollbackCmdOptions(t, objects...)
+	output, err := ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "2")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Integration my-it is already deployed at revision 2")
+
+	_, err = ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "3")
+	require.Error(t, err)
+	assert.Equal(t, "revision 3 not found for integration my-it", err.Error())
+}
+
+func TestRollbackMissingKit(t *testing.T) {
+	_, objects := revisionsEnvironment(t)
+	// Remove the kit of the first revision
+	objects = append(objects[:1], objects[2:]...)
+	cmd, _ := initializeRollbackCmdOptions(t, objects...)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "integration kit default/kit-v1 of revision 1 no longer exists", err.Error())
+}
+
+func TestRollbackPipeIntegration(t *testing.T) {
+	it, objects := revisionsEnvironment(t)
+	it.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+			Name:       "my-pipe",
+		},
+	}
+	cmd, _ := initializeRollbackCmdOptions(t, objects...)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "integration my-it is managed by Pipe my-pipe: roll back the Pipe instead", err.Error())
+}
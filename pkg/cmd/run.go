@@ -26,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -51,6 +52,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
 	"github.com/apache/camel-k/v2/pkg/util/property"
@@ -86,12 +88,14 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 		"(syntax: [my-key=my-value|file:/path/to/my-conf.properties])")
 	cmd.Flags().StringArray("build-property", nil, "Add a build time property or properties file from a path "+
 		"(syntax: [my-key=my-value|file:/path/to/my-conf.properties])")
-	cmd.Flags().StringArray("config", nil, "Add a runtime configuration from a Configmap or a Secret "+
+	cmd.Flags().StringArray("config", nil, "Add a runtime configuration from a Configmap, a Secret or an external secret "+
 		"(syntax: [configmap|secret]:name[/key], where name represents the configmap/secret name and key optionally "+
-		"represents the configmap/secret key to be filtered)")
+		"represents the configmap/secret key to be filtered, or <provider>:<path>#<key> for external secrets)")
 	cmd.Flags().StringArray("resource", nil, "Add a runtime resource from a Configmap or a Secret "+
 		"(syntax: [configmap|secret]:name[/key][@path], where name represents the configmap/secret name, "+
 		"key optionally represents the configmap/secret key to be filtered and path represents the destination path)")
+	cmd.Flags().StringArray("openapi", nil, "Add an OpenAPI spec used to generate the Integration REST routes, "+
+		"either from a local file or from a Configmap (syntax: [/path/to/spec.yaml|configmap:name])")
 	cmd.Flags().StringArray("maven-repository", nil, "Add a maven repository")
 	cmd.Flags().Bool("logs", false, "Print integration logs")
 	cmd.Flags().Bool("sync", false, "[Deprecated] Synchronize the local source file with the cluster, republishing at each change")
@@ -119,6 +123,10 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().Bool("save", false, "Save the run parameters into the default kamel configuration file (kamel-config.yaml)")
 	cmd.Flags().Bool("dont-run-after-build", false, "Only build, don't run the application. "+
 		"You can run \"kamel deploy\" to run a built Integration.")
+	cmd.Flags().Bool("local", false, "Build and run the Integration on the local host, using the local Maven installation, "+
+		"instead of Kubernetes. Configurations and resources must be provided as local files (syntax: file:/path/to/file[@/destination/path])")
+	cmd.Flags().Bool("local-container", false, "Run the Integration locally within a container instead of a Java process (requires --local)")
+	cmd.Flags().String("local-dir", "", "The working directory used to build and run the Integration locally (requires --local)")
 
 	return &cmd, &options
 }
@@ -159,6 +167,7 @@ type runCmdOptions struct {
 	// Deprecated: won't be supported in the future
 	BuildProperties []string `mapstructure:"build-properties"   yaml:",omitempty"`
 	Configs         []string `mapstructure:"configs"            yaml:",omitempty"`
+	OpenAPIs        []string `mapstructure:"openapis"           yaml:",omitempty"`
 	Repositories    []string `mapstructure:"maven-repositories" yaml:",omitempty"`
 	Traits          []string `mapstructure:"traits"             yaml:",omitempty"`
 	Volumes         []string `mapstructure:"volumes"            yaml:",omitempty"`
@@ -168,6 +177,9 @@ type runCmdOptions struct {
 	Annotations       []string `mapstructure:"annotations"          yaml:",omitempty"`
 	Sources           []string `mapstructure:"sources"              yaml:",omitempty"`
 	DontRunAfterBuild bool     `mapstructure:"dont-run-after-build" yaml:",omitempty"`
+	Local             bool     `mapstructure:"local"                yaml:",omitempty"`
+	LocalContainer    bool     `mapstructure:"local-container"      yaml:",omitempty"`
+	LocalDir          string   `mapstructure:"local-dir"            yaml:",omitempty"`
 }
 
 func (o *runCmdOptions) decode(cmd *cobra.Command, args []string) error {
@@ -194,7 +206,7 @@ func (o *runCmdOptions) decode(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if o.OutputFormat != "" {
+	if o.OutputFormat != "" || o.Local {
 		// let the command work in offline mode
 		cmd.Annotations[offlineCommandLabel] = strconv.FormatBool(true)
 	}
@@ -264,6 +276,10 @@ func (o *runCmdOptions) validate(cmd *cobra.Command) error {
 		return errors.New("cannot use --dev with -o/--output option")
 	}
 
+	if err := o.validateLocal(); err != nil {
+		return err
+	}
+
 	for _, label := range o.Labels {
 		parts := strings.Split(label, "=")
 		if len(parts) != 2 {
@@ -344,6 +360,10 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 			"(via --image argument) or a git repository (via --git argument)")
 	}
 
+	if o.Local {
+		return o.runLocal(cmd, args)
+	}
+
 	integration, err := o.createOrUpdateIntegration(cmd, c, args)
 	if err != nil {
 		return err
@@ -609,6 +629,11 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		return nil, err
 	}
 
+	openAPIConfigMaps, err := o.applyOpenAPIs(integration)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := o.applyDependencies(cmd, integration); err != nil {
 		return nil, err
 	}
@@ -628,6 +653,17 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		return nil, showIntegrationOutput(cmd, integration, o.OutputFormat)
 	}
 
+	if o.Local {
+		// The Integration is run on the local host, nothing to store in the cluster
+		return integration, nil
+	}
+
+	for _, cm := range openAPIConfigMaps {
+		if _, err := kubernetes.ReplaceResource(o.Context, c, cm); err != nil {
+			return nil, err
+		}
+	}
+
 	if existing == nil {
 		err = c.Create(o.Context, integration)
 		if err != nil {
@@ -644,7 +680,7 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		if string(d) == "{}" {
 			fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" unchanged`)
 
-			return integration, nil
+			return integration, o.bindOpenAPIConfigMaps(c, integration, openAPIConfigMaps)
 		}
 		err = c.Patch(o.Context, integration, patch)
 		if err != nil {
@@ -653,7 +689,7 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" updated`)
 	}
 
-	return integration, nil
+	return integration, o.bindOpenAPIConfigMaps(c, integration, openAPIConfigMaps)
 }
 
 func (o *runCmdOptions) isManaged() bool {
@@ -803,6 +839,69 @@ func (o *runCmdOptions) convertOptionsToTraits(cmd *cobra.Command, c client.Clie
 	return nil
 }
 
+// applyOpenAPIs configures the openapi trait with the given specs. The specs provided as local files are
+// returned as ConfigMaps that must be stored in the cluster along with the Integration.
+func (o *runCmdOptions) applyOpenAPIs(it *v1.Integration) ([]*corev1.ConfigMap, error) {
+	configmaps := make([]*corev1.ConfigMap, 0)
+	for _, item := range o.OpenAPIs {
+		if name, ok := strings.CutPrefix(item, "configmap:"); ok {
+			o.Traits = append(o.Traits, "openapi.configmaps="+name)
+
+			continue
+		}
+		if o.OutputFormat != "" || o.Local {
+			return nil, fmt.Errorf("cannot use the local OpenAPI spec %s, provide it from a Configmap instead", item)
+		}
+		data, err := util.ReadFile(item)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dsl.ParseOpenAPISpec(data); err != nil {
+			return nil, fmt.Errorf("invalid OpenAPI spec %s: %w", item, err)
+		}
+		cm := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: it.Namespace,
+				Name:      kubernetes.SanitizeName(it.Name + "-openapi-" + filepath.Base(item)),
+				Labels: map[string]string{
+					v1.IntegrationLabel: it.Name,
+				},
+			},
+			Data: map[string]string{
+				filepath.Base(item): string(data),
+			},
+		}
+		configmaps = append(configmaps, cm)
+		o.Traits = append(o.Traits, "openapi.configmaps="+cm.Name)
+	}
+
+	return configmaps, nil
+}
+
+// bindOpenAPIConfigMaps makes the Integration the owner of the ConfigMaps created from local OpenAPI specs,
+// so that they are garbage collected along with it.
+func (o *runCmdOptions) bindOpenAPIConfigMaps(c client.Client, it *v1.Integration, configmaps []*corev1.ConfigMap) error {
+	for _, cm := range configmaps {
+		cm.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+				Name:       it.Name,
+				UID:        it.UID,
+			},
+		}
+		if err := c.Update(o.Context, cm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *runCmdOptions) parseAndConvertToTrait(cmd *cobra.Command,
 	c client.Client, integration *v1.Integration, params []string,
 	parse func(string) (*resource.Config, error),
@@ -814,7 +913,7 @@ func (o *runCmdOptions) parseAndConvertToTrait(cmd *cobra.Command,
 		if err != nil {
 			return err
 		}
-		if o.OutputFormat == "" {
+		if o.OutputFormat == "" && !o.Local {
 			if err := parseConfig(o.Context, cmd, c, config, integration); err != nil {
 				return err
 			}
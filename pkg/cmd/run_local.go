@@ -0,0 +1,276 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/cmd/local"
+	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/sync"
+)
+
+func (o *runCmdOptions) validateLocal() error {
+	if !o.Local {
+		if o.LocalContainer || o.LocalDir != "" {
+			return errors.New("cannot use --local-container or --local-dir without --local option")
+		}
+
+		return nil
+	}
+	if !o.isManaged() {
+		return errors.New("cannot use --local with --image or --git options")
+	}
+	if o.OutputFormat != "" {
+		return errors.New("cannot use --local with -o/--output option")
+	}
+	if o.Dev {
+		return errors.New("cannot use --dev with --local option, use --sync to reload the Integration on changes")
+	}
+	for _, item := range slices.Concat(o.Configs, o.Resources) {
+		if !strings.HasPrefix(item, "file:") {
+			return fmt.Errorf("%s cannot be resolved when running locally, provide a local file instead "+
+				"(syntax: file:/path/to/file[@/destination/path])", item)
+		}
+	}
+
+	return nil
+}
+
+// runLocal builds and runs the Integration on the local host. When sync is enabled, the Integration
+// is built and run again each time any of the local files it depends on changes.
+func (o *runCmdOptions) runLocal(cmd *cobra.Command, sources []string) error {
+	// Use the Maven installation of the local host, unless configured otherwise
+	if _, ok := os.LookupEnv("MAVEN_CMD"); !ok {
+		if err := os.Setenv("MAVEN_CMD", "mvn"); err != nil {
+			return err
+		}
+	}
+
+	// Each run converts the options, so they are restored from their original values
+	configs, resources, traits := o.Configs, o.Resources, o.Traits
+	run := func(ctx context.Context) error {
+		o.Configs, o.Resources, o.Traits = slices.Clone(configs), slices.Clone(resources), slices.Clone(traits)
+
+		return o.runLocalOnce(ctx, cmd, sources)
+	}
+
+	if !o.Sync {
+		return run(o.Context)
+	}
+
+	changes, err := o.watchLocalFiles(cmd, sources)
+	if err != nil {
+		return err
+	}
+	for {
+		ctx, cancel := context.WithCancel(o.Context)
+		done := make(chan error, 1)
+		go func() {
+			done <- run(ctx)
+		}()
+
+		select {
+		case <-o.Context.Done():
+			cancel()
+			<-done
+
+			return nil
+		case <-changes:
+			cancel()
+			<-done
+		case err := <-done:
+			cancel()
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Unable to run integration locally:", err.Error())
+			}
+			// Wait for the next change before running the Integration again
+			select {
+			case <-o.Context.Done():
+				return nil
+			case <-changes:
+			}
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "Changes detected, reloading the integration")
+	}
+}
+
+func (o *runCmdOptions) runLocalOnce(ctx context.Context, cmd *cobra.Command, sources []string) error {
+	name, err := o.GetIntegrationName(sources)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return errors.New("unable to determine integration name")
+	}
+	stub := v1.NewIntegration(o.Namespace, name)
+	configMaps, err := o.convertLocalFiles(&stub)
+	if err != nil {
+		return err
+	}
+	it, err := o.createOrUpdateIntegration(cmd, nil, sources)
+	if err != nil {
+		return err
+	}
+	it.Status.Version = defaults.Version
+
+	catalog, err := camel.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+
+	// Compute the Integration dependencies
+	it.Status.Phase = v1.IntegrationPhaseInitialization
+	if _, err := trait.ApplyLocal(ctx, it, nil, catalog); err != nil {
+		return err
+	}
+
+	dir := o.LocalDir
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "kamel-local", it.Name)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Building integration %q locally in %s\n", it.Name, dir)
+	kit, err := local.Build(ctx, it, catalog, dir)
+	if err != nil {
+		return err
+	}
+
+	// Configure the integration container
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	env, err := trait.ApplyLocal(ctx, it, kit, catalog)
+	if err != nil {
+		return err
+	}
+
+	var command *local.Command
+	if o.LocalContainer {
+		command, err = local.NewContainerCommand(env, dir, configMaps, local.DefaultContainerEngine, env.Platform.BuildBaseImage)
+	} else {
+		command, err = local.NewCommand(env, dir, configMaps)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), command.String())
+	if o.DontRunAfterBuild {
+		return nil
+	}
+
+	process := command.Exec(ctx)
+	process.Stdout = cmd.OutOrStdout()
+	process.Stderr = cmd.ErrOrStderr()
+	if err := process.Run(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("integration %q terminated: %w", it.Name, err)
+	}
+
+	return nil
+}
+
+// convertLocalFiles converts the local files provided as configurations and resources into configmaps,
+// which are materialized in the local working directory the same way they would be mounted in the cluster.
+func (o *runCmdOptions) convertLocalFiles(it *v1.Integration) ([]*corev1.ConfigMap, error) {
+	configMaps := make([]*corev1.ConfigMap, 0, len(o.Configs)+len(o.Resources))
+	convert := func(items []string, kind string, binary bool) ([]string, error) {
+		converted := make([]string, 0, len(items))
+		for i, item := range items {
+			path, destination := resource.ParseFileValue(strings.TrimPrefix(item, "file:"))
+			cm, err := local.NewConfigMap(it, fmt.Sprintf("%s-local-%s-%03d", it.Name, kind, i), path, binary)
+			if err != nil {
+				return nil, err
+			}
+			configMaps = append(configMaps, cm)
+
+			ref := fmt.Sprintf("configmap:%s/%s", cm.Name, filepath.Base(path))
+			if destination != "" {
+				ref += "@" + destination
+			}
+			converted = append(converted, ref)
+		}
+
+		return converted, nil
+	}
+
+	var err error
+	if o.Configs, err = convert(o.Configs, "config", false); err != nil {
+		return nil, err
+	}
+	if o.Resources, err = convert(o.Resources, "resource", true); err != nil {
+		return nil, err
+	}
+
+	return configMaps, nil
+}
+
+// watchLocalFiles returns a channel signaling any change in the local files the Integration depends on.
+func (o *runCmdOptions) watchLocalFiles(cmd *cobra.Command, sources []string) (<-chan bool, error) {
+	files := slices.Concat(
+		sources,
+		o.Sources,
+		filterFileLocation(o.Resources),
+		filterFileLocation(o.Configs),
+		filterFileLocation(o.Properties),
+		filterFileLocation(o.BuildProperties),
+	)
+
+	changes := make(chan bool, 1)
+	for _, f := range files {
+		ok, err := source.IsLocalAndFileExists(f)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the following URL will not be watched for changes: %s\n", f)
+
+			continue
+		}
+		fileChanges, err := sync.File(o.Context, f)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			for {
+				select {
+				case <-o.Context.Done():
+					return
+				case <-fileChanges:
+					// Coalesce the changes notified while the Integration is reloading
+					select {
+					case changes <- true:
+					default:
+					}
+				}
+			}
+		}()
+	}
+
+	return changes, nil
+}
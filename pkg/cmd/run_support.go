@@ -56,6 +56,8 @@ func parseConfig(ctx context.Context, cmd *cobra.Command, c client.Client, confi
 		if secret == nil {
 			fmt.Fprintln(cmd.ErrOrStderr(), "Warn:", config.Name(), "Secret not found in", integration.Namespace, "namespace, make sure to provide it before the Integration can run")
 		}
+	case resource.StorageTypeExternal:
+		// External secrets are resolved by the operator, using the providers it's configured with
 	default:
 		// Should never reach this
 		return fmt.Errorf("invalid option type %s", config.StorageType())
@@ -30,7 +30,9 @@ import (
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -367,6 +369,62 @@ func TestRunResourceFlag(t *testing.T) {
 	assert.Equal(t, "res2", runCmdOptions.Resources[1])
 }
 
+func TestRunOpenAPIFlag(t *testing.T) {
+	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdRun,
+		"--openapi", "configmap:my-spec",
+		"--openapi", "spec.yaml",
+		integrationSource)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"configmap:my-spec", "spec.yaml"}, runCmdOptions.OpenAPIs)
+}
+
+func TestRunOpenAPIFromFile(t *testing.T) {
+	tempDir := t.TempDir()
+	source := filepath.Join(tempDir, "rest.yaml")
+	require.NoError(t, os.WriteFile(source, []byte(yamlIntegration), 0o400))
+	spec := filepath.Join(tempDir, "petstore.yaml")
+	require.NoError(t, os.WriteFile(spec, []byte(`openapi: 3.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+`), 0o400))
+
+	c := v1.NewCamelCatalog("default", defaults.DefaultRuntimeVersion)
+	c.Spec = v1.CamelCatalogSpec{Runtime: v1.RuntimeSpec{Provider: v1.RuntimeProviderPlainQuarkus, Version: defaults.DefaultRuntimeVersion}}
+	fakeClient, err := internal.NewFakeClient(&c)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	addTestRunCmdWithOutput(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	_, err = ExecuteCommand(rootCmd, cmdRun, source, "--openapi", spec, "--openapi", "configmap:my-spec")
+	require.NoError(t, err)
+
+	it := v1.NewIntegration("", "rest")
+	require.NoError(t, fakeClient.Get(context.TODO(), ctrl.ObjectKeyFromObject(&it), &it))
+	require.NotNil(t, it.Spec.Traits.OpenAPI)
+	assert.Equal(t, []string{"rest-openapi-petstore", "my-spec"}, it.Spec.Traits.OpenAPI.Configmaps)
+
+	cm := corev1.ConfigMap{}
+	require.NoError(t, fakeClient.Get(context.TODO(), ctrl.ObjectKey{Name: "rest-openapi-petstore"}, &cm))
+	assert.Contains(t, cm.Data, "petstore.yaml")
+	assert.Equal(t, "rest", cm.Labels[v1.IntegrationLabel])
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, v1.IntegrationKind, cm.OwnerReferences[0].Kind)
+	assert.Equal(t, "rest", cm.OwnerReferences[0].Name)
+}
+
+func TestRunOpenAPIFromFileWithOutput(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "rest.yaml")
+	require.NoError(t, os.WriteFile(source, []byte(yamlIntegration), 0o400))
+
+	_, rootCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	_, err := ExecuteCommand(rootCmd, cmdRun, source, "--openapi", "spec.yaml", "-o", "yaml")
+	require.EqualError(t, err, "cannot use the local OpenAPI spec spec.yaml, provide it from a Configmap instead")
+}
+
 func TestRunSaveFlag(t *testing.T) {
 	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
 	_, err := ExecuteCommand(rootCmd, cmdRun, "--save", integrationSource)
@@ -1001,3 +1059,50 @@ spec:
 status: {}
 `, output)
 }
+
+func TestRunLocalFlags(t *testing.T) {
+	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdRun, "--local", "--local-container", "--local-dir", "/tmp/local", integrationSource)
+	require.NoError(t, err)
+	assert.True(t, runCmdOptions.Local)
+	assert.True(t, runCmdOptions.LocalContainer)
+	assert.Equal(t, "/tmp/local", runCmdOptions.LocalDir)
+}
+
+func TestRunLocalInvalidFlags(t *testing.T) {
+	tests := map[string][]string{
+		"cannot use --local-container or --local-dir without --local option":                    {"--local-container"},
+		"cannot use --local with -o/--output option":                                            {"--local", "-o", "yaml"},
+		"cannot use --local with --image or --git options":                                      {"--local", "--image", "my-image"},
+		"cannot use --dev with --local option, use --sync to reload the Integration on changes": {"--local", "--dev"},
+		"configmap:my-cm cannot be resolved when running locally, provide a local file instead " +
+			"(syntax: file:/path/to/file[@/destination/path])": {"--local", "--config", "configmap:my-cm"},
+	}
+	for expected, flags := range tests {
+		_, rootCmd, _ := initializeRunCmdOptions(t)
+		_, err := ExecuteCommand(rootCmd, append([]string{cmdRun}, append(flags, integrationSource)...)...)
+		require.Error(t, err)
+		assert.Equal(t, expected, err.Error())
+	}
+}
+
+func TestRunLocalConvertFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	config := filepath.Join(tempDir, "app.properties")
+	require.NoError(t, os.WriteFile(config, []byte("my.key=my-value"), 0o600))
+	res := filepath.Join(tempDir, "data.bin")
+	require.NoError(t, os.WriteFile(res, []byte{0x00, 0x01}, 0o600))
+
+	runCmdOptions, _, _ := initializeRunCmdOptions(t)
+	runCmdOptions.Configs = []string{"file:" + config}
+	runCmdOptions.Resources = []string{"file:" + res + "@/tmp/data.bin"}
+	it := v1.NewIntegration("default", "my-it")
+
+	configMaps, err := runCmdOptions.convertLocalFiles(&it)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"configmap:my-it-local-config-000/app.properties"}, runCmdOptions.Configs)
+	assert.Equal(t, []string{"configmap:my-it-local-resource-000/data.bin@/tmp/data.bin"}, runCmdOptions.Resources)
+	require.Len(t, configMaps, 2)
+	assert.Equal(t, "my.key=my-value", configMaps[0].Data["app.properties"])
+	assert.Equal(t, []byte{0x00, 0x01}, configMaps[1].BinaryData["data.bin"])
+}
@@ -0,0 +1,512 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	utilsource "github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+const (
+	validationLevelError   = "error"
+	validationLevelWarning = "warning"
+	validationLevelNote    = "note"
+
+	validationRuleSource             = "invalid-source"
+	validationRuleUnknownComponent   = "unknown-component"
+	validationRuleTrait              = "invalid-trait"
+	validationRulePipe               = "invalid-pipe"
+	validationRuleEndpoint           = "unresolvable-endpoint"
+	validationRuleOfflineEndpoint    = "offline-endpoint"
+	validationRuleKameletNotFound    = "kamelet-not-found"
+	validationRuleKameletRequiredKey = "kamelet-missing-property"
+	validationRuleKamelet            = "invalid-kamelet"
+	validationRuleKameletPlaceholder = "kamelet-undeclared-property"
+
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+var validationRules = map[string]string{
+	validationRuleSource:             "The source cannot be parsed by the Camel K inspectors",
+	validationRuleUnknownComponent:   "The endpoint uses a component that the Camel catalog cannot map",
+	validationRuleTrait:              "The trait or trait property is not declared by the trait API",
+	validationRulePipe:               "The Pipe cannot be parsed",
+	validationRuleEndpoint:           "The Pipe endpoint cannot be resolved by any binding provider",
+	validationRuleOfflineEndpoint:    "The Pipe endpoint requires a cluster to be resolved",
+	validationRuleKameletNotFound:    "The Kamelet cannot be found in the local Kamelet directory",
+	validationRuleKameletRequiredKey: "A property marked as required by the Kamelet definition is missing",
+	validationRuleKamelet:            "The Kamelet does not follow the Kamelet specification",
+	validationRuleKameletPlaceholder: "The Kamelet template uses a property that is not declared by its definition",
+}
+
+func newCmdValidate(rootCmdOptions *RootCmdOptions) (*cobra.Command, *validateCmdOptions) {
+	options := validateCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "validate [files to validate]",
+		Short: "Validate Integration sources, traits and Pipes without a cluster",
+		Long: `Validate Integration sources, trait configuration and Pipes offline, before anything reaches the cluster. ` +
+			`The report can be produced in a machine readable format (json or sarif) and the command fails when an error is found.`,
+		PreRunE:     decode(&options, options.Flags),
+		RunE:        options.run,
+		Annotations: map[string]string{offlineCommandLabel: "true"},
+	}
+
+	cmd.Flags().StringArrayP("trait", "t", nil, "A trait configuration to validate. E.g. \"-t service.enabled=false\"")
+	cmd.Flags().String("kamelets-dir", "", "A local directory containing the Kamelets referenced by the sources and Pipes")
+	cmd.Flags().StringP("output", "o", "text", "Output format. One of: text|json|sarif")
+
+	return &cmd, &options
+}
+
+type validateCmdOptions struct {
+	*RootCmdOptions `json:"-"`
+
+	Traits       []string `mapstructure:"traits"       yaml:",omitempty"`
+	KameletsDir  string   `mapstructure:"kamelets-dir" yaml:",omitempty"`
+	OutputFormat string   `mapstructure:"output"       yaml:",omitempty"`
+}
+
+// validationFinding is a single problem reported by the validate command.
+type validationFinding struct {
+	RuleID   string `json:"ruleId"`
+	Level    string `json:"level"`
+	Message  string `json:"message"`
+	Location string `json:"location,omitempty"`
+}
+
+// validationReport collects all the findings of a validation run.
+type validationReport struct {
+	Valid    bool                `json:"valid"`
+	Findings []validationFinding `json:"findings"`
+}
+
+func (r *validationReport) add(ruleID, level, location, format string, args ...any) {
+	r.Findings = append(r.Findings, validationFinding{
+		RuleID:   ruleID,
+		Level:    level,
+		Message:  fmt.Sprintf(format, args...),
+		Location: location,
+	})
+}
+
+func (r *validationReport) errors() int {
+	count := 0
+	for _, f := range r.Findings {
+		if f.Level == validationLevelError {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (o *validateCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && len(o.Traits) == 0 {
+		return errors.New("validate expects at least a file or a trait to validate")
+	}
+	switch o.OutputFormat {
+	case "text", "json", "sarif":
+	default:
+		return fmt.Errorf("invalid output format %q, expected one of: text|json|sarif", o.OutputFormat)
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+	kamelets, err := loadLocalKamelets(o.KameletsDir)
+	if err != nil {
+		return err
+	}
+
+	report := validationReport{
+		Findings: make([]validationFinding, 0),
+	}
+	o.validateTraits(&report)
+
+	sources, err := source.Resolve(o.Context, args, false, cmd)
+	if err != nil {
+		return fmt.Errorf("one of the provided files is not reachable: %w", err)
+	}
+	for _, src := range sources {
+		if src.IsYaml() && isPipe(src.Content) {
+			o.validatePipe(&report, catalog, kamelets, src)
+		} else {
+			o.validateSource(&report, catalog, kamelets, src)
+		}
+	}
+
+	report.Valid = report.errors() == 0
+	if err := printValidationReport(cmd, o.OutputFormat, report); err != nil {
+		return err
+	}
+	if !report.Valid {
+		return fmt.Errorf("validation failed with %d error(s)", report.errors())
+	}
+
+	return nil
+}
+
+func (o *validateCmdOptions) validateTraits(report *validationReport) {
+	catalog := trait.NewCatalog(nil)
+	for _, option := range o.Traits {
+		name := extractTraitNames([]string{option})[0]
+		if err := trait.ValidateTrait(catalog, name); err != nil {
+			report.add(validationRuleTrait, validationLevelError, option, "%s", err.Error())
+
+			continue
+		}
+		if err := trait.ValidateTraitOptions([]string{option}); err != nil {
+			report.add(validationRuleTrait, validationLevelError, option, "%s", flattenError(err))
+		}
+	}
+}
+
+func (o *validateCmdOptions) validateSource(report *validationReport, catalog *camel.RuntimeCatalog,
+	kamelets map[string]*v1.Kamelet, src source.Source) {
+	spec := v1.NewSourceSpec(src.Name, src.Content, "")
+	language := spec.InferLanguage()
+	switch language {
+	case v1.LanguageJavaSource, v1.LanguageXML, v1.LanguageYaml:
+	default:
+		report.add(validationRuleSource, validationLevelNote, src.Location,
+			"language %q cannot be inspected offline, skipping", language)
+
+		return
+	}
+
+	meta := utilsource.NewMetadata()
+	if err := utilsource.InspectorForLanguage(catalog, language).Extract(spec, &meta); err != nil {
+		report.add(validationRuleSource, validationLevelError, src.Location, "%s", err.Error())
+
+		return
+	}
+
+	for _, uri := range util.StringSliceJoin(meta.FromURIs, meta.ToURIs) {
+		checkComponent(report, catalog, src.Location, uri)
+	}
+	for _, name := range meta.Kamelets {
+		o.checkKamelet(report, kamelets, src.Location, name, nil)
+	}
+}
+
+func (o *validateCmdOptions) validatePipe(report *validationReport, catalog *camel.RuntimeCatalog,
+	kamelets map[string]*v1.Kamelet, src source.Source) {
+	pipe := v1.Pipe{}
+	content, err := yaml.ToJSON([]byte(src.Content))
+	if err == nil {
+		err = json.Unmarshal(content, &pipe)
+	}
+	if err != nil {
+		report.add(validationRulePipe, validationLevelError, src.Location, "%s", err.Error())
+
+		return
+	}
+
+	namespace := pipe.Namespace
+	if namespace == "" {
+		namespace = o.Namespace
+	}
+	bindingContext := bindings.BindingContext{
+		Ctx:       o.Context,
+		Namespace: namespace,
+		Metadata:  pipe.Annotations,
+	}
+
+	o.validateEndpoint(report, catalog, kamelets, src.Location, bindingContext,
+		bindings.EndpointContext{Type: v1.EndpointTypeSource}, pipe.Spec.Source)
+	for i, step := range pipe.Spec.Steps {
+		position := i
+		o.validateEndpoint(report, catalog, kamelets, src.Location, bindingContext,
+			bindings.EndpointContext{Type: v1.EndpointTypeAction, Position: &position}, step)
+	}
+	position := len(pipe.Spec.Steps)
+	for _, branch := range pipe.Spec.GetBranches() {
+		for _, step := range branch.Steps {
+			stepPosition := position
+			position++
+			o.validateEndpoint(report, catalog, kamelets, src.Location, bindingContext,
+				bindings.EndpointContext{Type: v1.EndpointTypeAction, Position: &stepPosition}, step)
+		}
+		if branch.Sink != nil && !branch.Sink.IsEmpty() {
+			sinkPosition := position
+			position++
+			o.validateEndpoint(report, catalog, kamelets, src.Location, bindingContext,
+				bindings.EndpointContext{Type: v1.EndpointTypeSink, Position: &sinkPosition}, *branch.Sink)
+		}
+	}
+	if !pipe.Spec.HasBranches() || !pipe.Spec.Sink.IsEmpty() {
+		o.validateEndpoint(report, catalog, kamelets, src.Location, bindingContext,
+			bindings.EndpointContext{Type: v1.EndpointTypeSink}, pipe.Spec.Sink)
+	}
+	if pipe.Spec.ErrorHandler != nil {
+		report.add(validationRuleOfflineEndpoint, validationLevelNote, src.Location,
+			"error handler is not validated offline")
+	}
+}
+
+func (o *validateCmdOptions) validateEndpoint(report *validationReport, catalog *camel.RuntimeCatalog,
+	kamelets map[string]*v1.Kamelet, location string, bindingContext bindings.BindingContext,
+	endpointContext bindings.EndpointContext, endpoint v1.Endpoint) {
+	if endpoint.Ref != nil {
+		gv, err := schema.ParseGroupVersion(endpoint.Ref.APIVersion)
+		if err != nil {
+			report.add(validationRuleEndpoint, validationLevelError, location, "%s endpoint: %s", endpointContext.Type, err.Error())
+
+			return
+		}
+		if endpoint.Ref.Kind != v1.KameletKind || gv.Group != v1.SchemeGroupVersion.Group {
+			// Any other reference (Knative, Strimzi, Services, ...) is resolved against the cluster
+			report.add(validationRuleOfflineEndpoint, validationLevelNote, location,
+				"%s endpoint %s/%s %s can only be resolved on the cluster",
+				endpointContext.Type, endpoint.Ref.APIVersion, endpoint.Ref.Kind, endpoint.Ref.Name)
+
+			return
+		}
+		props, err := endpoint.Properties.GetPropertyMap()
+		if err != nil {
+			report.add(validationRuleEndpoint, validationLevelError, location, "%s endpoint: %s", endpointContext.Type, err.Error())
+
+			return
+		}
+		if props == nil {
+			props = make(map[string]string)
+		}
+		o.checkKamelet(report, kamelets, location, endpoint.Ref.Name, props)
+	}
+
+	binding, err := bindings.Translate(bindingContext, endpointContext, endpoint)
+	if err != nil {
+		report.add(validationRuleEndpoint, validationLevelError, location, "%s endpoint: %s", endpointContext.Type, err.Error())
+
+		return
+	}
+	if binding.URI != "" {
+		checkComponent(report, catalog, location, binding.URI)
+	}
+}
+
+// checkKamelet verifies the Kamelet is available in the local directory and, when the properties are known,
+// that all the properties required by its definition are provided.
+func (o *validateCmdOptions) checkKamelet(report *validationReport, kamelets map[string]*v1.Kamelet,
+	location, name string, props map[string]string) {
+	if o.KameletsDir == "" {
+		return
+	}
+	kamelet, ok := kamelets[name]
+	if !ok {
+		report.add(validationRuleKameletNotFound, validationLevelError, location,
+			"kamelet %q not found in %s", name, o.KameletsDir)
+
+		return
+	}
+	if props == nil || kamelet.Spec.Definition == nil {
+		return
+	}
+	for _, required := range kamelet.Spec.Definition.Required {
+		if _, ok := props[required]; ok {
+			continue
+		}
+		if prop, ok := kamelet.Spec.Definition.Properties[required]; ok && prop.Default != nil {
+			continue
+		}
+		report.add(validationRuleKameletRequiredKey, validationLevelError, location,
+			"kamelet %q requires property %q", name, required)
+	}
+}
+
+func checkComponent(report *validationReport, catalog *camel.RuntimeCatalog, location, uri string) {
+	if !catalog.IsResolvable(uri) {
+		return
+	}
+	if artifact, _ := catalog.DecodeComponent(uri); artifact == nil {
+		report.add(validationRuleUnknownComponent, validationLevelWarning, location,
+			"component for endpoint %q cannot be found in the Camel catalog %s", uri, catalog.GetRuntimeVersion())
+	}
+}
+
+func isPipe(content string) bool {
+	data, err := yaml.ToJSON([]byte(content))
+	if err != nil {
+		return false
+	}
+	typeMeta := struct {
+		Kind string `json:"kind"`
+	}{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return false
+	}
+
+	return typeMeta.Kind == v1.PipeKind
+}
+
+// loadLocalKamelets reads all the Kamelet files available in the given directory.
+func loadLocalKamelets(dir string) (map[string]*v1.Kamelet, error) {
+	kamelets := make(map[string]*v1.Kamelet)
+	if dir == "" {
+		return kamelets, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isKameletFile(entry.Name()) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data, err := yaml.ToJSON(content)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse kamelet %s: %w", entry.Name(), err)
+		}
+		kamelet := v1.Kamelet{}
+		if err := json.Unmarshal(data, &kamelet); err != nil {
+			return nil, fmt.Errorf("cannot parse kamelet %s: %w", entry.Name(), err)
+		}
+		kamelets[kamelet.Name] = &kamelet
+	}
+
+	return kamelets, nil
+}
+
+func isKameletFile(name string) bool {
+	return strings.HasSuffix(name, ".kamelet.yaml") || strings.HasSuffix(name, ".kamelet.yml") ||
+		strings.HasSuffix(name, ".kamelet.json")
+}
+
+func flattenError(err error) string {
+	lines := strings.Split(err.Error(), "\n")
+	parts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			parts = append(parts, line)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func printValidationReport(cmd *cobra.Command, outputFormat string, report validationReport) error {
+	switch outputFormat {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	case "sarif":
+		data, err := json.MarshalIndent(toSarif(report), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	default:
+		if len(report.Findings) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No problems found")
+
+			return nil
+		}
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "LEVEL\tRULE\tLOCATION\tMESSAGE")
+		for _, f := range report.Findings {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", f.Level, f.RuleID, f.Location, f.Message)
+		}
+
+		return w.Flush()
+	}
+
+	return nil
+}
+
+// toSarif converts the report into a SARIF 2.1.0 log, understood by most CI code scanning tools.
+func toSarif(report validationReport) map[string]any {
+	ruleIDs := make([]string, 0, len(validationRules))
+	for id := range validationRules {
+		ruleIDs = append(ruleIDs, id)
+	}
+	sort.Strings(ruleIDs)
+	rules := make([]map[string]any, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rules = append(rules, map[string]any{
+			"id":               id,
+			"shortDescription": map[string]any{"text": validationRules[id]},
+		})
+	}
+
+	results := make([]map[string]any, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		result := map[string]any{
+			"ruleId":  f.RuleID,
+			"level":   f.Level,
+			"message": map[string]any{"text": f.Message},
+		}
+		if f.Location != "" {
+			result["locations"] = []map[string]any{
+				{
+					"physicalLocation": map[string]any{
+						"artifactLocation": map[string]any{"uri": f.Location},
+					},
+				},
+			}
+		}
+		results = append(results, result)
+	}
+
+	return map[string]any{
+		"$schema": sarifSchema,
+		"version": sarifVersion,
+		"runs": []map[string]any{
+			{
+				"tool": map[string]any{
+					"driver": map[string]any{
+						"name":           "kamel",
+						"informationUri": "https://camel.apache.org/camel-k/",
+						"rules":          rules,
+					},
+				},
+				"results": results,
+			},
+		},
+	}
+}
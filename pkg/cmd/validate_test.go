@@ -0,0 +1,195 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cmdValidate = "validate"
+
+const validateTestKamelet = `apiVersion: camel.apache.org/v1
+kind: Kamelet
+metadata:
+  name: my-source
+spec:
+  definition:
+    required:
+    - message
+    - period
+    properties:
+      message:
+        type: string
+      period:
+        type: integer
+        default: 1000
+  template:
+    from:
+      uri: timer:tick
+      steps:
+      - to: kamelet:sink
+`
+
+func initializeValidateCmdOptions(t *testing.T) (*validateCmdOptions, *cobra.Command) {
+	t.Helper()
+
+	options, rootCmd := kamelTestPreAddCommandInit()
+	options.Namespace = "default"
+	validateCmd, validateOptions := newCmdValidate(options)
+	rootCmd.AddCommand(validateCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return validateOptions, rootCmd
+}
+
+func writeValidateTestFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	return file
+}
+
+// executeValidateCommand runs the command keeping the report apart from the error printed by cobra.
+func executeValidateCommand(root *cobra.Command, args ...string) (string, error) {
+	buf := new(bytes.Buffer)
+	root.SetOut(buf)
+	root.SetErr(io.Discard)
+	root.SetArgs(append([]string{cmdValidate}, args...))
+	_, err := root.ExecuteC()
+
+	return buf.String(), err
+}
+
+func TestValidateNoArgs(t *testing.T) {
+	_, rootCmd := initializeValidateCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdValidate)
+	require.Error(t, err)
+	assert.Equal(t, "validate expects at least a file or a trait to validate", err.Error())
+}
+
+func TestValidateTraits(t *testing.T) {
+	_, rootCmd := initializeValidateCmdOptions(t)
+	output, err := executeValidateCommand(rootCmd, "-o", "json",
+		"-t", "container.request-cpu=1",
+		"-t", "container.request-cpus=1",
+		"-t", "missing.enabled=true")
+	require.Error(t, err)
+	assert.Equal(t, "validation failed with 2 error(s)", err.Error())
+
+	report := validationReport{}
+	require.NoError(t, json.Unmarshal([]byte(output), &report))
+	assert.False(t, report.Valid)
+	require.Len(t, report.Findings, 2)
+	assert.Equal(t, validationRuleTrait, report.Findings[0].RuleID)
+	assert.Contains(t, report.Findings[0].Message, "request-cpus")
+	assert.Equal(t, "trait missing does not exist in catalog", report.Findings[1].Message)
+}
+
+func TestValidateSource(t *testing.T) {
+	dir := t.TempDir()
+	route := writeValidateTestFile(t, dir, "route.yaml", `
+- from:
+    uri: timer:tick
+    steps:
+    - to: my-custom:endpoint
+    - to: log:info
+`)
+
+	_, rootCmd := initializeValidateCmdOptions(t)
+	output, err := executeValidateCommand(rootCmd, "-o", "json", route)
+	require.NoError(t, err)
+
+	report := validationReport{}
+	require.NoError(t, json.Unmarshal([]byte(output), &report))
+	assert.True(t, report.Valid)
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, validationRuleUnknownComponent, report.Findings[0].RuleID)
+	assert.Equal(t, validationLevelWarning, report.Findings[0].Level)
+	assert.Contains(t, report.Findings[0].Message, "my-custom:endpoint")
+	assert.Equal(t, route, report.Findings[0].Location)
+}
+
+func TestValidatePipe(t *testing.T) {
+	dir := t.TempDir()
+	kameletsDir := filepath.Join(dir, "kamelets")
+	require.NoError(t, os.Mkdir(kameletsDir, 0o700))
+	writeValidateTestFile(t, kameletsDir, "my-source.kamelet.yaml", validateTestKamelet)
+	pipe := writeValidateTestFile(t, dir, "pipe.yaml", `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-pipe
+spec:
+  source:
+    ref:
+      apiVersion: camel.apache.org/v1
+      kind: Kamelet
+      name: my-source
+  steps:
+  - ref:
+      apiVersion: camel.apache.org/v1
+      kind: Kamelet
+      name: missing-action
+  sink:
+    ref:
+      apiVersion: messaging.knative.dev/v1
+      kind: Channel
+      name: my-channel
+`)
+
+	_, rootCmd := initializeValidateCmdOptions(t)
+	output, err := executeValidateCommand(rootCmd, "-o", "json", "--kamelets-dir", kameletsDir, pipe)
+	require.Error(t, err)
+
+	report := validationReport{}
+	require.NoError(t, json.Unmarshal([]byte(output), &report))
+	rules := make([]string, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		rules = append(rules, f.RuleID)
+	}
+	assert.Equal(t, []string{validationRuleKameletRequiredKey, validationRuleKameletNotFound, validationRuleOfflineEndpoint}, rules)
+	assert.Equal(t, `kamelet "my-source" requires property "message"`, report.Findings[0].Message)
+}
+
+func TestValidateSarifOutput(t *testing.T) {
+	_, rootCmd := initializeValidateCmdOptions(t)
+	output, err := executeValidateCommand(rootCmd, "-o", "sarif", "-t", "container.request-cpus=1")
+	require.Error(t, err)
+
+	sarif := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(output), &sarif))
+	assert.Equal(t, sarifVersion, sarif["version"])
+	runs, ok := sarif["runs"].([]any)
+	require.True(t, ok)
+	require.Len(t, runs, 1)
+	run, ok := runs[0].(map[string]any)
+	require.True(t, ok)
+	results, ok := run["results"].([]any)
+	require.True(t, ok)
+	assert.Len(t, results, 1)
+}
@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newCmdWake(rootCmdOptions *RootCmdOptions) (*cobra.Command, *wakeCmdOptions) {
+	options := wakeCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "wake [name1] [name2] ...",
+		Short: "Wake up one or more Integrations or Pipes sleeping according to their sleep schedules.",
+		Long: `Wake up one or more Integrations or Pipes scaled down by the sleep trait, causing them to scale back up ` +
+			`and to stay awake until their next scheduled sleep.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type wakeCmdOptions struct {
+	*RootCmdOptions
+}
+
+func (o *wakeCmdOptions) validate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("wake requires an Integration or Pipe name argument")
+	}
+
+	return nil
+}
+
+func (o *wakeCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	integrations, err := getIntegrations(o.Context, c, args, o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	woken, err := o.wakeIntegrations(cmd, c, integrations, time.Now())
+	// We print the number of woken integrations anyway (they could have been correctly processed)
+	fmt.Fprintln(cmd.OutOrStdout(), woken, "integrations have been woken up")
+
+	return err
+}
+
+func (o *wakeCmdOptions) wakeIntegrations(cmd *cobra.Command, c k8sclient.Client, integrations []v1.Integration, now time.Time) (int, error) {
+	woken := 0
+	for _, i := range integrations {
+		if i.Status.Sleep == nil || !i.Status.Sleep.Sleeping {
+			fmt.Fprintf(cmd.OutOrStdout(), "warning: could not wake up integration %s, it is not sleeping\n", i.Name)
+
+			continue
+		}
+		it := i.DeepCopy()
+		if it.Annotations == nil {
+			it.Annotations = make(map[string]string)
+		}
+		it.Annotations[v1.IntegrationWokenAtAnnotation] = now.UTC().Format(time.RFC3339)
+		if err := c.Patch(o.Context, it, k8sclient.MergeFrom(&i)); err != nil {
+			return woken, fmt.Errorf("could not wake up %s in namespace %s: %w", it.Name, o.Namespace, err)
+		}
+		woken++
+	}
+
+	return woken, nil
+}
@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const cmdWake = "wake"
+
+func initializeWakeCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, *wakeCmdOptions) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	wakeCmdOptions := addTestWakeCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, wakeCmdOptions
+}
+
+func addTestWakeCmd(options RootCmdOptions, rootCmd *cobra.Command) *wakeCmdOptions {
+	wakeCmd, wakeOptions := newCmdWake(&options)
+	wakeCmd.Args = ArbitraryArgs
+	rootCmd.AddCommand(wakeCmd)
+	return wakeOptions
+}
+
+func TestWakeNoArgs(t *testing.T) {
+	cmd, _ := initializeWakeCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdWake)
+	require.Error(t, err)
+	assert.Equal(t, "wake requires an Integration or Pipe name argument", err.Error())
+}
+
+func TestWakeMissingIntegrations(t *testing.T) {
+	cmd, _ := initializeWakeCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdWake, "missing")
+	require.Error(t, err)
+	assert.Equal(t,
+		"could not find integration missing in namespace default: integrations.camel.apache.org \"missing\" not found",
+		err.Error())
+}
+
+func TestWakeNotSleepingIntegrations(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	cmd, _ := initializeWakeCmdOptions(t, &it)
+	output, err := ExecuteCommand(cmd, cmdWake, "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "could not wake up integration my-it, it is not sleeping")
+	assert.Contains(t, output, "0 integrations have been woken up")
+}
+
+func TestWakeIntegrations(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Sleep = &v1.IntegrationSleepStatus{
+		Sleeping: true,
+	}
+	cmd, options := initializeWakeCmdOptions(t, &it)
+	output, err := ExecuteCommand(cmd, cmdWake, "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "1 integrations have been woken up")
+
+	c, err := options.GetCmdClient()
+	require.NoError(t, err)
+	woken := v1.NewIntegration("default", "my-it")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&woken), &woken))
+	wokenAt, err := time.Parse(time.RFC3339, woken.Annotations[v1.IntegrationWokenAtAnnotation])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), wokenAt, time.Minute)
+}
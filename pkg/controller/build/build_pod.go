@@ -19,15 +19,19 @@ package build
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -35,8 +39,12 @@ import (
 )
 
 const (
-	builderDir    = "/builder"
-	builderVolume = "camel-k-builder"
+	builderDir            = "/builder"
+	builderVolume         = "camel-k-builder"
+	dependencyCacheVolume = "camel-k-dependency-cache"
+	signingSecretVolume   = "camel-k-signing"
+	// the size of the dependency cache claim, when no maximum size is configured.
+	dependencyCacheDefaultClaimSize = "10Gi"
 )
 
 func newBuildPod(ctx context.Context, client client.Client, build *v1.Build) *corev1.Pod {
@@ -95,8 +103,10 @@ func newBuildPod(ctx context.Context, client client.Client, build *v1.Build) *co
 		//nolint:staticcheck
 		case task.S2i != nil:
 			addBuildTaskToPod(ctx, client, build, task.S2i.Name, pod)
+			addSigningSecretToPod(task.S2i.PublishTask, pod)
 		case task.Jib != nil:
 			addBuildTaskToPod(ctx, client, build, task.Jib.Name, pod)
+			addSigningSecretToPod(task.Jib.PublishTask, pod)
 		}
 	}
 
@@ -229,9 +239,114 @@ func addBuildTaskToPod(ctx context.Context, client client.Client, build *v1.Buil
 	}
 
 	configureResources(taskName, build, &container)
+	addDependencyCacheToPod(build, &container, pod)
 	addContainerToPod(build, container, pod)
 }
 
+// dependencyCacheSpec returns the dependency cache configuration of the build, if enabled.
+func dependencyCacheSpec(build *v1.Build) *v1.MavenDependencyCacheSpec {
+	for _, task := range build.Spec.Tasks {
+		if task.Builder != nil {
+			spec := task.Builder.Maven.DependencyCache
+			if spec != nil && ptr.Deref(spec.Enabled, false) {
+				return spec
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// addDependencyCacheToPod mounts the dependency cache PersistentVolumeClaim, so that it's shared across the builder Pods.
+func addDependencyCacheToPod(build *v1.Build, container *corev1.Container, pod *corev1.Pod) {
+	spec := dependencyCacheSpec(build)
+	if spec == nil || spec.PersistentVolumeClaim == "" {
+		return
+	}
+	if !hasVolume(pod, dependencyCacheVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: dependencyCacheVolume,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: spec.PersistentVolumeClaim,
+				},
+			},
+		})
+	}
+	path := spec.Path
+	if path == "" {
+		path = builder.DependencyCacheDefaultPath
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      dependencyCacheVolume,
+		MountPath: path,
+	})
+}
+
+// ensureDependencyCacheClaim creates the dependency cache PersistentVolumeClaim in the Build namespace, if missing.
+func ensureDependencyCacheClaim(ctx context.Context, c client.Client, build *v1.Build) error {
+	spec := dependencyCacheSpec(build)
+	if spec == nil || spec.PersistentVolumeClaim == "" {
+		return nil
+	}
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, build.Namespace, spec.PersistentVolumeClaim)
+	if err != nil || pvc != nil {
+		return err
+	}
+
+	size := spec.MaxSize
+	if size == "" {
+		size = dependencyCacheDefaultClaimSize
+	}
+	capacity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid dependency cache max size %s: %w", size, err)
+	}
+	// The claim is shared by concurrent builder Pods, possibly scheduled on different nodes
+	pvc = kubernetes.NewPersistentVolumeClaim(build.Namespace, spec.PersistentVolumeClaim, spec.StorageClassName,
+		capacity, corev1.ReadWriteMany)
+	if spec.StorageClassName == "" {
+		// Use the default StorageClass
+		pvc.Spec.StorageClassName = nil
+	}
+	pvc.Labels = map[string]string{
+		"camel.apache.org/component": "builder",
+	}
+	Log.WithValues("request-namespace", build.Namespace, "request-name", build.Name).
+		Infof("Creating dependency cache PersistentVolumeClaim %s", pvc.Name)
+	if err := c.Create(ctx, pvc); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("cannot create dependency cache PersistentVolumeClaim: %w", err)
+	}
+
+	return nil
+}
+
+// addSigningSecretToPod mounts the image signing Secret into the publishing task container, so that the builder
+// ServiceAccount isn't granted to read the Secrets of the namespace.
+func addSigningSecretToPod(task v1.PublishTask, pod *corev1.Pod) {
+	if task.Signing == nil || task.Signing.Secret == "" {
+		return
+	}
+	if !hasVolume(pod, signingSecretVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: signingSecretVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: task.Signing.Secret,
+				},
+			},
+		})
+	}
+	container := &pod.Spec.InitContainers[len(pod.Spec.InitContainers)-1]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      signingSecretVolume,
+		MountPath: builder.SigningSecretDir,
+		ReadOnly:  true,
+	})
+}
+
 func addCustomTaskToPod(build *v1.Build, task *v1.UserTask, pod *corev1.Pod) {
 	container := corev1.Container{
 		Name:            task.Name,
@@ -22,10 +22,14 @@ import (
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestNewBuildPodConfiguration(t *testing.T) {
@@ -66,3 +70,144 @@ func TestNewBuildPodConfiguration(t *testing.T) {
 	assert.Equal(t, map[string]string{"node": "selector"}, pod.Spec.NodeSelector)
 	assert.Equal(t, map[string]string{"annotation": "value"}, pod.Annotations)
 }
+
+func TestNewBuildPodDependencyCache(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "theBuildName",
+			Namespace: "theNamespace",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+						},
+						Maven: v1.MavenBuildSpec{
+							MavenSpec: v1.MavenSpec{
+								DependencyCache: &v1.MavenDependencyCacheSpec{
+									Enabled:               ptr.To(true),
+									Path:                  "/cache",
+									PersistentVolumeClaim: "maven-cache",
+									MaxSize:               "5Gi",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, ensureDependencyCacheClaim(ctx, c, &build))
+	pvc := corev1.PersistentVolumeClaim{}
+	require.NoError(t, c.Get(ctx, ctrl.ObjectKey{Namespace: "theNamespace", Name: "maven-cache"}, &pvc))
+	assert.Nil(t, pvc.Spec.StorageClassName)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	assert.Equal(t, "5Gi", pvc.Spec.Resources.Requests.Storage().String())
+	// The claim is created only once
+	require.NoError(t, ensureDependencyCacheClaim(ctx, c, &build))
+
+	pod := newBuildPod(ctx, c, &build)
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      dependencyCacheVolume,
+		MountPath: "/cache",
+	})
+	assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+		Name: dependencyCacheVolume,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: "maven-cache",
+			},
+		},
+	})
+}
+
+func TestNewBuildPodDependencyCacheDisabled(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "theBuildName",
+			Namespace: "theNamespace",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+						},
+						Maven: v1.MavenBuildSpec{
+							MavenSpec: v1.MavenSpec{
+								DependencyCache: &v1.MavenDependencyCacheSpec{
+									PersistentVolumeClaim: "maven-cache",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, ensureDependencyCacheClaim(ctx, c, &build))
+	pvc := corev1.PersistentVolumeClaim{}
+	require.Error(t, c.Get(ctx, ctrl.ObjectKey{Namespace: "theNamespace", Name: "maven-cache"}, &pvc))
+
+	pod := newBuildPod(ctx, c, &build)
+	assert.False(t, hasVolume(pod, dependencyCacheVolume))
+}
+
+func TestNewBuildPodSigningSecret(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "theBuildName",
+			Namespace: "theNamespace",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{Name: "builder"},
+					},
+				},
+				{
+					Jib: &v1.JibTask{
+						BaseTask: v1.BaseTask{Name: "jib"},
+						PublishTask: v1.PublishTask{
+							Signing: &v1.ImageSigningSpec{Secret: "camel-k-signing"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := newBuildPod(ctx, c, &build)
+
+	assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+		Name: signingSecretVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "camel-k-signing"},
+		},
+	})
+	signingMount := corev1.VolumeMount{Name: signingSecretVolume, MountPath: builder.SigningSecretDir, ReadOnly: true}
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, signingMount)
+	// The signing Secret is only available to the publishing task
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.NotContains(t, pod.Spec.InitContainers[0].VolumeMounts, signingMount)
+}
@@ -30,8 +30,12 @@ import (
 )
 
 const (
-	buildResultLabel = "result"
-	buildTypeLabel   = "type"
+	buildResultLabel           = "result"
+	buildTypeLabel             = "type"
+	dependencyCacheResultLabel = "result"
+
+	dependencyCacheHit  = "hit"
+	dependencyCacheMiss = "miss"
 )
 
 var (
@@ -82,11 +86,22 @@ var (
 			buildTypeLabel,
 		},
 	)
+
+	dependencyCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "camel_k_build_dependency_cache_total",
+			Help: "Camel K build dependency cache hits and misses",
+		},
+		[]string{
+			dependencyCacheResultLabel,
+			buildTypeLabel,
+		},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration)
+	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration, dependencyCacheRequests)
 }
 
 func observeBuildQueueDuration(build *v1.Build, creator *corev1.ObjectReference) {
@@ -130,6 +145,20 @@ func observeBuildResult(build *v1.Build, phase v1.BuildPhase, creator *corev1.Ob
 	buildDuration.WithLabelValues(resultLabel, typeLabel).Observe(duration.Seconds())
 }
 
+// observeDependencyCacheResult accounts for the usage of the dependency cache, as reported by the builder.
+func observeDependencyCacheResult(build *v1.Build, status v1.BuildStatus) {
+	condition := status.GetCondition(v1.BuildConditionDependencyCache)
+	if condition == nil {
+		return
+	}
+
+	resultLabel := dependencyCacheMiss
+	if condition.Status == corev1.ConditionTrue {
+		resultLabel = dependencyCacheHit
+	}
+	dependencyCacheRequests.WithLabelValues(resultLabel, build.Labels[v1.IntegrationKitLayoutLabel]).Inc()
+}
+
 func getBuildAttemptFor(build *v1.Build) (int, int) {
 	attempt := 0
 	attemptMax := math.MaxInt32
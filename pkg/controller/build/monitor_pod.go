@@ -71,6 +71,9 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 	if pod == nil {
 		switch build.Status.Phase {
 		case v1.BuildPhasePending:
+			if err = ensureDependencyCacheClaim(ctx, action.client, build); err != nil {
+				return nil, err
+			}
 			pod = newBuildPod(ctx, action.client, build)
 			if err = controllerutil.SetControllerReference(build, pod, action.client.GetScheme()); err != nil {
 				return nil, err
@@ -130,6 +133,7 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		buildCreator := kubernetes.GetCamelCreator(build)
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, buildCreator, duration)
+		observeDependencyCacheResult(build, build.Status)
 
 		// operator supported publishing tasks should provide the image name and digest in the builder command process execution
 		if !operatorSupportedPublishingStrategy(build.Spec.Tasks) {
@@ -183,6 +187,7 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		buildCreator := kubernetes.GetCamelCreator(build)
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, buildCreator, duration)
+		observeDependencyCacheResult(build, build.Status)
 	}
 
 	return build, nil
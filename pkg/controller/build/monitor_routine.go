@@ -163,8 +163,14 @@ tasks:
 				t.ContextDir = filepath.Join(buildDir, builder.ContextDir)
 			}
 
-			// Execute the task
+			// Execute the task, retaining the conditions reported by the previous tasks
+			conditions := status.Conditions
 			status = Builder.Build(build).Task(task).Do(ctxWithTimeout)
+			for _, condition := range conditions {
+				if status.GetCondition(condition.Type) == nil {
+					status.Conditions = append(status.Conditions, condition)
+				}
+			}
 
 			lastTask := i == len(build.Spec.Tasks)-1
 			taskFailed := status.Phase == v1.BuildPhaseFailed ||
@@ -197,6 +203,7 @@ tasks:
 	buildCreator := kubernetes.GetCamelCreator(build)
 	// Account for the Build metrics
 	observeBuildResult(build, status.Phase, buildCreator, duration)
+	observeDependencyCacheResult(build, status)
 
 	_ = action.updateBuildStatus(ctx, build, status)
 }
@@ -20,13 +20,19 @@ package integration
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
 )
 
 // NewInitializeAction creates a new initialize action.
@@ -79,7 +85,7 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 	}
 
 	if integration.Status.IntegrationKit == nil {
-		ikt := action.lookupIntegrationKit(integration)
+		ikt := action.lookupIntegrationKit(ctx, integration)
 		integration.SetIntegrationKit(ikt)
 	}
 
@@ -100,8 +106,12 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 	return integration, nil
 }
 
-func (action *initializeAction) lookupIntegrationKit(integration *v1.Integration) *v1.IntegrationKit {
+func (action *initializeAction) lookupIntegrationKit(ctx context.Context, integration *v1.Integration) *v1.IntegrationKit {
 	if integration.Spec.IntegrationKit == nil || integration.Spec.IntegrationKit.Name == "" {
+		if kit := action.lookupRollbackKit(ctx, integration); kit != nil {
+			return v1.NewIntegrationKit(kit.Namespace, kit.Name)
+		}
+
 		return nil
 	}
 
@@ -111,6 +121,40 @@ func (action *initializeAction) lookupIntegrationKit(integration *v1.Integration
 	return v1.NewIntegrationKit(kitNamespace, kitName)
 }
 
+// lookupRollbackKit returns the kit of the revision the Integration has been rolled back to, as long as
+// the Integration specification is the one recorded in the revision, or nil otherwise.
+func (action *initializeAction) lookupRollbackKit(ctx context.Context, integration *v1.Integration) *corev1.ObjectReference {
+	value := integration.Annotations[v1.IntegrationRollbackRevisionAnnotation]
+	if value == "" {
+		return nil
+	}
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		action.L.Infof("Ignoring the invalid rollback revision %q of Integration %s", value, integration.Name)
+
+		return nil
+	}
+	rev := &appsv1.ControllerRevision{}
+	key := ctrl.ObjectKey{Namespace: integration.Namespace, Name: revision.Name(integration.Name, number)}
+	if err := action.client.Get(ctx, key, rev); err != nil {
+		action.L.Infof("Unable to get the revision %d Integration %s has been rolled back to: %v", number, integration.Name, err)
+
+		return nil
+	}
+	data, err := revision.Decode(rev)
+	if err != nil {
+		action.L.Infof("Unable to decode the revision %d Integration %s has been rolled back to: %v", number, integration.Name, err)
+
+		return nil
+	}
+	if !equality.Semantic.DeepEqual(data.Spec, integration.Spec) {
+		// The Integration has been changed since it has been rolled back
+		return nil
+	}
+
+	return data.IntegrationKit
+}
+
 func (action *initializeAction) importFromExternalApp(integration *v1.Integration) (*v1.Integration, error) {
 	readyMessage := fmt.Sprintf(
 		"imported from %s %s",
@@ -27,6 +27,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
 
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/stretchr/testify/assert"
@@ -188,3 +189,35 @@ func TestCamelImportUnsupportedKind(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionImportingKindAvailableReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 	assert.Equal(t, "Unsupported SomeKind import kind", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
 }
+
+func TestLookupRollbackKit(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	it.Spec.Sources = []v1.SourceSpec{v1.NewSourceSpec("routes.yaml", "timer:v1", v1.LanguageYaml)}
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "ns", Name: "kit-v1"}
+	rev, err := revision.New(&it, 1)
+	require.NoError(t, err)
+	c, err := internal.NewFakeClient(rev)
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	rolledBack := v1.NewIntegration("ns", "my-it")
+	rolledBack.Spec = *it.Spec.DeepCopy()
+	assert.Nil(t, a.lookupIntegrationKit(context.TODO(), &rolledBack))
+
+	v1.SetAnnotation(&rolledBack.ObjectMeta, v1.IntegrationRollbackRevisionAnnotation, "1")
+	kit := a.lookupIntegrationKit(context.TODO(), &rolledBack)
+	require.NotNil(t, kit)
+	assert.Equal(t, "ns", kit.Namespace)
+	assert.Equal(t, "kit-v1", kit.Name)
+
+	// The kit of the revision is no longer used once the Integration is changed
+	rolledBack.Spec.Sources[0].Content = "timer:v2"
+	assert.Nil(t, a.lookupIntegrationKit(context.TODO(), &rolledBack))
+
+	rolledBack.Spec = *it.Spec.DeepCopy()
+	v1.SetAnnotation(&rolledBack.ObjectMeta, v1.IntegrationRollbackRevisionAnnotation, "2")
+	assert.Nil(t, a.lookupIntegrationKit(context.TODO(), &rolledBack))
+}
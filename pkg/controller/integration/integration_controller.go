@@ -125,9 +125,20 @@ func integrationUpdateFunc(c client.Client, old *v1.Integration, it *v1.Integrat
 
 	// Ignore updates to the integration status in which case metadata.Generation does not change,
 	// or except when the integration phase changes as it's used to transition from one phase
-	// to another.
+	// to another, when a rollout moves forward as the deployed resources must follow, or when
+	// the integration is woken up on demand.
 	return old.Generation != it.Generation ||
-		old.Status.Phase != it.Status.Phase
+		old.Status.Phase != it.Status.Phase ||
+		rolloutChanged(old.Status.Rollout, it.Status.Rollout) ||
+		wokenUpChanged(old, it)
+}
+
+func rolloutChanged(old *v1.IntegrationRolloutStatus, rollout *v1.IntegrationRolloutStatus) bool {
+	if old == nil || rollout == nil {
+		return old != rollout
+	}
+
+	return old.Phase != rollout.Phase || old.Step != rollout.Step
 }
 
 func isIntegrationUpdated(it *v1.Integration, previous, next *v1.IntegrationCondition) bool {
@@ -285,6 +296,39 @@ func integrationPlatformEnqueueRequestsFromMapFunc(ctx context.Context, c client
 	return requests
 }
 
+func integrationProfileEnqueueRequestsFromMapFunc(ctx context.Context, c client.Client, profile *v1.IntegrationProfile) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &v1.IntegrationList{}
+
+	// Do global search in case of global operator (it may be using a profile from the operator namespace)
+	var opts []ctrl.ListOption
+	if !platform.IsCurrentOperatorGlobal() {
+		opts = append(opts, ctrl.InNamespace(profile.Namespace))
+	}
+
+	if err := c.List(ctx, list, opts...); err != nil {
+		log.Error(err, "Failed to list integrations")
+
+		return requests
+	}
+
+	for i := range list.Items {
+		integration := &list.Items[i]
+		if platform.IsIntegrationProfileReferenced(ctx, c, integration, profile) {
+			log.Infof("IntegrationProfile %s changed, reconcile integration: %s", profile.Name, integration.Name)
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: integration.Namespace,
+					Name:      integration.Name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 	b := builder.ControllerManagedBy(mgr).
 		Named("integration-controller").
@@ -354,6 +398,25 @@ func watchIntegrationResources(c client.Client, b *builder.Builder) {
 
 				return integrationPlatformEnqueueRequestsFromMapFunc(ctx, c, p)
 			})).
+		// Watch for IntegrationProfile changes and enqueue requests for any integrations
+		// using the profile, so that they get the new configuration
+		Watches(&v1.IntegrationProfile{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrl.Object) []reconcile.Request {
+				profile, ok := a.(*v1.IntegrationProfile)
+				if !ok {
+					log.Error(fmt.Errorf("type assertion failed: %v", a), "Failed to retrieve IntegrationProfile")
+
+					return []reconcile.Request{}
+				}
+
+				return integrationProfileEnqueueRequestsFromMapFunc(ctx, c, profile)
+			}),
+			builder.WithPredicates(platform.FilteringFuncs[ctrl.Object]{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					// Only spec changes are relevant to the integrations
+					return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration()
+				},
+			})).
 		// Watch for Configmaps or Secret used in the Integrations for updates
 		Watches(&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrl.Object) []reconcile.Request {
@@ -532,9 +595,19 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		// is always at its latest state
 		camelevent.NotifyIntegrationUpdated(ctx, r.client, r.recorder, &instance, newTarget)
 
+		// A progressing rollout must be checked periodically, as it moves forward over time
+		if newTarget != nil && newTarget.Status.Rollout != nil &&
+			newTarget.Status.Rollout.Phase == v1.IntegrationRolloutPhaseProgressing {
+			return reconcile.Result{RequeueAfter: rolloutRequeuePeriod}, nil
+		}
+
 		break
 	}
 
+	if target.Status.Phase == v1.IntegrationPhaseRunning {
+		return reconcile.Result{RequeueAfter: requeuePeriod(target)}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -19,11 +19,13 @@ package integration
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -44,9 +46,14 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/digest"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// externalSecretsRequeuePeriod is the period at which the values of the external secrets of a running Integration
+// are checked for rotation, as external stores can't be watched.
+const externalSecretsRequeuePeriod = 1 * time.Minute
+
 // NewMonitorAction is an action used to monitor manager Integrations.
 func NewMonitorAction() Action {
 	return &monitorAction{}
@@ -128,8 +135,17 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		}
 		if priorityReadyKit != nil {
 			integration.SetIntegrationKit(priorityReadyKit)
+			// The Integration is deployed with a new version, to be recorded in a new revision
+			integration.Status.Revision = 0
 		}
 	}
+	// Verify the image signature before any workload is created
+	if verified, err := action.verifyImageSignature(ctx, integration); err != nil {
+		return nil, err
+	} else if !verified {
+		return integration, nil
+	}
+
 	// Run traits that are enabled for the phase
 	environment, err := trait.Apply(ctx, action.client, integration, kit)
 	if err != nil {
@@ -140,6 +156,9 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		return integration, err
 	}
 	action.checkTraitAnnotationsDeprecatedNotice(integration)
+	if err := action.recordRevision(ctx, integration); err != nil {
+		return nil, err
+	}
 
 	return action.monitorPods(ctx, environment, integration)
 }
@@ -218,14 +237,17 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 	if err != nil {
 		return nil, err
 	}
+	// The Pods of a new version being rolled out are monitored separately
+	pending, canaryPending := splitRolloutPods(pendingPods.Items)
+	running, canaryRunning := splitRolloutPods(runningPods.Items)
 	nonTerminatingPods := 0
-	for _, pod := range runningPods.Items {
+	for _, pod := range running {
 		if pod.DeletionTimestamp != nil {
 			continue
 		}
 		nonTerminatingPods++
 	}
-	podCount := len(pendingPods.Items) + nonTerminatingPods
+	podCount := len(pending) + nonTerminatingPods
 	replicas, err := util.IToInt32(podCount)
 	if err != nil {
 		return nil, err
@@ -237,10 +259,14 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 		integration.Status.Phase = v1.IntegrationPhaseRunning
 	}
 	if err = action.updateIntegrationPhaseAndReadyCondition(
-		ctx, controller, environment, integration, pendingPods.Items, runningPods.Items,
+		ctx, controller, environment, integration, pending, running,
 	); err != nil {
 		return nil, err
 	}
+	if err = action.monitorRollout(ctx, environment, integration, canaryPending, canaryRunning); err != nil {
+		return nil, err
+	}
+	action.sampleResources(ctx, environment, integration, running)
 
 	return integration, nil
 }
@@ -360,10 +386,64 @@ func getIntegrationSecretAndConfigmapResourceVersions(ctx context.Context, clien
 			}
 		}
 	}
+	if integration.Status.Traits != nil && integration.Status.Traits.Mount != nil {
+		// The values of external secrets are always watched, so that the Integration is rolled when they rotate
+		for _, ref := range trait.ExternalSecretReferences(integration.Status.Traits.Mount.Configs) {
+			secrets = append(secrets, externalSecretVersion(ctx, client, integration, ref))
+		}
+	}
 
 	return secrets, configmaps
 }
 
+// requeuePeriod returns the period at which a running Integration must be reconciled, regardless of any change,
+// or zero if it is only reconciled upon changes.
+func requeuePeriod(integration *v1.Integration) time.Duration {
+	var period time.Duration
+	// The resource usage is sampled periodically, so that the recommendations applied at the next rollout are up to date
+	if appliesResourceRecommendations(integration) {
+		period = resourcesSamplePeriod
+	}
+	// External secrets can't be watched, so they are checked periodically
+	if hasExternalSecrets(integration) {
+		period = externalSecretsRequeuePeriod
+	}
+
+	// The Integration must go to sleep or wake up at the time of its next sleep transition
+	return sleepRequeuePeriod(integration, period)
+}
+
+// hasExternalSecrets returns true if the Integration mounts any external secret.
+func hasExternalSecrets(integration *v1.Integration) bool {
+	return integration.Status.Traits != nil && integration.Status.Traits.Mount != nil &&
+		len(trait.ExternalSecretReferences(integration.Status.Traits.Mount.Configs)) > 0
+}
+
+// externalSecretVersion returns a hash of the current value of the external secret reference. When the value can't be
+// resolved, e.g., because of a transient error of the provider, the hash of the last value materialized for the
+// Integration is returned, so that the Integration isn't reset until the value is resolved again.
+func externalSecretVersion(ctx context.Context, client client.Client, integration *v1.Integration, ref secrets.Reference) string {
+	value, err := secrets.Resolve(secrets.ProviderContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: integration.Namespace,
+	}, ref)
+	if err != nil {
+		Log.ForIntegration(integration).Errorf(err, "Unable to resolve external secret %s", ref.String())
+
+		materialized, err := kubernetes.GetSecret(ctx, client, trait.ExternalSecretName(integration), integration.Namespace)
+		if err != nil {
+			return ""
+		}
+		value = materialized.Data[ref.Key]
+		if value == nil {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(value))
+}
+
 type controller interface {
 	checkReadyCondition(ctx context.Context) (bool, error)
 	updateReadyCondition(readyPods int32) bool
@@ -417,10 +497,12 @@ func (action *monitorAction) newController(env *trait.Environment, integration *
 	return controller, nil
 }
 
-// getUpdatedController retrieves the controller updated from the deployer trait execution.
+// getUpdatedController returns the controller of the Integration, excluding the Deployment running its new
+// version during a rollout, that is monitored separately.
 func getUpdatedController(env *trait.Environment, obj ctrl.Object) ctrl.Object {
 	return env.Resources.GetController(func(object ctrl.Object) bool {
-		return reflect.TypeOf(obj) == reflect.TypeOf(object)
+		return reflect.TypeOf(obj) == reflect.TypeOf(object) &&
+			object.GetLabels()[v1.IntegrationRolloutTrackLabel] != v1.IntegrationRolloutTrackCanary
 	})
 }
 
@@ -457,16 +539,25 @@ func (action *monitorAction) updateIntegrationPhaseAndReadyCondition(
 }
 
 func arePodsFailingStatuses(integration *v1.Integration, pendingPods []corev1.Pod, runningPods []corev1.Pod) bool {
+	if message, failing := podsFailingStatus(pendingPods, runningPods); failing {
+		integration.Status.Phase = v1.IntegrationPhaseError
+		integration.SetReadyConditionError(message)
+
+		return true
+	}
+
+	return false
+}
+
+// podsFailingStatus returns the message of the first failing status found in the given Pods, if any.
+func podsFailingStatus(pendingPods []corev1.Pod, runningPods []corev1.Pod) (string, bool) {
 	// Check Pods statuses
 	for _, pod := range pendingPods {
 		// Check the scheduled condition
 		if scheduled := kubernetes.GetPodCondition(pod, corev1.PodScheduled); scheduled != nil &&
 			scheduled.Status == corev1.ConditionFalse &&
 			scheduled.Reason == "Unschedulable" {
-			integration.Status.Phase = v1.IntegrationPhaseError
-			integration.SetReadyConditionError(scheduled.Message)
-
-			return true
+			return scheduled.Message, true
 		}
 	}
 	// Check pending container statuses
@@ -477,10 +568,7 @@ func arePodsFailingStatuses(integration *v1.Integration, pendingPods []corev1.Po
 		for _, container := range containers {
 			// Check the images are pulled
 			if waiting := container.State.Waiting; waiting != nil && waiting.Reason == "ImagePullBackOff" {
-				integration.Status.Phase = v1.IntegrationPhaseError
-				integration.SetReadyConditionError(waiting.Message)
-
-				return true
+				return waiting.Message, true
 			}
 		}
 	}
@@ -495,21 +583,15 @@ func arePodsFailingStatuses(integration *v1.Integration, pendingPods []corev1.Po
 		for _, container := range containers {
 			// Check the container state
 			if waiting := container.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
-				integration.Status.Phase = v1.IntegrationPhaseError
-				integration.SetReadyConditionError(waiting.Message)
-
-				return true
+				return waiting.Message, true
 			}
 			if terminated := container.State.Terminated; terminated != nil && terminated.Reason == "Error" {
-				integration.Status.Phase = v1.IntegrationPhaseError
-				integration.SetReadyConditionError(terminated.Message)
-
-				return true
+				return terminated.Message, true
 			}
 		}
 	}
 
-	return false
+	return "", false
 }
 
 // probeReadiness calls the readiness probes of the non-ready Pods directly to retrieve insights from the Camel runtime.
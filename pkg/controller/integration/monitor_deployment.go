@@ -36,25 +36,31 @@ type deploymentController struct {
 var _ controller = &deploymentController{}
 
 func (c *deploymentController) checkReadyCondition(ctx context.Context) (bool, error) {
-	// Check the Deployment progression
-	progressing := kubernetes.GetDeploymentCondition(*c.obj, appsv1.DeploymentProgressing)
-	replicaFailure := kubernetes.GetDeploymentCondition(*c.obj, appsv1.DeploymentReplicaFailure)
-
-	if replicaFailure != nil && replicaFailure.Status == corev1.ConditionTrue {
+	if message, failed := deploymentFailure(c.obj); failed {
 		c.integration.Status.Phase = v1.IntegrationPhaseError
-		c.integration.SetReadyConditionError(replicaFailure.Message)
+		c.integration.SetReadyConditionError(message)
 
 		return true, nil
 	}
 
-	if progressing != nil && progressing.Status == corev1.ConditionFalse && progressing.Reason == "ProgressDeadlineExceeded" {
-		c.integration.Status.Phase = v1.IntegrationPhaseError
-		c.integration.SetReadyConditionError(progressing.Message)
+	return false, nil
+}
 
-		return true, nil
+// deploymentFailure returns the message of the condition reporting the Deployment has failed, if any.
+func deploymentFailure(deployment *appsv1.Deployment) (string, bool) {
+	// Check the Deployment progression
+	progressing := kubernetes.GetDeploymentCondition(*deployment, appsv1.DeploymentProgressing)
+	replicaFailure := kubernetes.GetDeploymentCondition(*deployment, appsv1.DeploymentReplicaFailure)
+
+	if replicaFailure != nil && replicaFailure.Status == corev1.ConditionTrue {
+		return replicaFailure.Message, true
 	}
 
-	return false, nil
+	if progressing != nil && progressing.Status == corev1.ConditionFalse && progressing.Reason == "ProgressDeadlineExceeded" {
+		return progressing.Message, true
+	}
+
+	return "", false
 }
 
 func (c *deploymentController) updateReadyCondition(readyPods int32) bool {
@@ -62,6 +68,10 @@ func (c *deploymentController) updateReadyCondition(readyPods int32) bool {
 	if r := c.integration.Spec.Replicas; r != nil {
 		replicas = *r
 	}
+	// The Deployment is scaled down to zero replicas while the Integration sleeps
+	if isSleeping(c.integration) {
+		replicas = 0
+	}
 	// The Deployment status reports updated and ready replicas separately,
 	// so that the number of ready replicas also accounts for older versions.
 	readyReplicas := readyPods
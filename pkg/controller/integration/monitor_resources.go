@@ -0,0 +1,251 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	metricsv1beta1 "github.com/apache/camel-k/v2/pkg/apis/duck/metrics/v1beta1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+)
+
+const (
+	// resourcesSamplePeriod is the period at which the resource usage of a running Integration is sampled.
+	resourcesSamplePeriod = 15 * time.Minute
+	// resourcesObservationWindow is the period over which the resource usage samples are kept.
+	resourcesObservationWindow = 24 * time.Hour
+	// resourcesMinSamples is the number of samples required before any recommendation is made.
+	resourcesMinSamples = 4
+
+	// The recommended requests cover the usage observed most of the time,
+	// and the recommended limits leave room for the peaks.
+	recommendedRequestCPUPercentile    = 90
+	recommendedRequestMemoryPercentile = 95
+	recommendedLimitCPUPercentile      = 99
+	recommendedRequestMarginPercentage = 15
+	// The memory limit is given a larger margin, as exceeding it gets the container killed.
+	recommendedLimitMemoryMarginPercentage = 30
+	// The CPU limit is at least twice the request, so that the JVM can warm up at startup.
+	recommendedLimitCPURequestRatio = 2
+
+	minRecommendedCPUMillis   = int64(10)
+	minRecommendedMemoryBytes = int64(64 * 1024 * 1024)
+)
+
+// resourcesSample is the resource usage of the Integration container observed at a given time.
+// It is the highest usage observed among the Integration Pods.
+type resourcesSample struct {
+	timestamp time.Time
+	cpu       resource.Quantity
+	memory    resource.Quantity
+}
+
+// resourcesSampleStore keeps in memory the resource usage samples of the Integrations, over the observation window.
+// Only the recommendations computed from the samples are stored in the Integration status, so that the samples
+// are collected again when the operator restarts.
+type resourcesSampleStore struct {
+	lock    sync.Mutex
+	samples map[types.UID][]resourcesSample
+}
+
+var resourcesSamples = &resourcesSampleStore{
+	samples: make(map[types.UID][]resourcesSample),
+}
+
+// lastSampleTime returns the time of the last sample of the Integration, or the zero time if there is none.
+func (s *resourcesSampleStore) lastSampleTime(uid types.UID) time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	samples := s.samples[uid]
+	if len(samples) == 0 {
+		return time.Time{}
+	}
+
+	return samples[len(samples)-1].timestamp
+}
+
+// add records the sample of the Integration, discards the samples of all the Integrations that are out of
+// the observation window, and returns the samples of the Integration.
+func (s *resourcesSampleStore) add(uid types.UID, sample resourcesSample) []resourcesSample {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.samples[uid] = append(s.samples[uid], sample)
+	for key, samples := range s.samples {
+		kept := slices.DeleteFunc(samples, func(candidate resourcesSample) bool {
+			return sample.timestamp.Sub(candidate.timestamp) >= resourcesObservationWindow
+		})
+		if len(kept) == 0 {
+			delete(s.samples, key)
+		} else {
+			s.samples[key] = kept
+		}
+	}
+
+	return slices.Clone(s.samples[uid])
+}
+
+// sampleResources collects the resource usage of the Integration container from the resource metrics API,
+// at most once per sample period, and updates the requests and limits recommended from the samples
+// collected over the observation window.
+func (action *monitorAction) sampleResources(
+	ctx context.Context, environment *trait.Environment, integration *v1.Integration, runningPods []corev1.Pod,
+) {
+	if integration.Status.Phase != v1.IntegrationPhaseRunning || len(runningPods) == 0 {
+		return
+	}
+	now := metav1.Now()
+	if now.Sub(resourcesSamples.lastSampleTime(integration.UID)) < resourcesSamplePeriod {
+		return
+	}
+
+	sample, err := lookupResourcesSample(ctx, action.client, integration, environment.GetIntegrationContainerName(), runningPods)
+	if err != nil {
+		// The resource metrics API is an optional cluster add-on
+		action.L.Debugf("Unable to sample the resource usage of Integration %s: %v", integration.Name, err)
+
+		return
+	}
+	if sample == nil {
+		return
+	}
+	sample.timestamp = now.Time
+
+	samples := resourcesSamples.add(integration.UID, *sample)
+	if recommendation := recommendResources(samples, now); recommendation != nil {
+		if integration.Status.Resources == nil {
+			integration.Status.Resources = &v1.IntegrationResourcesStatus{}
+		}
+		integration.Status.Resources.Recommendation = recommendation
+	}
+}
+
+// appliesResourceRecommendations returns true if the recommended requests and limits are applied to the Integration.
+func appliesResourceRecommendations(integration *v1.Integration) bool {
+	return integration.Status.Traits != nil && integration.Status.Traits.Container != nil &&
+		ptr.Deref(integration.Status.Traits.Container.ApplyRecommendations, false)
+}
+
+// lookupResourcesSample returns the highest usage of the Integration container among the given Pods,
+// or nil if no metrics are available yet.
+func lookupResourcesSample(
+	ctx context.Context, c ctrl.Reader, integration *v1.Integration, containerName string, pods []corev1.Pod,
+) (*resourcesSample, error) {
+	metrics := &metricsv1beta1.PodMetricsList{}
+	if err := c.List(ctx, metrics,
+		ctrl.InNamespace(integration.Namespace),
+		ctrl.MatchingLabels{v1.IntegrationLabel: integration.Name},
+	); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil {
+			names[pod.Name] = true
+		}
+	}
+	var sample *resourcesSample
+	for _, podMetrics := range metrics.Items {
+		if !names[podMetrics.Name] {
+			continue
+		}
+		for _, container := range podMetrics.Containers {
+			if container.Name != containerName {
+				continue
+			}
+			if sample == nil {
+				sample = &resourcesSample{}
+			}
+			if cpu, ok := container.Usage[corev1.ResourceCPU]; ok && cpu.Cmp(sample.cpu) > 0 {
+				sample.cpu = cpu
+			}
+			if memory, ok := container.Usage[corev1.ResourceMemory]; ok && memory.Cmp(sample.memory) > 0 {
+				sample.memory = memory
+			}
+		}
+	}
+
+	return sample, nil
+}
+
+// recommendResources computes the requests and limits recommended from the given usage samples,
+// or returns nil if there are not enough samples.
+func recommendResources(samples []resourcesSample, now metav1.Time) *v1.IntegrationResourcesRecommendation {
+	if len(samples) < resourcesMinSamples {
+		return nil
+	}
+	cpu := make([]int64, 0, len(samples))
+	memory := make([]int64, 0, len(samples))
+	for _, sample := range samples {
+		cpu = append(cpu, sample.cpu.MilliValue())
+		memory = append(memory, sample.memory.Value())
+	}
+	slices.Sort(cpu)
+	slices.Sort(memory)
+
+	requestCPU := max(withMargin(percentile(cpu, recommendedRequestCPUPercentile), recommendedRequestMarginPercentage),
+		minRecommendedCPUMillis)
+	limitCPU := max(withMargin(percentile(cpu, recommendedLimitCPUPercentile), recommendedRequestMarginPercentage),
+		requestCPU*recommendedLimitCPURequestRatio)
+	requestMemory := max(withMargin(percentile(memory, recommendedRequestMemoryPercentile), recommendedRequestMarginPercentage),
+		minRecommendedMemoryBytes)
+	limitMemory := max(withMargin(memory[len(memory)-1], recommendedLimitMemoryMarginPercentage),
+		requestMemory)
+
+	return &v1.IntegrationResourcesRecommendation{
+		Timestamp: now,
+		//nolint:gosec // the number of samples is bounded by the observation window
+		Samples:       int32(len(samples)),
+		RequestCPU:    *resource.NewMilliQuantity(requestCPU, resource.DecimalSI),
+		RequestMemory: mebibytes(requestMemory),
+		LimitCPU:      *resource.NewMilliQuantity(limitCPU, resource.DecimalSI),
+		LimitMemory:   mebibytes(limitMemory),
+	}
+}
+
+// percentile returns the nearest-rank percentile of the given sorted values.
+func percentile(sorted []int64, p int) int64 {
+	rank := (p*len(sorted) + 99) / 100
+
+	return sorted[max(rank, 1)-1]
+}
+
+func withMargin(value int64, percentage int64) int64 {
+	return value + (value*percentage+99)/100
+}
+
+// mebibytes rounds the given amount of bytes up to the next mebibyte.
+func mebibytes(bytes int64) resource.Quantity {
+	mi := int64(1024 * 1024)
+
+	return *resource.NewQuantity((bytes+mi-1)/mi*mi, resource.BinarySI)
+}
@@ -0,0 +1,176 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	metricsv1beta1 "github.com/apache/camel-k/v2/pkg/apis/duck/metrics/v1beta1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorIntegrationSamplesResources(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	now := time.Now()
+	it.UID = "samples-resources"
+	setResourcesSamples(t, it.UID,
+		newResourcesSample(now.Add(-25*time.Hour), "2", "2Gi"),
+		newResourcesSample(now.Add(-time.Hour), "100m", "100Mi"),
+		newResourcesSample(now.Add(-45*time.Minute), "100m", "100Mi"),
+		newResourcesSample(now.Add(-30*time.Minute), "100m", "200Mi"),
+	)
+	require.NoError(t, c.Create(context.TODO(), newPodMetrics("my-pod", "integration", "200m", "200Mi")))
+	// The Pods which are not running are ignored
+	require.NoError(t, c.Create(context.TODO(), newPodMetrics("my-terminated-pod", "integration", "4", "4Gi")))
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+
+	resources := handledIt.Status.Resources
+	require.NotNil(t, resources)
+	// The samples out of the observation window are discarded
+	samples := resourcesSamples.samples[it.UID]
+	require.Len(t, samples, 4)
+	assert.Equal(t, "200m", samples[3].cpu.String())
+	assert.Equal(t, "200Mi", samples[3].memory.String())
+	require.NotNil(t, resources.Recommendation)
+	assert.Equal(t, int32(4), resources.Recommendation.Samples)
+	assert.Equal(t, "230m", resources.Recommendation.RequestCPU.String())
+	assert.Equal(t, "460m", resources.Recommendation.LimitCPU.String())
+	assert.Equal(t, "230Mi", resources.Recommendation.RequestMemory.String())
+	assert.Equal(t, "260Mi", resources.Recommendation.LimitMemory.String())
+	assert.Nil(t, resources.Applied)
+
+	// The recommendation is applied to the next rollout
+	handledIt.Initialize()
+	require.NotNil(t, handledIt.Status.Resources)
+	require.NotNil(t, handledIt.Status.Resources.Applied)
+	assert.Equal(t, "230m", handledIt.Status.Resources.Applied.RequestCPU.String())
+	assert.NotNil(t, handledIt.Status.Resources.Recommendation)
+}
+
+func TestMonitorIntegrationSamplesResourcesPeriodically(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	it.UID = "samples-resources-periodically"
+	setResourcesSamples(t, it.UID, newResourcesSample(time.Now().Add(-time.Minute), "100m", "100Mi"))
+	require.NoError(t, c.Create(context.TODO(), newPodMetrics("my-pod", "integration", "200m", "200Mi")))
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Len(t, resourcesSamples.samples[it.UID], 1)
+	assert.Nil(t, handledIt.Status.Resources)
+}
+
+func TestResourcesSampleStoreDiscardsExpiredSamples(t *testing.T) {
+	now := time.Now()
+	setResourcesSamples(t, "expired", newResourcesSample(now.Add(-25*time.Hour), "100m", "100Mi"))
+	setResourcesSamples(t, "current", newResourcesSample(now.Add(-time.Hour), "100m", "100Mi"))
+
+	samples := resourcesSamples.add("current", newResourcesSample(now, "200m", "200Mi"))
+	assert.Len(t, samples, 2)
+	assert.NotContains(t, resourcesSamples.samples, types.UID("expired"))
+	assert.Equal(t, now, resourcesSamples.lastSampleTime("current"))
+	assert.True(t, resourcesSamples.lastSampleTime("expired").IsZero())
+}
+
+func TestRecommendResources(t *testing.T) {
+	now := metav1.Now()
+	samples := []resourcesSample{
+		newResourcesSample(now.Time, "1m", "10Mi"),
+		newResourcesSample(now.Time, "2m", "10Mi"),
+		newResourcesSample(now.Time, "1m", "12Mi"),
+	}
+	assert.Nil(t, recommendResources(samples, now))
+
+	samples = append(samples, newResourcesSample(now.Time, "1m", "10Mi"))
+	recommendation := recommendResources(samples, now)
+	require.NotNil(t, recommendation)
+	// The recommendation does not go below the minimum amounts
+	assert.Equal(t, "10m", recommendation.RequestCPU.String())
+	assert.Equal(t, "20m", recommendation.LimitCPU.String())
+	assert.Equal(t, "64Mi", recommendation.RequestMemory.String())
+	assert.Equal(t, "64Mi", recommendation.LimitMemory.String())
+}
+
+func newResourcesSample(timestamp time.Time, cpu string, memory string) resourcesSample {
+	return resourcesSample{
+		timestamp: timestamp,
+		cpu:       resource.MustParse(cpu),
+		memory:    resource.MustParse(memory),
+	}
+}
+
+// setResourcesSamples replaces the samples of the Integration in the operator memory, for the duration of the test.
+func setResourcesSamples(t *testing.T, uid types.UID, samples ...resourcesSample) {
+	t.Helper()
+
+	resourcesSamples.lock.Lock()
+	defer resourcesSamples.lock.Unlock()
+	resourcesSamples.samples[uid] = samples
+	t.Cleanup(func() {
+		resourcesSamples.lock.Lock()
+		defer resourcesSamples.lock.Unlock()
+		delete(resourcesSamples.samples, uid)
+	})
+}
+
+func newPodMetrics(pod string, container string, cpu string, memory string) *metricsv1beta1.PodMetrics {
+	return &metricsv1beta1.PodMetrics{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metricsv1beta1.SchemeGroupVersion.String(),
+			Kind:       "PodMetrics",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      pod,
+			Labels: map[string]string{
+				v1.IntegrationLabel: "my-it",
+			},
+		},
+		Containers: []metricsv1beta1.ContainerMetrics{
+			{
+				Name: container,
+				Usage: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			},
+		},
+	}
+}
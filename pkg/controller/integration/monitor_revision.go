@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+// recordRevision records a revision of the Integration when a new version of it is deployed, so that it can be
+// rolled back later on, and deletes the oldest revisions exceeding the history limit.
+func (action *monitorAction) recordRevision(ctx context.Context, integration *v1.Integration) error {
+	if integration.Status.Revision != 0 {
+		return nil
+	}
+	revisions, err := revision.List(ctx, action.client, integration)
+	if err != nil {
+		return err
+	}
+	limit := revisionHistoryLimit(integration)
+	if limit == 0 {
+		// The revision history is disabled
+		return action.pruneRevisions(ctx, revisions, 0)
+	}
+
+	current := revision.DataFor(integration)
+	var latest int64
+	if len(revisions) > 0 {
+		last := &revisions[len(revisions)-1]
+		latest = last.Revision
+		data, err := revision.Decode(last)
+		if err != nil {
+			return err
+		}
+		if data.SameDeployment(&current) {
+			// The latest revision is deployed again, i.e., the Integration has been re-initialized
+			integration.Status.Revision = latest
+
+			return action.pruneRevisions(ctx, revisions, limit+1)
+		}
+	}
+
+	rev, err := revision.New(integration, latest+1)
+	if err != nil {
+		return err
+	}
+	if err := action.client.Create(ctx, rev); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			// The revision has been recorded by a previous reconciliation, and will be matched once listed
+			return nil
+		}
+
+		return err
+	}
+	action.L.Infof("Integration %s revision %d recorded", integration.Name, rev.Revision)
+	integration.Status.Revision = rev.Revision
+
+	return action.pruneRevisions(ctx, append(revisions, *rev), limit+1)
+}
+
+// pruneRevisions deletes the oldest revisions, so that at most the given number of revisions is kept.
+func (action *monitorAction) pruneRevisions(ctx context.Context, revisions []appsv1.ControllerRevision, keep int) error {
+	for i := range len(revisions) - keep {
+		if err := action.client.Delete(ctx, &revisions[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revisionHistoryLimit returns the number of old revisions of the Integration to retain.
+func revisionHistoryLimit(integration *v1.Integration) int {
+	traits := integration.Status.Traits
+	if traits == nil || traits.Deployer == nil || traits.Deployer.RevisionHistoryLimit == nil {
+		return revision.DefaultHistoryLimit
+	}
+
+	return max(int(*traits.Deployer.RevisionHistoryLimit), 0)
+}
@@ -0,0 +1,120 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorRecordRevision(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+	it.UID = "my-it-uid"
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), handledIt.Status.Revision)
+
+	revisions := listRevisions(t, c, handledIt)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, "my-it-1", revisions[0].Name)
+	assert.Equal(t, int64(1), revisions[0].Revision)
+	assert.Equal(t, "my-it", revisions[0].Labels[v1.IntegrationRevisionLabel])
+	data, err := revision.Decode(&revisions[0])
+	require.NoError(t, err)
+	assert.Equal(t, it.Status.Digest, data.Digest)
+	assert.Equal(t, &corev1.ObjectReference{Namespace: "ns", Name: "my-kit"}, data.IntegrationKit)
+
+	// The same version deployed again matches the latest revision
+	handledIt.Status.Revision = 0
+	require.NoError(t, a.recordRevision(context.TODO(), handledIt))
+	assert.Equal(t, int64(1), handledIt.Status.Revision)
+	assert.Len(t, listRevisions(t, c, handledIt), 1)
+
+	// A new version is recorded in a new revision
+	handledIt.Status.Revision = 0
+	handledIt.Status.Digest = "new-digest"
+	require.NoError(t, a.recordRevision(context.TODO(), handledIt))
+	assert.Equal(t, int64(2), handledIt.Status.Revision)
+	revisions = listRevisions(t, c, handledIt)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int64(2), revisions[1].Revision)
+}
+
+func TestMonitorPruneRevisions(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+	it.UID = "my-it-uid"
+	it.Status.Traits = &v1.Traits{
+		Deployer: &traitv1.DeployerTrait{
+			RevisionHistoryLimit: ptr.To(int32(1)),
+		},
+	}
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	for _, d := range []string{"digest-1", "digest-2", "digest-3"} {
+		it.Status.Revision = 0
+		it.Status.Digest = d
+		require.NoError(t, a.recordRevision(context.TODO(), it))
+	}
+	assert.Equal(t, int64(3), it.Status.Revision)
+	revisions := listRevisions(t, c, it)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int64(2), revisions[0].Revision)
+	assert.Equal(t, int64(3), revisions[1].Revision)
+
+	// Disabling the revision history deletes the revisions
+	it.Status.Traits.Deployer.RevisionHistoryLimit = ptr.To(int32(0))
+	it.Status.Revision = 0
+	it.Status.Digest = "digest-4"
+	require.NoError(t, a.recordRevision(context.TODO(), it))
+	assert.Equal(t, int64(0), it.Status.Revision)
+	assert.Empty(t, listRevisions(t, c, it))
+}
+
+func listRevisions(t *testing.T, c client.Client, it *v1.Integration) []appsv1.ControllerRevision {
+	t.Helper()
+	revisions := appsv1.ControllerRevisionList{}
+	require.NoError(t, c.List(context.TODO(), &revisions, ctrl.InNamespace(it.Namespace)))
+	sorted, err := revision.List(context.TODO(), c, it)
+	require.NoError(t, err)
+	assert.Len(t, sorted, len(revisions.Items))
+
+	return sorted
+}
@@ -0,0 +1,93 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+)
+
+// rolloutRequeuePeriod is the period at which the health of the new version of an Integration is checked during a rollout.
+const rolloutRequeuePeriod = 10 * time.Second
+
+// monitorRollout checks the health of the Pods of the new version of the Integration being rolled out,
+// so that the rollout can move forward, or be rolled back.
+func (action *monitorAction) monitorRollout(
+	ctx context.Context, environment *trait.Environment, integration *v1.Integration,
+	pendingPods []corev1.Pod, runningPods []corev1.Pod,
+) error {
+	rollout := integration.Status.Rollout
+	if rollout == nil || rollout.Phase != v1.IntegrationRolloutPhaseProgressing {
+		return nil
+	}
+	canary := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel] == v1.IntegrationRolloutTrackCanary
+	})
+	if canary == nil {
+		return nil
+	}
+
+	failure, failed := podsFailingStatus(pendingPods, runningPods)
+	if !failed {
+		failure, failed = deploymentFailure(canary)
+	}
+	healthy := false
+	if failed {
+		if failure == "" {
+			failure = "new version failing"
+		}
+	} else {
+		// The readiness probes are called on behalf of a copy of the Integration, so that
+		// the new version does not impact the ready condition of the running Integration
+		readyPods, probeOk, err := action.probeReadiness(ctx, environment, integration.DeepCopy(), runningPods)
+		if err != nil {
+			return err
+		}
+		healthy = probeOk && len(pendingPods) == 0 && readyPods >= ptr.Deref(canary.Spec.Replicas, 1)
+	}
+
+	trait.UpdateRollout(environment, healthy, failure, metav1.Now())
+	if rollout.Phase != v1.IntegrationRolloutPhaseProgressing {
+		action.L.Infof("Integration %s rollout of %s: %s", integration.Name, rollout.CanaryImage, rollout.Message)
+	}
+
+	return nil
+}
+
+// splitRolloutPods separates the Pods of the new version of the Integration being rolled out from the others.
+func splitRolloutPods(pods []corev1.Pod) ([]corev1.Pod, []corev1.Pod) {
+	stable := make([]corev1.Pod, 0, len(pods))
+	var canary []corev1.Pod
+	for _, pod := range pods {
+		if pod.Labels[v1.IntegrationRolloutTrackLabel] == v1.IntegrationRolloutTrackCanary {
+			canary = append(canary, pod)
+		} else {
+			stable = append(stable, pod)
+		}
+	}
+
+	return stable, canary
+}
@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// sleepTransitionDelay is the delay after the time of the next sleep transition of an Integration at which
+// it is reconciled, so that the transition has occurred.
+const sleepTransitionDelay = 1 * time.Second
+
+// isSleeping returns true if the Integration is scaled down to zero replicas by its sleep schedules.
+func isSleeping(integration *v1.Integration) bool {
+	return integration.Status.Sleep != nil && integration.Status.Sleep.Sleeping
+}
+
+// sleepRequeuePeriod returns the delay until the next sleep transition of the Integration, when it occurs
+// before the given requeue period, or when no requeue period is given.
+func sleepRequeuePeriod(integration *v1.Integration, period time.Duration) time.Duration {
+	sleep := integration.Status.Sleep
+	if sleep == nil || sleep.NextTransitionTime == nil {
+		return period
+	}
+	transition := max(time.Until(sleep.NextTransitionTime.Time), 0) + sleepTransitionDelay
+	if period == 0 {
+		return transition
+	}
+
+	return min(period, transition)
+}
+
+// wokenUpChanged returns true if the Integration has been woken up on demand.
+func wokenUpChanged(old *v1.Integration, it *v1.Integration) bool {
+	return old.Annotations[v1.IntegrationWokenAtAnnotation] != it.Annotations[v1.IntegrationWokenAtAnnotation]
+}
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorSleepingIntegration(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	// Always sleeping, but on February 29th
+	it.Spec.Traits.Sleep = &traitv1.SleepTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+		Sleep: []string{"0 0 29 2 *"},
+		Wake:  []string{"* * * * *"},
+	}
+	it.Status.Digest, err = digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	require.NotNil(t, handledIt.Status.Sleep)
+	assert.True(t, handledIt.Status.Sleep.Sleeping)
+	sleeping := handledIt.Status.GetCondition(v1.IntegrationConditionSleeping)
+	require.NotNil(t, sleeping)
+	assert.Equal(t, corev1.ConditionTrue, sleeping.Status)
+	// No replica is expected to be ready while the Integration sleeps
+	ready := handledIt.Status.GetCondition(v1.IntegrationConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionTrue, ready.Status)
+	assert.Contains(t, ready.Message, "/0 ready replicas")
+}
+
+func TestSleepRequeuePeriod(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	assert.Equal(t, resourcesSamplePeriod, sleepRequeuePeriod(&it, resourcesSamplePeriod))
+
+	it.Status.Sleep = &v1.IntegrationSleepStatus{
+		NextTransitionTime: &metav1.Time{Time: time.Now().Add(5 * time.Minute)},
+	}
+	assert.Equal(t, externalSecretsRequeuePeriod, sleepRequeuePeriod(&it, externalSecretsRequeuePeriod))
+	assert.InDelta(t, 5*time.Minute+sleepTransitionDelay, sleepRequeuePeriod(&it, resourcesSamplePeriod), float64(time.Second))
+
+	// A missed transition is reconciled right away
+	it.Status.Sleep.NextTransitionTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	assert.Equal(t, sleepTransitionDelay, sleepRequeuePeriod(&it, resourcesSamplePeriod))
+	assert.Equal(t, sleepTransitionDelay, sleepRequeuePeriod(&it, 0))
+}
+
+func TestRequeuePeriod(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	// Integrations using none of the periodic features are only reconciled upon changes
+	assert.Zero(t, requeuePeriod(&it))
+
+	it.Status.Traits = &v1.Traits{
+		Container: &traitv1.ContainerTrait{ApplyRecommendations: ptr.To(true)},
+	}
+	assert.Equal(t, resourcesSamplePeriod, requeuePeriod(&it))
+
+	it.Status.Traits.Mount = &traitv1.MountTrait{Configs: []string{"vault:secret/data/app#password"}}
+	assert.Equal(t, externalSecretsRequeuePeriod, requeuePeriod(&it))
+
+	it.Status.Traits = nil
+	it.Status.Sleep = &v1.IntegrationSleepStatus{
+		NextTransitionTime: &metav1.Time{Time: time.Now().Add(5 * time.Minute)},
+	}
+	assert.InDelta(t, 5*time.Minute+sleepTransitionDelay, requeuePeriod(&it), float64(time.Second))
+}
+
+func TestWokenUpTriggersReconciliation(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	woken := it.DeepCopy()
+	woken.Annotations = map[string]string{
+		v1.IntegrationWokenAtAnnotation: time.Now().UTC().Format(time.RFC3339),
+	}
+	assert.True(t, integrationUpdateFunc(c, it, woken))
+	assert.False(t, integrationUpdateFunc(c, woken, woken.DeepCopy()))
+}
@@ -99,6 +99,70 @@ func TestGetIntegrationSecretAndConfigmapResourceVersions(t *testing.T) {
 	assert.NotEqual(t, "", secrets[0])
 }
 
+func TestGetIntegrationExternalSecretVersions(t *testing.T) {
+	sec := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"password": []byte("first"),
+		},
+	}
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "default",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+			Traits: &v1.Traits{
+				Mount: &trait.MountTrait{
+					Configs: []string{"configmap:cm-test", "kubernetes:credentials#password"},
+				},
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(sec)
+	require.NoError(t, err)
+	assert.True(t, hasExternalSecrets(it))
+
+	// External secrets are watched even when hot reload is disabled
+	secrets, configmaps := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Empty(t, configmaps)
+	require.Len(t, secrets, 1)
+	first, err := digest.ComputeForIntegration(it, configmaps, secrets)
+	require.NoError(t, err)
+
+	// A rotation changes the Integration digest, so that it's rolled
+	sec.Data["password"] = []byte("second")
+	require.NoError(t, c.Update(context.TODO(), sec))
+	secrets, configmaps = getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	second, err := digest.ComputeForIntegration(it, configmaps, secrets)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	// A value that can't be resolved keeps the version of the last value materialized for the Integration
+	require.NoError(t, c.Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it-external-secrets",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"password": []byte("second"),
+		},
+	}))
+	require.NoError(t, c.Delete(context.TODO(), sec))
+	secrets, configmaps = getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	unresolved, err := digest.ComputeForIntegration(it, configmaps, secrets)
+	require.NoError(t, err)
+	assert.Equal(t, second, unresolved)
+}
+
 func TestMonitorIntegration(t *testing.T) {
 	c, it, err := nominalEnvironment()
 	require.NoError(t, err)
@@ -138,6 +202,158 @@ func TestMonitorFailureIntegration(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionInitializationFailedReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 }
 
+func TestMonitorIntegrationRollsBackFailingCanary(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	it.Spec.Traits.Rollout = &trait.RolloutTrait{
+		Trait: trait.Trait{
+			Enabled: ptr.To(true),
+		},
+	}
+	it.Status.Image = "my-image:2"
+	it.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:       v1.IntegrationRolloutPhaseProgressing,
+		Strategy:    trait.RolloutStrategyCanary,
+		StableImage: "my-image:1",
+		CanaryImage: "my-image:2",
+		Weight:      10,
+	}
+	it.Status.Digest, err = digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+	err = c.Create(context.TODO(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-canary-pod",
+			Labels: map[string]string{
+				v1.IntegrationLabel:             "my-it",
+				v1.IntegrationRolloutTrackLabel: v1.IntegrationRolloutTrackCanary,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodReady,
+					Status: corev1.ConditionFalse,
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "integration",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "CrashLoopBackOff",
+							Message: "back-off restarting failed container",
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	// The failing new version does not affect the running Integration
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	assert.Equal(t, int32(1), *handledIt.Status.Replicas)
+	assert.Equal(t, corev1.ConditionTrue, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	// The new version is rolled back
+	require.NotNil(t, handledIt.Status.Rollout)
+	assert.Equal(t, v1.IntegrationRolloutPhaseRolledBack, handledIt.Status.Rollout.Phase)
+	assert.Equal(t, "new version rolled back: back-off restarting failed container", handledIt.Status.Rollout.Message)
+}
+
+func TestMonitorIntegrationHealthyWhileCanaryFails(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	it.Spec.Traits.Rollout = &trait.RolloutTrait{
+		Trait: trait.Trait{
+			Enabled: ptr.To(true),
+		},
+	}
+	it.Status.Image = "my-image:2"
+	it.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:       v1.IntegrationRolloutPhaseProgressing,
+		Strategy:    trait.RolloutStrategyCanary,
+		StableImage: "my-image:1",
+		CanaryImage: "my-image:2",
+		Weight:      10,
+	}
+	it.Status.Digest, err = digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+	stable := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it",
+			Labels: map[string]string{
+				v1.IntegrationLabel: "my-it",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel: "my-it",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "integration",
+							Image: "my-image:1",
+						},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:        1,
+			ReadyReplicas:   1,
+			UpdatedReplicas: 1,
+		},
+	}
+	require.NoError(t, c.Create(context.TODO(), stable))
+	canary := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it-canary",
+			Labels: map[string]string{
+				v1.IntegrationLabel:             "my-it",
+				v1.IntegrationRolloutTrackLabel: v1.IntegrationRolloutTrackCanary,
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:    appsv1.DeploymentReplicaFailure,
+					Status:  corev1.ConditionTrue,
+					Message: "quota exceeded",
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Create(context.TODO(), canary))
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	// The failing canary Deployment does not drive the status of the running Integration
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	assert.Equal(t, corev1.ConditionTrue, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	// The new version is rolled back
+	require.NotNil(t, handledIt.Status.Rollout)
+	assert.Equal(t, v1.IntegrationRolloutPhaseRolledBack, handledIt.Status.Rollout.Phase)
+	assert.Equal(t, "new version rolled back: quota exceeded", handledIt.Status.Rollout.Message)
+}
+
 func nominalEnvironment() (client.Client, *v1.Integration, error) {
 	catalog := &v1.CamelCatalog{
 		TypeMeta: metav1.TypeMeta{
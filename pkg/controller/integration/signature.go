@@ -0,0 +1,158 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/registry"
+	"github.com/apache/camel-k/v2/pkg/util/signature"
+)
+
+// verifyImageSignature verifies the signature of the Integration image, when required by the platform.
+// The verified image is recorded by digest, so that the Integration is deployed from it, even when its image
+// is addressed by a tag that may be moved afterwards. It returns false when the Integration image must not be deployed.
+func (action *monitorAction) verifyImageSignature(ctx context.Context, integration *v1.Integration) (bool, error) {
+	pl := platform.SingletonPlatform
+	itp, err := platform.GetForResource(ctx, action.client, integration)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+	if itp != nil {
+		pl = platform.FromIntegrationPlatform(itp)
+	}
+	if !pl.Signing.IsVerificationEnabled() || integration.Status.Image == "" {
+		integration.Status.VerifiedImage = ""
+
+		return true, nil
+	}
+
+	options, err := action.remoteOptions(ctx, integration.Namespace, pl)
+	if err != nil {
+		return false, err
+	}
+	digest, err := resolveImage(integration.Status.Image, pl, options)
+	if err == nil {
+		condition := integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+		if condition != nil && condition.Status == corev1.ConditionTrue && integration.Status.VerifiedImage == digest.String() {
+			// the signature of this image digest has already been verified
+			return true, nil
+		}
+		err = action.verifyImage(ctx, integration.Namespace, digest, pl, options)
+	}
+	if err != nil {
+		message := fmt.Sprintf("cannot verify signature of image %s: %s", integration.Status.Image, err.Error())
+		integration.Status.VerifiedImage = ""
+		integration.Status.Phase = v1.IntegrationPhaseError
+		integration.Status.SetCondition(v1.IntegrationConditionImageSignatureVerified, corev1.ConditionFalse,
+			v1.IntegrationConditionImageSignatureVerificationFailedReason, message)
+		integration.SetReadyCondition(corev1.ConditionFalse,
+			v1.IntegrationConditionImageSignatureVerificationFailedReason, message)
+
+		return false, nil
+	}
+
+	condition := integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	if condition != nil && condition.Status == corev1.ConditionFalse && integration.Status.Phase == v1.IntegrationPhaseError {
+		// the signature is now valid (e.g. the image has been signed afterwards), resume the deployment
+		integration.SetDeployingPhase()
+	}
+	integration.Status.VerifiedImage = digest.String()
+	integration.Status.SetCondition(v1.IntegrationConditionImageSignatureVerified, corev1.ConditionTrue,
+		v1.IntegrationConditionImageSignatureVerifiedReason, fmt.Sprintf("signature of image %s verified", digest.String()))
+
+	return true, nil
+}
+
+// remoteOptions returns the options to access the platform registry, using the registry secret credentials when set.
+func (action *monitorAction) remoteOptions(ctx context.Context, namespace string, pl platform.Platform) ([]remote.Option, error) {
+	var keychain authn.Keychain = authn.DefaultKeychain
+	if pl.Registry.Secret != "" {
+		if secret, err := action.getSecret(ctx, namespace, pl.Registry.Secret); err == nil {
+			secretKeychain, err := registry.SecretKeychain(secret)
+			if err != nil {
+				return nil, err
+			}
+			keychain = authn.NewMultiKeychain(secretKeychain, authn.DefaultKeychain)
+		} else if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return []remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(keychain)}, nil
+}
+
+// resolveImage returns the reference by digest of the image, resolving the images addressed by tag.
+func resolveImage(image string, pl platform.Platform, options []remote.Option) (name.Digest, error) {
+	var nameOptions []name.Option
+	if pl.Registry.Insecure {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	ref, err := name.ParseReference(image, nameOptions...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	if digest, ok := ref.(name.Digest); ok {
+		return digest, nil
+	}
+	descriptor, err := remote.Head(ref, options...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	return ref.Context().Digest(descriptor.Digest.String()), nil
+}
+
+func (action *monitorAction) verifyImage(ctx context.Context, namespace string, digest name.Digest, pl platform.Platform, options []remote.Option) error {
+	secret, err := action.getSecret(ctx, namespace, pl.Signing.Secret)
+	if err != nil {
+		return fmt.Errorf("cannot get signing secret %s: %w", pl.Signing.Secret, err)
+	}
+	key, ok := secret.Data[signature.PublicKeySecretKey]
+	if !ok {
+		return fmt.Errorf("no %s key found in signing secret %s/%s", signature.PublicKeySecretKey, secret.Namespace, secret.Name)
+	}
+	publicKey, err := signature.LoadPublicKey(key)
+	if err != nil {
+		return err
+	}
+
+	return signature.Verify(digest, publicKey, options...)
+}
+
+// getSecret looks up the secret in the Integration namespace, falling back to the operator namespace.
+func (action *monitorAction) getSecret(ctx context.Context, namespace string, name string) (*corev1.Secret, error) {
+	secret, err := action.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" && operatorNamespace != namespace {
+			return action.client.CoreV1().Secrets(operatorNamespace).Get(ctx, name, metav1.GetOptions{})
+		}
+	}
+
+	return secret, err
+}
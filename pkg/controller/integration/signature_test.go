@@ -0,0 +1,173 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	stdlog "log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/signature"
+)
+
+func newSignatureTestEnvironment(t *testing.T) (*monitorAction, *v1.Integration, name.Digest, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	server := httptest.NewServer(registry.New(registry.Logger(stdlog.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	address := strings.TrimPrefix(server.URL, "http://")
+	ref, err := name.ParseReference(address+"/default/my-it:1", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pub, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	itp := v1.NewIntegrationPlatform("default", "camel-k")
+	itp.Status.Phase = v1.IntegrationPlatformPhaseReady
+	itp.Status.Build.Registry = v1.RegistrySpec{Address: address, Insecure: true}
+	itp.Status.Build.Signing = &v1.ImageSigningSpec{Secret: "signing"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "signing"},
+		Data: map[string][]byte{
+			signature.PublicKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}),
+		},
+	}
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseDeploying
+	it.Status.Image = ref.String()
+
+	c, err := internal.NewFakeClient(&itp, secret)
+	require.NoError(t, err)
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	return &a, &it, ref.Context().Digest(digest.String()), key
+}
+
+func TestVerifyImageSignatureUnsigned(t *testing.T) {
+	a, it, _, _ := newSignatureTestEnvironment(t)
+
+	verified, err := a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.False(t, verified)
+	assert.Equal(t, v1.IntegrationPhaseError, it.Status.Phase)
+	condition := it.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionImageSignatureVerificationFailedReason, condition.Reason)
+	assert.Contains(t, condition.Message, "no signature found")
+	assert.Equal(t, corev1.ConditionFalse, it.Status.GetCondition(v1.IntegrationConditionReady).Status)
+}
+
+func TestVerifyImageSignature(t *testing.T) {
+	a, it, ref, key := newSignatureTestEnvironment(t)
+
+	// the deployment is blocked until the image gets signed
+	verified, err := a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.False(t, verified)
+
+	require.NoError(t, signature.Sign(ref, key))
+	verified, err = a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.True(t, verified)
+	assert.Equal(t, v1.IntegrationPhaseDeploying, it.Status.Phase)
+	condition := it.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "signature of image "+ref.String()+" verified", condition.Message)
+	assert.Equal(t, ref.String(), it.Status.VerifiedImage)
+}
+
+func TestVerifyImageSignatureMovedTag(t *testing.T) {
+	a, it, ref, key := newSignatureTestEnvironment(t)
+	require.NoError(t, signature.Sign(ref, key))
+	verified, err := a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.True(t, verified)
+	assert.Equal(t, ref.String(), it.Status.VerifiedImage)
+
+	// the tag now refers to an unsigned image
+	tag, err := name.ParseReference(it.Status.Image, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+
+	verified, err = a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.False(t, verified)
+	assert.Empty(t, it.Status.VerifiedImage)
+	assert.Equal(t, v1.IntegrationPhaseError, it.Status.Phase)
+	assert.Contains(t, it.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified).Message, "no signature found")
+}
+
+func TestVerifyImageSignatureWrongKey(t *testing.T) {
+	a, it, ref, _ := newSignatureTestEnvironment(t)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, signature.Sign(ref, other))
+
+	verified, err := a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.False(t, verified)
+	assert.Contains(t, it.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified).Message, "no valid signature found")
+}
+
+func TestVerifyImageSignatureDisabled(t *testing.T) {
+	a, it, _, _ := newSignatureTestEnvironment(t)
+	itp := v1.NewIntegrationPlatform("default", "camel-k")
+	itp.Status.Phase = v1.IntegrationPlatformPhaseReady
+	itp.Status.Build.Signing = &v1.ImageSigningSpec{Secret: "signing", Verify: new(false)}
+	c, err := internal.NewFakeClient(&itp)
+	require.NoError(t, err)
+	a.InjectClient(c)
+
+	verified, err := a.verifyImageSignature(context.TODO(), it)
+	require.NoError(t, err)
+	assert.True(t, verified)
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified))
+}
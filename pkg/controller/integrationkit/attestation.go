@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationkit
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+var attestationKeys = map[v1.AttestationType]string{
+	v1.AttestationTypeSBOM:       "sbom.cdx.json",
+	v1.AttestationTypeProvenance: "provenance.intoto.json",
+}
+
+// recordAttestations stores the SBOM and provenance of the kit image into a ConfigMap owned by the kit,
+// so that they can be retrieved without pulling them from the registry.
+func (action *buildAction) recordAttestations(ctx context.Context, kit *v1.IntegrationKit, build *v1.Build) ([]v1.Attestation, error) {
+	docs, err := builder.GenerateAttestations(build, build.Status.Image, build.Status.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kit.Name + "-attestations",
+			Namespace: kit.Namespace,
+			Labels:    kubernetes.FilterCamelCreatorLabels(kit.Labels),
+		},
+		Data: make(map[string]string, len(docs)),
+	}
+	cm.Labels[v1.IntegrationKitLabel] = kit.Name
+	if err := controllerutil.SetControllerReference(kit, cm, action.client.GetScheme()); err != nil {
+		return nil, err
+	}
+
+	attestations := make([]v1.Attestation, 0, len(docs))
+	for _, doc := range docs {
+		key := attestationKeys[doc.Type]
+		cm.Data[key] = string(doc.Content)
+
+		attestation := doc.Attestation
+		attestation.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: cm.Name},
+			Key:                  key,
+		}
+		// the image of the attestation is only known by the builder which has pushed it
+		for _, a := range build.Status.Attestations {
+			if a.Type == doc.Type {
+				attestation.Image = a.Image
+			}
+		}
+		attestations = append(attestations, attestation)
+	}
+
+	applier := action.client.ServerOrClientSideApplier()
+	if err := applier.Apply(ctx, cm); err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
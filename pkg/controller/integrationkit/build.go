@@ -248,6 +248,17 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 			})
 		}
 
+		kit.Status.Attestations = nil
+		if build.Status.Digest != "" {
+			attestations, err := action.recordAttestations(ctx, kit, build)
+			if err != nil {
+				// attestations are informative, the kit can be used anyway
+				action.L.Errorf(err, "Cannot record attestations of kit %s", kit.Name)
+			} else {
+				kit.Status.Attestations = attestations
+			}
+		}
+
 		return kit, err
 	case v1.BuildPhaseError, v1.BuildPhaseInterrupted:
 		// we should ensure that the integration kit is still in the right phase,
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func TestInitializeValidProfile(t *testing.T) {
+	profile := v1.IntegrationProfile{}
+	profile.Namespace = "ns"
+	profile.Name = "custom"
+	profile.Spec.Build.RuntimeProvider = v1.RuntimeProviderQuarkus
+	profile.Spec.Traits.Container = &trait.ContainerTrait{
+		Name: "my-container",
+	}
+	c, err := internal.NewFakeClient(&profile)
+	require.NoError(t, err)
+
+	action := NewInitializeAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+
+	assert.True(t, action.CanHandle(&profile))
+
+	answer, err := action.Handle(context.TODO(), &profile)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseReady, answer.Status.Phase)
+	assert.Equal(t, profile.Spec.Traits, answer.Status.Traits)
+	assert.Equal(t, corev1.ConditionTrue, answer.Status.GetCondition(v1.IntegrationProfileConditionTypeCreated).Status)
+	assert.Equal(t, corev1.ConditionTrue, answer.Status.GetCondition(v1.IntegrationProfileConditionTypeTraitsValid).Status)
+	assert.Equal(t, corev1.ConditionTrue, answer.Status.GetCondition(v1.IntegrationProfileConditionTypeBuildValid).Status)
+	assert.Nil(t, answer.Status.GetCondition(v1.IntegrationProfileConditionTypeRegistryAvailable))
+	inUse := answer.Status.GetCondition(v1.IntegrationProfileConditionTypeInUse)
+	assert.Equal(t, corev1.ConditionFalse, inUse.Status)
+	assert.Equal(t, "used by 0 Integrations and 0 Pipes", inUse.Message)
+}
+
+func TestInitializeInvalidTraits(t *testing.T) {
+	profile := v1.IntegrationProfile{}
+	profile.Namespace = "ns"
+	profile.Name = "custom"
+	profile.Spec.Traits.Container = &trait.ContainerTrait{
+		Auto: ptr.To(true),
+	}
+	profile.Spec.Traits.Addons = map[string]v1.AddonTrait{
+		"unknown": {RawMessage: v1.RawMessage(`{"enabled":true}`)},
+	}
+	c, err := internal.NewFakeClient(&profile)
+	require.NoError(t, err)
+
+	action := NewInitializeAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+
+	answer, err := action.Handle(context.TODO(), &profile)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseError, answer.Status.Phase)
+	cond := answer.Status.GetCondition(v1.IntegrationProfileConditionTypeTraitsValid)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationProfileConditionTraitsInvalidReason, cond.Reason)
+	assert.Contains(t, cond.Message, "trait unknown does not exist in catalog")
+	assert.Equal(t, corev1.ConditionTrue, answer.Status.GetCondition(v1.IntegrationProfileConditionTypeBuildValid).Status)
+}
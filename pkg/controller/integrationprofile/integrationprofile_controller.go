@@ -0,0 +1,253 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationprofile
+
+import (
+	"context"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	camelevent "github.com/apache/camel-k/v2/pkg/event"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/monitoring"
+)
+
+// errorRequeueAfter is how long to wait before validating again an IntegrationProfile in error,
+// as some of the checks (ie, registry reachability) depend on external conditions.
+const errorRequeueAfter = time.Minute
+
+// Add creates a new IntegrationProfile Controller and adds it to the Manager. The Manager will set fields
+// on the Controller and Start it when the Manager is Started.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	return add(mgr, newReconciler(mgr, c))
+}
+
+func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
+	return monitoring.NewInstrumentedReconciler(
+		&reconcileIntegrationProfile{
+			client:   c,
+			scheme:   mgr.GetScheme(),
+			recorder: mgr.GetEventRecorder("camel-k-integration-profile-controller"),
+			actions: []Action{
+				NewInitializeAction(),
+				NewMonitorAction(),
+			},
+		},
+		schema.GroupVersionKind{
+			Group:   v1.SchemeGroupVersion.Group,
+			Version: v1.SchemeGroupVersion.Version,
+			Kind:    v1.IntegrationProfileKind,
+		},
+	)
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	b := builder.ControllerManagedBy(mgr).
+		Named("integrationprofile-controller").
+		// Watch for changes to primary resource IntegrationProfile
+		For(&v1.IntegrationProfile{}, builder.WithPredicates(
+			platform.FilteringFuncs[ctrl.Object]{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					oldProfile, ok := e.ObjectOld.(*v1.IntegrationProfile)
+					if !ok {
+						return false
+					}
+					newProfile, ok := e.ObjectNew.(*v1.IntegrationProfile)
+					if !ok {
+						return false
+					}
+
+					// Ignore updates to the integration profile status in which case metadata.Generation
+					// does not change, or except when the integration profile phase changes as it's used
+					// to transition from one phase to another
+					return oldProfile.Generation != newProfile.Generation ||
+						oldProfile.Status.Phase != newProfile.Status.Phase
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					// Evaluates to false if the object has been confirmed deleted
+					return !e.DeleteStateUnknown
+				},
+			})).
+		// Watch for Integrations and Pipes starting or stopping to use a profile, in order to
+		// keep the profile usage up to date
+		Watches(&v1.Integration{}, referencingResourceHandler()).
+		Watches(&v1.Pipe{}, referencingResourceHandler())
+
+	return b.Complete(r)
+}
+
+// referencingResourceHandler enqueues the profiles referenced by a resource when the resource is created or
+// deleted, or when it gets attached to a different profile.
+func referencingResourceHandler() handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueReferencedProfile(q, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			//nolint:staticcheck
+			if v1.GetIntegrationProfileAnnotation(e.ObjectOld) == v1.GetIntegrationProfileAnnotation(e.ObjectNew) &&
+				v1.GetIntegrationProfileNamespaceAnnotation(e.ObjectOld) == v1.GetIntegrationProfileNamespaceAnnotation(e.ObjectNew) {
+				return
+			}
+			enqueueReferencedProfile(q, e.ObjectOld)
+			enqueueReferencedProfile(q, e.ObjectNew)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueReferencedProfile(q, e.Object)
+		},
+	}
+}
+
+// enqueueReferencedProfile enqueues the profile referenced by the resource, in any of the namespaces
+// it can be resolved from.
+func enqueueReferencedProfile(q workqueue.TypedRateLimitingInterface[reconcile.Request], o ctrl.Object) {
+	profileName := v1.GetIntegrationProfileAnnotation(o)
+	if profileName == "" {
+		return
+	}
+	//nolint:staticcheck
+	namespace := v1.GetIntegrationProfileNamespaceAnnotation(o)
+	if namespace == "" {
+		namespace = o.GetNamespace()
+	}
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: profileName}})
+	if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" && operatorNamespace != namespace {
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: operatorNamespace, Name: profileName}})
+	}
+}
+
+var _ reconcile.Reconciler = &reconcileIntegrationProfile{}
+
+// reconcileIntegrationProfile reconciles a IntegrationProfile object.
+type reconcileIntegrationProfile struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the API server
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder events.EventRecorder
+	actions  []Action
+}
+
+// Reconcile reads that state of the cluster for a IntegrationProfile object and makes changes based
+// on the state read and what is in the IntegrationProfile.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *reconcileIntegrationProfile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	rlog.Debug("Reconciling IntegrationProfile")
+
+	// Make sure the operator is allowed to act on namespace
+	if ok, err := platform.IsOperatorAllowedOnNamespace(ctx, r.client, request.Namespace); err != nil {
+		return reconcile.Result{}, err
+	} else if !ok {
+		rlog.Info("Ignoring request because namespace is locked")
+
+		return reconcile.Result{}, nil
+	}
+
+	// Fetch the IntegrationProfile instance
+	var instance v1.IntegrationProfile
+
+	if err := r.client.Get(ctx, request.NamespacedName, &instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Only process resources assigned to the operator
+	if !platform.IsOperatorHandlerConsideringLock(ctx, r.client, request.Namespace, &instance) {
+		rlog.Info("Ignoring request because resource is not assigned to current operator")
+
+		return reconcile.Result{}, nil
+	}
+
+	target := instance.DeepCopy()
+	targetLog := rlog.ForIntegrationProfile(target)
+
+	for _, a := range r.actions {
+		a.InjectClient(r.client)
+		a.InjectLogger(targetLog)
+
+		if !a.CanHandle(target) {
+			continue
+		}
+
+		targetLog.Debugf("Invoking action %s", a.Name())
+
+		phaseFrom := target.Status.Phase
+
+		newTarget, err := a.Handle(ctx, target)
+		if err != nil {
+			camelevent.NotifyError(r.recorder, &instance, target, instance.Name, instance.Kind, err)
+
+			return reconcile.Result{}, err
+		}
+
+		if newTarget != nil {
+			newTarget.Status.ObservedGeneration = instance.Generation
+
+			if err := r.client.Status().Patch(ctx, newTarget, ctrl.MergeFrom(&instance)); err != nil {
+				camelevent.NotifyError(r.recorder, &instance, newTarget, newTarget.Name, newTarget.Kind, err)
+
+				return reconcile.Result{}, err
+			}
+
+			if newTarget.Status.Phase != phaseFrom {
+				targetLog.Info(
+					"State transition",
+					"phase-from", phaseFrom,
+					"phase-to", newTarget.Status.Phase,
+				)
+			}
+
+			target = newTarget
+		}
+
+		// handle one action at time so the resource
+		// is always at its latest state
+		camelevent.NotifyIntegrationProfileUpdated(ctx, r.client, r.recorder, &instance, target)
+
+		break
+	}
+
+	if target.Status.Phase == v1.IntegrationProfilePhaseError {
+		return reconcile.Result{RequeueAfter: errorRequeueAfter}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,138 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationprofile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func TestMonitorCanHandlePhaseReadyOrError(t *testing.T) {
+	profile := v1.IntegrationProfile{}
+	action := NewMonitorAction()
+
+	assert.False(t, action.CanHandle(&profile))
+	profile.Status.Phase = v1.IntegrationProfilePhaseReady
+	assert.True(t, action.CanHandle(&profile))
+	profile.Status.Phase = v1.IntegrationProfilePhaseError
+	assert.True(t, action.CanHandle(&profile))
+}
+
+func TestMonitorInvalidBuild(t *testing.T) {
+	profile := v1.IntegrationProfile{}
+	profile.Namespace = "ns"
+	profile.Name = "custom"
+	profile.Spec.Build.RuntimeProvider = "main"
+	profile.Spec.Build.BaseImage = "Not A Valid Image"
+	profile.Spec.Build.Timeout = &metav1.Duration{Duration: -time.Minute}
+	profile.Status.Phase = v1.IntegrationProfilePhaseReady
+	c, err := internal.NewFakeClient(&profile)
+	require.NoError(t, err)
+
+	action := NewMonitorAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+
+	answer, err := action.Handle(context.TODO(), &profile)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseError, answer.Status.Phase)
+	cond := answer.Status.GetCondition(v1.IntegrationProfileConditionTypeBuildValid)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationProfileConditionBuildInvalidReason, cond.Reason)
+	assert.Contains(t, cond.Message, `unsupported runtime provider "main"`)
+	assert.Contains(t, cond.Message, `invalid base image "Not A Valid Image"`)
+	assert.Contains(t, cond.Message, "invalid build timeout -1m0s")
+}
+
+func TestMonitorRegistry(t *testing.T) {
+	defer func(ping func(context.Context, client.Client, string, v1.RegistrySpec) error) { pingRegistry = ping }(pingRegistry)
+
+	profile := v1.IntegrationProfile{}
+	profile.Namespace = "ns"
+	profile.Name = "custom"
+	profile.Spec.Build.Registry.Address = "registry.example.com"
+	profile.Status.Phase = v1.IntegrationProfilePhaseReady
+	c, err := internal.NewFakeClient(&profile)
+	require.NoError(t, err)
+
+	action := NewMonitorAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+
+	pingRegistry = func(ctx context.Context, c client.Client, namespace string, spec v1.RegistrySpec) error {
+		return errors.New("registry registry.example.com is not reachable: connection refused")
+	}
+	answer, err := action.Handle(context.TODO(), profile.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseError, answer.Status.Phase)
+	cond := answer.Status.GetCondition(v1.IntegrationProfileConditionTypeRegistryAvailable)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationProfileConditionRegistryUnavailableReason, cond.Reason)
+
+	pingRegistry = func(ctx context.Context, c client.Client, namespace string, spec v1.RegistrySpec) error {
+		return nil
+	}
+	answer, err = action.Handle(context.TODO(), answer)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseReady, answer.Status.Phase)
+	cond = answer.Status.GetCondition(v1.IntegrationProfileConditionTypeRegistryAvailable)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "registry registry.example.com is reachable", cond.Message)
+}
+
+func TestMonitorInUse(t *testing.T) {
+	profile := v1.IntegrationProfile{}
+	profile.Namespace = "ns"
+	profile.Name = "custom"
+	profile.Status.Phase = v1.IntegrationProfilePhaseReady
+
+	annotations := map[string]string{v1.IntegrationProfileAnnotation: "custom"}
+	it1 := v1.NewIntegration("ns", "it1")
+	it1.Annotations = annotations
+	it2 := v1.NewIntegration("ns", "it2")
+	it2.Annotations = annotations
+	it3 := v1.NewIntegration("ns", "it3")
+	it3.Annotations = map[string]string{v1.IntegrationProfileAnnotation: "other"}
+	pipe := v1.NewPipe("ns", "my-pipe")
+	pipe.Annotations = annotations
+	c, err := internal.NewFakeClient(&profile, &it1, &it2, &it3, &pipe)
+	require.NoError(t, err)
+
+	action := NewMonitorAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+
+	answer, err := action.Handle(context.TODO(), &profile)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationProfilePhaseReady, answer.Status.Phase)
+	cond := answer.Status.GetCondition(v1.IntegrationProfileConditionTypeInUse)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "used by 2 Integrations (it1, it2) and 1 Pipe (my-pipe)", cond.Message)
+}
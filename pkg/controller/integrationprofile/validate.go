@@ -0,0 +1,185 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/registry"
+)
+
+// maxReferencesInMessage is the maximum number of resource names reported by the InUse condition.
+const maxReferencesInMessage = 5
+
+// pingRegistry verifies that the registry is reachable, it can be replaced in tests.
+var pingRegistry = registry.Ping
+
+// validate checks the traits, build settings and registry of the profile, and records the outcome, along with
+// the resources using it, in the profile status.
+func validate(ctx context.Context, c client.Client, profile *v1.IntegrationProfile) error {
+	profile.Status.IntegrationProfileSpec = *profile.Spec.DeepCopy()
+	profile.Status.Phase = v1.IntegrationProfilePhaseReady
+
+	if err := trait.ValidateTraitsSpec(trait.NewCatalog(nil), profile.Spec.Traits); err != nil {
+		profile.Status.Phase = v1.IntegrationProfilePhaseError
+		profile.Status.SetErrorCondition(
+			v1.IntegrationProfileConditionTypeTraitsValid,
+			v1.IntegrationProfileConditionTraitsInvalidReason,
+			err)
+	} else {
+		profile.Status.SetCondition(
+			v1.IntegrationProfileConditionTypeTraitsValid,
+			corev1.ConditionTrue,
+			v1.IntegrationProfileConditionTraitsValidReason,
+			"traits are valid")
+	}
+
+	if err := validateBuild(profile); err != nil {
+		profile.Status.Phase = v1.IntegrationProfilePhaseError
+		profile.Status.SetErrorCondition(
+			v1.IntegrationProfileConditionTypeBuildValid,
+			v1.IntegrationProfileConditionBuildInvalidReason,
+			err)
+	} else {
+		profile.Status.SetCondition(
+			v1.IntegrationProfileConditionTypeBuildValid,
+			corev1.ConditionTrue,
+			v1.IntegrationProfileConditionBuildValidReason,
+			"build settings are valid")
+	}
+
+	if address := profile.Spec.Build.Registry.Address; address == "" {
+		profile.Status.RemoveCondition(v1.IntegrationProfileConditionTypeRegistryAvailable)
+	} else if err := pingRegistry(ctx, c, profile.Namespace, profile.Spec.Build.Registry); err != nil {
+		profile.Status.Phase = v1.IntegrationProfilePhaseError
+		profile.Status.SetErrorCondition(
+			v1.IntegrationProfileConditionTypeRegistryAvailable,
+			v1.IntegrationProfileConditionRegistryUnavailableReason,
+			err)
+	} else {
+		profile.Status.SetCondition(
+			v1.IntegrationProfileConditionTypeRegistryAvailable,
+			corev1.ConditionTrue,
+			v1.IntegrationProfileConditionRegistryAvailableReason,
+			fmt.Sprintf("registry %s is reachable", address))
+	}
+
+	return setInUseCondition(ctx, c, profile)
+}
+
+// validateBuild checks the build settings of the profile.
+func validateBuild(profile *v1.IntegrationProfile) error {
+	var errs []error
+
+	build := profile.Spec.Build
+	if build.RuntimeProvider != "" && !build.RuntimeProvider.IsQuarkusBased() {
+		errs = append(errs, fmt.Errorf("unsupported runtime provider %q", build.RuntimeProvider))
+	}
+	if build.BaseImage != "" {
+		if _, err := name.ParseReference(build.BaseImage); err != nil {
+			errs = append(errs, fmt.Errorf("invalid base image %q: %w", build.BaseImage, err))
+		}
+	}
+	if build.Timeout != nil && build.Timeout.Duration < 0 {
+		errs = append(errs, fmt.Errorf("invalid build timeout %s: must not be negative", build.Timeout.Duration))
+	}
+
+	// Dependencies can only be checked against the catalog embedded in the operator
+	if len(profile.Spec.Dependencies) > 0 &&
+		(build.RuntimeVersion == "" || build.RuntimeVersion == defaults.DefaultRuntimeVersion) {
+		catalog, err := camel.DefaultCatalog()
+		if err != nil {
+			return err
+		}
+		if err := camel.ValidateDependenciesE(catalog, profile.Spec.Dependencies); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// setInUseCondition records which Integrations and Pipes reference the profile.
+func setInUseCondition(ctx context.Context, c client.Client, profile *v1.IntegrationProfile) error {
+	var options []ctrl.ListOption
+	if !platform.IsCurrentOperatorGlobal() {
+		options = append(options, ctrl.InNamespace(profile.Namespace))
+	}
+
+	integrations := v1.NewIntegrationList()
+	if err := c.List(ctx, &integrations, options...); err != nil {
+		return err
+	}
+	var integrationNames []string
+	for i := range integrations.Items {
+		if platform.IsIntegrationProfileReferenced(ctx, c, &integrations.Items[i], profile) {
+			integrationNames = append(integrationNames, integrations.Items[i].Name)
+		}
+	}
+
+	pipes := v1.NewPipeList()
+	if err := c.List(ctx, &pipes, options...); err != nil {
+		return err
+	}
+	var pipeNames []string
+	for i := range pipes.Items {
+		if platform.IsIntegrationProfileReferenced(ctx, c, &pipes.Items[i], profile) {
+			pipeNames = append(pipeNames, pipes.Items[i].Name)
+		}
+	}
+
+	status := corev1.ConditionTrue
+	if len(integrationNames) == 0 && len(pipeNames) == 0 {
+		status = corev1.ConditionFalse
+	}
+	profile.Status.SetCondition(
+		v1.IntegrationProfileConditionTypeInUse,
+		status,
+		v1.IntegrationProfileConditionInUseReason,
+		fmt.Sprintf("used by %s and %s", describeReferences(integrationNames, "Integration"), describeReferences(pipeNames, "Pipe")))
+
+	return nil
+}
+
+func describeReferences(names []string, kind string) string {
+	if len(names) != 1 {
+		kind += "s"
+	}
+	if len(names) == 0 {
+		return "0 " + kind
+	}
+	listed := names
+	if len(listed) > maxReferencesInMessage {
+		listed = append(listed[:maxReferencesInMessage:maxReferencesInMessage], "...")
+	}
+
+	return fmt.Sprintf("%d %s (%s)", len(names), kind, strings.Join(listed, ", "))
+}
@@ -0,0 +1,182 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	camelevent "github.com/apache/camel-k/v2/pkg/event"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/monitoring"
+)
+
+// Add creates a new Kamelet Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	return add(mgr, newReconciler(mgr, c))
+}
+
+func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
+	return monitoring.NewInstrumentedReconciler(
+		&reconcileKamelet{
+			client:   c,
+			scheme:   mgr.GetScheme(),
+			recorder: mgr.GetEventRecorder("camel-k-kamelet-controller"),
+			actions: []Action{
+				NewInitializeAction(),
+				NewMonitorAction(),
+			},
+		},
+		schema.GroupVersionKind{
+			Group:   v1.SchemeGroupVersion.Group,
+			Version: v1.SchemeGroupVersion.Version,
+			Kind:    v1.KameletKind,
+		},
+	)
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("kamelet-controller").
+		// Watch for changes to primary resource Kamelet
+		For(&v1.Kamelet{}, builder.WithPredicates(
+			platform.FilteringFuncs[ctrl.Object]{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					// Ignore updates to the kamelet status in which case metadata.Generation does not change
+					return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration()
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					// The status of a deleted Kamelet does not need to be updated
+					return false
+				},
+			})).
+		Complete(r)
+}
+
+var _ reconcile.Reconciler = &reconcileKamelet{}
+
+// reconcileKamelet reconciles a Kamelet object.
+type reconcileKamelet struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the API server
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder events.EventRecorder
+	actions  []Action
+}
+
+// Reconcile reads that state of the cluster for a Kamelet object and makes changes based
+// on the state read and what is in the Kamelet.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *reconcileKamelet) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	rlog.Debug("Reconciling Kamelet")
+
+	// Make sure the operator is allowed to act on namespace
+	if ok, err := platform.IsOperatorAllowedOnNamespace(ctx, r.client, request.Namespace); err != nil {
+		return reconcile.Result{}, err
+	} else if !ok {
+		rlog.Info("Ignoring request because namespace is locked")
+
+		return reconcile.Result{}, nil
+	}
+
+	// Fetch the Kamelet instance
+	var instance v1.Kamelet
+
+	if err := r.client.Get(ctx, request.NamespacedName, &instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Only process resources assigned to the operator
+	if !platform.IsOperatorHandlerConsideringLock(ctx, r.client, request.Namespace, &instance) {
+		rlog.Info("Ignoring request because resource is not assigned to current operator")
+
+		return reconcile.Result{}, nil
+	}
+
+	target := instance.DeepCopy()
+	targetLog := rlog.ForKamelet(target)
+
+	for _, a := range r.actions {
+		a.InjectClient(r.client)
+		a.InjectLogger(targetLog)
+
+		if !a.CanHandle(target) {
+			continue
+		}
+
+		targetLog.Debugf("Invoking action %s", a.Name())
+
+		phaseFrom := target.Status.Phase
+
+		newTarget, err := a.Handle(ctx, target)
+		if err != nil {
+			camelevent.NotifyError(r.recorder, &instance, target, instance.Name, instance.Kind, err)
+
+			return reconcile.Result{}, err
+		}
+
+		if newTarget != nil {
+			newTarget.Status.ObservedGeneration = instance.Generation
+
+			if err := r.client.Status().Patch(ctx, newTarget, ctrl.MergeFrom(&instance)); err != nil {
+				camelevent.NotifyError(r.recorder, &instance, newTarget, newTarget.Name, newTarget.Kind, err)
+
+				return reconcile.Result{}, err
+			}
+
+			if newTarget.Status.Phase != phaseFrom {
+				targetLog.Info(
+					"State transition",
+					"phase-from", phaseFrom,
+					"phase-to", newTarget.Status.Phase,
+				)
+			}
+
+			// handle one action at time so the resource
+			// is always at its latest state
+			camelevent.NotifyKameletUpdated(ctx, r.client, r.recorder, &instance, newTarget)
+		}
+
+		break
+	}
+
+	return reconcile.Result{}, nil
+}
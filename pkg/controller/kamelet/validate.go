@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	utilkamelet "github.com/apache/camel-k/v2/pkg/kamelet"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+// validate checks the Kamelet and all its versions, and records the outcome, along with the default
+// value of the properties, in the Kamelet status. Only the errors make the Kamelet invalid.
+func validate(catalog *camel.RuntimeCatalog, kamelet *v1.Kamelet) {
+	var errs []utilkamelet.Issue
+	for _, issue := range utilkamelet.Validate(catalog, kamelet) {
+		if issue.Severity == utilkamelet.SeverityError {
+			errs = append(errs, issue)
+		}
+	}
+
+	kamelet.Status.Properties = defaultProperties(kamelet.Spec.Definition)
+
+	if len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			if err.Version != "" {
+				messages = append(messages, fmt.Sprintf("version %s: %s", err.Version, err.Message))
+			} else {
+				messages = append(messages, err.Message)
+			}
+		}
+		kamelet.Status.Phase = v1.KameletPhaseError
+		kamelet.Status.SetCondition(
+			v1.KameletConditionReady,
+			corev1.ConditionFalse,
+			errs[0].Reason,
+			strings.Join(messages, "; "))
+
+		return
+	}
+
+	kamelet.Status.Phase = v1.KameletPhaseReady
+	kamelet.Status.SetCondition(
+		v1.KameletConditionReady,
+		corev1.ConditionTrue,
+		v1.KameletConditionReasonValid,
+		"kamelet is valid")
+}
+
+// defaultProperties returns the properties declared by the definition, along with their default value (if any).
+func defaultProperties(definition *v1.JSONSchemaProps) []v1.KameletProperty {
+	if definition == nil {
+		return nil
+	}
+
+	properties := make([]v1.KameletProperty, 0, len(definition.Properties))
+	for _, name := range util.SortedMapKeys(definition.Properties) {
+		property := v1.KameletProperty{Name: name}
+		if d := definition.Properties[name].Default; d != nil {
+			// strings are unquoted, any other value is kept in its JSON form
+			if err := json.Unmarshal(d.RawMessage, &property.Default); err != nil {
+				property.Default = strings.TrimSpace(string(d.RawMessage))
+			}
+		}
+		properties = append(properties, property)
+	}
+
+	return properties
+}
@@ -0,0 +1,166 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func newTestKamelet(name string) *v1.Kamelet {
+	kamelet := v1.NewKamelet("ns", name)
+	kamelet.Generation = 1
+	kamelet.Spec.Definition = &v1.JSONSchemaProps{
+		Required: []string{"message"},
+		Properties: map[string]v1.JSONSchemaProp{
+			"message": {
+				Type: "string",
+			},
+			"period": {
+				Type:    "integer",
+				Default: &v1.JSON{RawMessage: []byte("1000")},
+			},
+			"prefix": {
+				Type:    "string",
+				Default: &v1.JSON{RawMessage: []byte(`"hello"`)},
+			},
+		},
+	}
+	kamelet.Spec.Template = &v1.Template{
+		RawMessage: []byte(`{"from":{"uri":"timer:tick","parameters":{"period":"{{period}}"},` +
+			`"steps":[{"setBody":{"constant":"{{prefix}} {{message}}"}},{"to":"kamelet:sink"}]}}`),
+	}
+	kamelet.Spec.DataTypes = map[v1.TypeSlot]v1.DataTypesSpec{
+		v1.TypeSlotOut: {
+			Default: "text",
+			Types: map[string]v1.DataTypeSpec{
+				"text": {MediaType: "text/plain"},
+			},
+		},
+	}
+	kamelet.Spec.Dependencies = []string{"camel:timer"}
+
+	return &kamelet
+}
+
+func TestValidateKamelet(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	kamelet := newTestKamelet("my-source")
+	validate(catalog, kamelet)
+
+	assert.Equal(t, v1.KameletPhaseReady, kamelet.Status.Phase)
+	cond := kamelet.Status.GetCondition(v1.KameletConditionReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.KameletConditionReasonValid, cond.Reason)
+	assert.Equal(t, []v1.KameletProperty{
+		{Name: "message"},
+		{Name: "period", Default: "1000"},
+		{Name: "prefix", Default: "hello"},
+	}, kamelet.Status.Properties)
+}
+
+func TestValidateInvalidKamelet(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	kamelet := newTestKamelet("my-source")
+	kamelet.Spec.Definition.Required = append(kamelet.Spec.Definition.Required, "missing")
+	kamelet.Spec.Definition.Properties["count"] = v1.JSONSchemaProp{Type: "int"}
+	kamelet.Spec.DataTypes["unknown"] = v1.DataTypesSpec{}
+	kamelet.Spec.DataTypes[v1.TypeSlotIn] = v1.DataTypesSpec{
+		Default: "json",
+		Types: map[string]v1.DataTypeSpec{
+			"text": {Dependencies: []string{"camel:not-a-component"}},
+		},
+	}
+	version := newTestKamelet("my-source").Spec.KameletSpecBase
+	version.Template = nil
+	kamelet.Spec.Versions = map[string]v1.KameletSpecBase{
+		"v1": version,
+	}
+
+	validate(catalog, kamelet)
+
+	assert.Equal(t, v1.KameletPhaseError, kamelet.Status.Phase)
+	cond := kamelet.Status.GetCondition(v1.KameletConditionReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.KameletConditionReasonInvalidProperty, cond.Reason)
+	assert.Contains(t, cond.Message, `required property "missing" is not declared by the definition`)
+	assert.Contains(t, cond.Message, `property "count" has an invalid type "int"`)
+	assert.Contains(t, cond.Message, `invalid data type slot "unknown", expected one of: in|out|error`)
+	assert.Contains(t, cond.Message, `default in data type "json" is not declared`)
+	assert.Contains(t, cond.Message, `in data type "text": dependency camel:not-a-component not found in Camel catalog`)
+	assert.Contains(t, cond.Message, "version v1: kamelet has neither a template nor sources")
+}
+
+func TestValidateKameletInvalidTemplateAndDependency(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	kamelet := newTestKamelet("source")
+	kamelet.Spec.Template = &v1.Template{
+		RawMessage: []byte(`["not", "a", "template"]`),
+	}
+	kamelet.Spec.Dependencies = []string{"camel:not-a-component"}
+
+	validate(catalog, kamelet)
+
+	assert.Equal(t, v1.KameletPhaseError, kamelet.Status.Phase)
+	cond := kamelet.Status.GetCondition(v1.KameletConditionReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, v1.KameletConditionReasonInvalidName, cond.Reason)
+	assert.Contains(t, cond.Message, `kamelet name "source" is reserved`)
+	assert.Contains(t, cond.Message, "invalid template")
+	assert.Contains(t, cond.Message, "dependency camel:not-a-component not found in Camel catalog")
+}
+
+func TestMonitorValidatesOnlyNewGenerations(t *testing.T) {
+	kamelet := newTestKamelet("my-source")
+	kamelet.Status.Phase = v1.KameletPhaseReady
+	c, err := internal.NewFakeClient(kamelet)
+	require.NoError(t, err)
+
+	action := NewMonitorAction()
+	action.InjectLogger(log.Log)
+	action.InjectClient(c)
+	require.True(t, action.CanHandle(kamelet))
+
+	answer, err := action.Handle(context.TODO(), kamelet)
+	require.NoError(t, err)
+	require.NotNil(t, answer)
+	assert.Equal(t, v1.KameletPhaseReady, answer.Status.Phase)
+	assert.Len(t, answer.Status.Properties, 3)
+
+	answer.Status.ObservedGeneration = answer.Generation
+	answer, err = action.Handle(context.TODO(), answer)
+	require.NoError(t, err)
+	assert.Nil(t, answer)
+}
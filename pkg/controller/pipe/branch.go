@@ -0,0 +1,202 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+)
+
+const defaultChoiceLanguage = "simple"
+
+// branchBinding holds the translated endpoints of a Pipe branch.
+type branchBinding struct {
+	steps []*bindings.Binding
+	sink  *bindings.Binding
+}
+
+// maybeBranches will return the Camel YAML DSL step mapping the Choice or the Multicast of the Pipe,
+// together with the bindings of all the branch endpoints. The endpoint positions start from the given
+// position, so that each endpoint gets a unique identifier.
+func maybeBranches(spec v1.PipeSpec, bindingContext bindings.BindingContext, position int) (map[string]any, []*bindings.Binding, error) {
+	switch {
+	case spec.Choice != nil && spec.Multicast != nil:
+		return nil, nil, errors.New("illegal branch definition: choice and multicast cannot be used together")
+	case spec.Choice != nil:
+		return translateChoice(*spec.Choice, bindingContext, position)
+	case spec.Multicast != nil:
+		return translateMulticast(*spec.Multicast, bindingContext, position)
+	default:
+		return nil, nil, nil
+	}
+}
+
+func translateChoice(choice v1.PipeChoice, bindingContext bindings.BindingContext, position int) (map[string]any, []*bindings.Binding, error) {
+	if len(choice.When) == 0 {
+		return nil, nil, errors.New("illegal choice definition: at least one when branch should be provided")
+	}
+
+	all := make([]*bindings.Binding, 0)
+	when := make([]map[string]any, 0, len(choice.When))
+	for idx, w := range choice.When {
+		if w.Expression == "" {
+			return nil, nil, fmt.Errorf("illegal definition for when branch %d: expression should be provided", idx)
+		}
+		branch, err := translateBranch(bindingContext, w.PipeBranch, &position)
+		if err != nil {
+			return nil, nil, fmt.Errorf("illegal definition for when branch %d: %w", idx, err)
+		}
+		all = append(all, branch.bindings()...)
+
+		language := w.Language
+		if language == "" {
+			language = defaultChoiceLanguage
+		}
+		when = append(when, map[string]any{
+			"expression": map[string]any{
+				language: map[string]any{
+					"expression": w.Expression,
+				},
+			},
+			"steps": branch.asYamlDSL(),
+		})
+	}
+
+	choiceDsl := map[string]any{
+		"when": when,
+	}
+	if choice.Otherwise != nil {
+		branch, err := translateBranch(bindingContext, *choice.Otherwise, &position)
+		if err != nil {
+			return nil, nil, fmt.Errorf("illegal definition for otherwise branch: %w", err)
+		}
+		all = append(all, branch.bindings()...)
+		choiceDsl["otherwise"] = map[string]any{
+			"steps": branch.asYamlDSL(),
+		}
+	}
+
+	return map[string]any{"choice": choiceDsl}, all, nil
+}
+
+func translateMulticast(multicast v1.PipeMulticast, bindingContext bindings.BindingContext, position int) (map[string]any, []*bindings.Binding, error) {
+	if len(multicast.Branches) == 0 {
+		return nil, nil, errors.New("illegal multicast definition: at least one branch should be provided")
+	}
+
+	all := make([]*bindings.Binding, 0)
+	steps := make([]map[string]any, 0, len(multicast.Branches))
+	for idx, b := range multicast.Branches {
+		branch, err := translateBranch(bindingContext, b, &position)
+		if err != nil {
+			return nil, nil, fmt.Errorf("illegal definition for multicast branch %d: %w", idx, err)
+		}
+		all = append(all, branch.bindings()...)
+		steps = append(steps, map[string]any{
+			"pipeline": map[string]any{
+				"steps": branch.asYamlDSL(),
+			},
+		})
+	}
+
+	multicastDsl := map[string]any{
+		"steps": steps,
+	}
+	if multicast.Parallel {
+		multicastDsl["parallelProcessing"] = true
+	}
+
+	return map[string]any{"multicast": multicastDsl}, all, nil
+}
+
+// translateBranch resolves the branch endpoints through the binding providers, as it's done for the Pipe
+// steps and sink, so that any supported endpoint (Kamelets, Knative, Strimzi, ...) can be used in a branch.
+func translateBranch(bindingContext bindings.BindingContext, branch v1.PipeBranch, position *int) (*branchBinding, error) {
+	if len(branch.Steps) == 0 && (branch.Sink == nil || branch.Sink.IsEmpty()) {
+		return nil, errors.New("either steps or a sink should be provided")
+	}
+
+	result := branchBinding{
+		steps: make([]*bindings.Binding, 0, len(branch.Steps)),
+	}
+	for idx, step := range branch.Steps {
+		stepPosition := *position
+		*position++
+		stepBinding, err := bindings.Translate(bindingContext, bindings.EndpointContext{
+			Type:     v1.EndpointTypeAction,
+			Position: &stepPosition,
+		}, step)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine URI for step %d: %w", idx, err)
+		}
+		if stepBinding.Step == nil && stepBinding.URI == "" {
+			return nil, fmt.Errorf("illegal step definition for step %d: either Step or URI should be provided", idx)
+		}
+		result.steps = append(result.steps, stepBinding)
+	}
+
+	if branch.Sink != nil && !branch.Sink.IsEmpty() {
+		sinkPosition := *position
+		*position++
+		sinkBinding, err := bindings.Translate(bindingContext, bindings.EndpointContext{
+			Type:     v1.EndpointTypeSink,
+			Position: &sinkPosition,
+		}, *branch.Sink)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine URI for sink: %w", err)
+		}
+		if sinkBinding.Step == nil && sinkBinding.URI == "" {
+			return nil, errors.New("illegal step definition for sink step: either Step or URI should be provided")
+		}
+		result.sink = sinkBinding
+	}
+
+	return &result, nil
+}
+
+func (b *branchBinding) bindings() []*bindings.Binding {
+	all := make([]*bindings.Binding, 0, len(b.steps)+1)
+	all = append(all, b.steps...)
+	if b.sink != nil {
+		all = append(all, b.sink)
+	}
+
+	return all
+}
+
+func (b *branchBinding) asYamlDSL() []map[string]any {
+	dslSteps := make([]map[string]any, 0, len(b.steps)+2)
+	for _, step := range b.steps {
+		dslSteps = append(dslSteps, step.AsYamlDSL())
+	}
+	if b.sink != nil {
+		if b.sink.Step != nil {
+			dslSteps = append(dslSteps, b.sink.AsYamlDSL())
+		}
+		if b.sink.URI != "" {
+			dslSteps = append(dslSteps, map[string]any{
+				"to": b.sink.URI,
+			})
+		}
+	}
+
+	return dslSteps
+}
@@ -63,6 +63,14 @@ func (action *initializeAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.
 func initializePipe(ctx context.Context, c client.Client, l log.Logger, pipe *v1.Pipe) (*v1.Pipe, error) {
 	// Remove the previous conditions
 	pipe.Status = v1.PipeStatus{}
+	// Do not materialize the Pipe until all its Kamelets are ready
+	if ready, err := checkKamelets(ctx, c, pipe); err != nil {
+		return nil, err
+	} else if !ready {
+		setKameletNotReady(pipe)
+
+		return pipe, nil
+	}
 	it, err := CreateIntegrationFor(ctx, c, pipe)
 	if err != nil {
 		pipe.Status.Phase = v1.PipePhaseError
@@ -262,14 +262,14 @@ func TestNewPipeUnsupportedRef(t *testing.T) {
 	handledPipe, err := a.Handle(context.TODO(), pipe)
 	require.Error(t, err)
 	assert.Equal(t, "could not find any suitable binding provider for my-api-version/my-kind my-kind-name in namespace ns. "+
-		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", err.Error())
+		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"service-binding\" \"camel-uri\" \"knative-ref\"]", err.Error())
 	assert.Equal(t, v1.PipePhaseError, handledPipe.Status.Phase)
 	cond := handledPipe.Status.GetCondition(v1.PipeConditionReady)
 	assert.NotNil(t, cond)
 	assert.Equal(t, corev1.ConditionFalse, cond.Status)
 	assert.Equal(t, "IntegrationError", cond.Reason)
 	assert.Equal(t, "could not find any suitable binding provider for my-api-version/my-kind my-kind-name in namespace ns. "+
-		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", cond.Message)
+		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"service-binding\" \"camel-uri\" \"knative-ref\"]", cond.Message)
 }
 
 func TestNewPipeKnativeURIBinding(t *testing.T) {
@@ -22,12 +22,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/trait"
 
 	"github.com/apache/camel-k/v2/pkg/client"
@@ -37,6 +39,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/knative"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/property"
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 )
 
 var (
@@ -124,9 +127,13 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 	if err != nil {
 		return nil, err
 	}
-	to, err := bindings.Translate(bindingContext, endpointTypeSinkContext, pipe.Spec.Sink)
-	if err != nil {
-		return nil, err
+	// sink is optional when the Pipe ends with a choice or a multicast
+	var to *bindings.Binding
+	if !pipe.Spec.HasBranches() || !pipe.Spec.Sink.IsEmpty() {
+		to, err = bindings.Translate(bindingContext, endpointTypeSinkContext, pipe.Spec.Sink)
+		if err != nil {
+			return nil, err
+		}
 	}
 	// error handler is optional
 	errorHandler, err := maybeErrorHandler(pipe.Spec.ErrorHandler, bindingContext)
@@ -147,7 +154,12 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		steps = append(steps, stepBinding)
 	}
 
-	if to.Step == nil && to.URI == "" {
+	branches, branchBindings, err := maybeBranches(pipe.Spec, bindingContext, len(steps))
+	if err != nil {
+		return nil, err
+	}
+
+	if to != nil && to.Step == nil && to.URI == "" {
 		return nil, errors.New("illegal step definition for sink step: either Step or URI should be provided")
 	}
 	if from.URI == "" {
@@ -167,6 +179,10 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		return nil, err
 	}
 
+	if err := configureBinding(&it, branchBindings...); err != nil {
+		return nil, err
+	}
+
 	if err := configureBinding(&it, to); err != nil {
 		return nil, err
 	}
@@ -197,12 +213,18 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		dslSteps = append(dslSteps, step.AsYamlDSL())
 	}
 
-	if to.Step != nil {
-		dslSteps = append(dslSteps, to.AsYamlDSL())
+	if branches != nil {
+		dslSteps = append(dslSteps, branches)
+	}
+
+	if to != nil {
+		if to.Step != nil {
+			dslSteps = append(dslSteps, to.AsYamlDSL())
+		}
+		dslSteps = append(dslSteps, map[string]any{
+			"to": to.URI,
+		})
 	}
-	dslSteps = append(dslSteps, map[string]any{
-		"to": to.URI,
-	})
 
 	fromWrapper := map[string]any{
 		"uri":   from.URI,
@@ -245,11 +267,26 @@ func configureBinding(integration *v1.Integration, bindings ...*bindings.Binding
 		if b == nil {
 			continue
 		}
+		var services []string
+		if integration.Spec.Traits.ServiceBinding != nil {
+			services = integration.Spec.Traits.ServiceBinding.Services
+		}
 		//nolint:staticcheck
 		if err := integration.Spec.Traits.Merge(b.Traits); err != nil {
 			return err
 		}
-		for k, v := range b.ApplicationProperties {
+		// The services bound by the different endpoints add up
+		if integration.Spec.Traits.ServiceBinding != nil {
+			for _, service := range services {
+				util.StringSliceUniqueAdd(&integration.Spec.Traits.ServiceBinding.Services, service)
+			}
+		}
+		for _, k := range util.SortedStringMapKeys(b.ApplicationProperties) {
+			v := b.ApplicationProperties[k]
+			if secrets.IsReference(v) {
+				// External secrets are materialized by the mount trait, and resolved as placeholders
+				v = externalSecretPlaceholder(integration, v)
+			}
 			entry, err := property.EncodePropertyFileEntry(k, v)
 			if err != nil {
 				return err
@@ -262,6 +299,20 @@ func configureBinding(integration *v1.Integration, bindings ...*bindings.Binding
 	return nil
 }
 
+// externalSecretPlaceholder declares the external secret reference as a config of the Integration,
+// and returns the placeholder of the property holding its value.
+func externalSecretPlaceholder(integration *v1.Integration, value string) string {
+	if integration.Spec.Traits.Mount == nil {
+		integration.Spec.Traits.Mount = &traitv1.MountTrait{}
+	}
+	if !slices.Contains(integration.Spec.Traits.Mount.Configs, value) {
+		integration.Spec.Traits.Mount.Configs = append(integration.Spec.Traits.Mount.Configs, value)
+	}
+	ref, _ := secrets.ParseReference(value)
+
+	return "{{" + ref.Key + "}}"
+}
+
 //nolint:staticcheck
 func determineTraitProfile(ctx context.Context, c client.Client, binding *v1.Pipe) (v1.TraitProfile, error) {
 	pl, err := platform.GetForResource(ctx, c, binding)
@@ -55,6 +55,31 @@ func TestCreateIntegrationForPipe(t *testing.T) {
 	assert.Equal(t, expectedNominalRoute(), string(dsl))
 }
 
+func TestCreateIntegrationForPipeWithExternalSecrets(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-pipe")
+	pipe.Spec.Source.Properties = asEndpointProperties(map[string]string{
+		"user":     "admin",
+		"password": "kubernetes:db-credentials#password",
+	})
+	pipe.Spec.Sink.Properties = asEndpointProperties(map[string]string{
+		"token": "kubernetes:api-credentials#token",
+	})
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	require.NotNil(t, it.Spec.Traits.Mount)
+	assert.Equal(t, []string{"kubernetes:db-credentials#password", "kubernetes:api-credentials#token"}, it.Spec.Traits.Mount.Configs)
+	//nolint:staticcheck
+	assert.ElementsMatch(t, []v1.ConfigurationSpec{
+		{Type: "property", Value: "camel.kamelet.my-source.source.password = {{password}}"},
+		{Type: "property", Value: "camel.kamelet.my-source.source.user = admin"},
+		{Type: "property", Value: "camel.kamelet.my-sink.sink.token = {{token}}"},
+	}, it.Spec.Configuration)
+}
+
 func TestCreateIntegrationForPipeWithSinkKameletErrorHandler(t *testing.T) {
 	client, err := internal.NewFakeClient()
 	require.NoError(t, err)
@@ -357,3 +382,176 @@ func TestCreateIntegrationTraitsForPipeWithTraitSpec(t *testing.T) {
 	assert.Nil(t, it.Spec.Traits.Service)
 	assert.Equal(t, []string{"my-test-affinity"}, it.Spec.Traits.Affinity.PodAffinityLabels)
 }
+
+func TestCreateIntegrationForPipeWithChoice(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-choice-pipe")
+	pipe.Spec.Sink = v1.Endpoint{}
+	pipe.Spec.Choice = &v1.PipeChoice{
+		When: []v1.PipeWhen{
+			{
+				Expression: "${header.type} == 'order'",
+				PipeBranch: v1.PipeBranch{
+					Steps: []v1.Endpoint{
+						{
+							Ref: &corev1.ObjectReference{
+								Kind:       "Kamelet",
+								Name:       "my-action",
+								APIVersion: "camel.apache.org/v1",
+							},
+						},
+					},
+					Sink: &v1.Endpoint{
+						Ref: &corev1.ObjectReference{
+							Kind:       "Kamelet",
+							Name:       "my-order-sink",
+							APIVersion: "camel.apache.org/v1",
+						},
+						Properties: asEndpointProperties(map[string]string{"topic": "orders"}),
+					},
+				},
+			},
+			{
+				Language:   "jsonpath",
+				Expression: "$.priority",
+				PipeBranch: v1.PipeBranch{
+					Sink: &v1.Endpoint{
+						URI: ptr.To("log:priority"),
+					},
+				},
+			},
+		},
+		Otherwise: &v1.PipeBranch{
+			Sink: &v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       "Kamelet",
+					Name:       "my-sink",
+					APIVersion: "camel.apache.org/v1",
+				},
+			},
+		},
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t, `- route:
+    from:
+      steps:
+      - choice:
+          otherwise:
+            steps:
+            - to: kamelet:my-sink/sink-3
+          when:
+          - expression:
+              simple:
+                expression: ${header.type} == 'order'
+            steps:
+            - kamelet:
+                name: my-action/action-0
+            - to: kamelet:my-order-sink/sink-1
+          - expression:
+              jsonpath:
+                expression: $.priority
+            steps:
+            - to: log:priority
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl))
+	require.NotNil(t, it.Spec.Configuration)
+	assert.Contains(t, it.Spec.Configuration, v1.ConfigurationSpec{
+		Type:  "property",
+		Value: "camel.kamelet.my-order-sink.sink-1.topic = orders",
+	})
+}
+
+func TestCreateIntegrationForPipeWithMulticast(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-multicast-pipe")
+	pipe.Spec.Steps = []v1.Endpoint{
+		{
+			URI: ptr.To("log:info"),
+		},
+	}
+	pipe.Spec.Multicast = &v1.PipeMulticast{
+		Parallel: true,
+		Branches: []v1.PipeBranch{
+			{
+				Sink: &v1.Endpoint{
+					Ref: &corev1.ObjectReference{
+						Kind:       "Kamelet",
+						Name:       "my-first-sink",
+						APIVersion: "camel.apache.org/v1",
+					},
+				},
+			},
+			{
+				Sink: &v1.Endpoint{
+					URI: ptr.To("log:second"),
+				},
+			},
+		},
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t, `- route:
+    from:
+      steps:
+      - to: log:info
+      - multicast:
+          parallelProcessing: true
+          steps:
+          - pipeline:
+              steps:
+              - to: kamelet:my-first-sink/sink-1
+          - pipeline:
+              steps:
+              - to: log:second
+      - to: kamelet:my-sink/sink
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl))
+}
+
+func TestCreateIntegrationForPipeWithIllegalBranches(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-illegal-pipe")
+	pipe.Spec.Choice = &v1.PipeChoice{
+		When: []v1.PipeWhen{
+			{
+				Expression: "${body} != null",
+				PipeBranch: v1.PipeBranch{
+					Sink: &v1.Endpoint{URI: ptr.To("log:info")},
+				},
+			},
+		},
+	}
+	pipe.Spec.Multicast = &v1.PipeMulticast{}
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.EqualError(t, err, "illegal branch definition: choice and multicast cannot be used together")
+
+	pipe.Spec.Multicast = nil
+	pipe.Spec.Choice.When[0].Expression = ""
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.EqualError(t, err, "illegal definition for when branch 0: expression should be provided")
+
+	pipe.Spec.Choice.When[0].Expression = "${body} != null"
+	pipe.Spec.Choice.When[0].Sink = nil
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.EqualError(t, err, "illegal definition for when branch 0: either steps or a sink should be provided")
+
+	pipe.Spec.Choice = nil
+	pipe.Spec.Sink = v1.Endpoint{}
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+}
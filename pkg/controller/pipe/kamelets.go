@@ -0,0 +1,197 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+)
+
+const kameletNotReadyReason = "KameletNotReady"
+
+// kameletRefs returns the references to the Kamelets used by any endpoint of the Pipe.
+func kameletRefs(pipe *v1.Pipe) []*corev1.ObjectReference {
+	endpoints := []v1.Endpoint{pipe.Spec.Source, pipe.Spec.Sink}
+	endpoints = append(endpoints, pipe.Spec.Steps...)
+	for _, branch := range pipe.Spec.GetBranches() {
+		endpoints = append(endpoints, branch.Steps...)
+		if branch.Sink != nil {
+			endpoints = append(endpoints, *branch.Sink)
+		}
+	}
+
+	refs := make([]*corev1.ObjectReference, 0)
+	for _, endpoint := range endpoints {
+		if endpoint.Ref == nil || endpoint.Ref.Kind != v1.KameletKind {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(endpoint.Ref.APIVersion)
+		if err != nil || gv.Group != v1.SchemeGroupVersion.Group {
+			continue
+		}
+		refs = append(refs, endpoint.Ref)
+	}
+
+	return refs
+}
+
+// checkKamelets verifies that none of the Kamelets referenced by the Pipe is in error, and records the outcome
+// in the KameletsReady condition. Kamelets that are not available in the cluster (ie, provided by a
+// repository) are not taken into account. It returns false when the Pipe must not be materialized.
+func checkKamelets(ctx context.Context, c client.Client, pipe *v1.Pipe) (bool, error) {
+	refs := kameletRefs(pipe)
+	if len(refs) == 0 {
+		pipe.Status.RemoveCondition(v1.PipeConditionKameletsReady)
+
+		return true, nil
+	}
+
+	var notReady []string
+	for _, ref := range refs {
+		kamelet, err := lookupKamelet(ctx, c, pipe.Namespace, ref)
+		if err != nil {
+			return false, err
+		}
+		if kamelet == nil || kamelet.Status.Phase != v1.KameletPhaseError {
+			continue
+		}
+		message := fmt.Sprintf("Kamelet %s/%s is not ready", kamelet.Namespace, kamelet.Name)
+		if condition := kamelet.Status.GetCondition(v1.KameletConditionReady); condition != nil && condition.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, condition.Message)
+		}
+		if !slices.Contains(notReady, message) {
+			notReady = append(notReady, message)
+		}
+	}
+
+	if len(notReady) > 0 {
+		pipe.Status.SetCondition(
+			v1.PipeConditionKameletsReady,
+			corev1.ConditionFalse,
+			kameletNotReadyReason,
+			strings.Join(notReady, "; "),
+		)
+
+		return false, nil
+	}
+
+	pipe.Status.SetCondition(
+		v1.PipeConditionKameletsReady,
+		corev1.ConditionTrue,
+		"",
+		"all the referenced Kamelets are ready",
+	)
+
+	return true, nil
+}
+
+// lookupKamelet gets the referenced Kamelet from the reference namespace, or the Pipe namespace, falling back
+// to the operator namespace. It returns nil when the Kamelet is not found.
+func lookupKamelet(ctx context.Context, c ctrl.Reader, namespace string, ref *corev1.ObjectReference) (*v1.Kamelet, error) {
+	namespaces := []string{namespace}
+	if ref.Namespace != "" {
+		namespaces = []string{ref.Namespace}
+	}
+	if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" && operatorNamespace != namespaces[0] {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+
+	for _, ns := range namespaces {
+		kamelet := v1.NewKamelet(ns, ref.Name)
+		err := c.Get(ctx, ctrl.ObjectKeyFromObject(&kamelet), &kamelet)
+		if err == nil {
+			return &kamelet, nil
+		}
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// setKameletNotReady moves the Pipe in error, because of the Kamelets reported by the KameletsReady condition.
+func setKameletNotReady(pipe *v1.Pipe) {
+	pipe.Status.Phase = v1.PipePhaseError
+	message := "some of the referenced Kamelets are not ready"
+	if condition := pipe.Status.GetCondition(v1.PipeConditionKameletsReady); condition != nil {
+		message = condition.Message
+	}
+	pipe.Status.SetCondition(
+		v1.PipeConditionReady,
+		corev1.ConditionFalse,
+		kameletNotReadyReason,
+		message,
+	)
+}
+
+func kameletEnqueueRequestsFromMapFunc(ctx context.Context, c client.Client, kamelet *v1.Kamelet) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &v1.PipeList{}
+
+	// Do global search in case of global operator (the Kamelet may be in the operator namespace)
+	var opts []ctrl.ListOption
+	if !platform.IsCurrentOperatorGlobal() {
+		opts = append(opts, ctrl.InNamespace(kamelet.Namespace))
+	}
+
+	if err := c.List(ctx, list, opts...); err != nil {
+		Log.Error(err, "Failed to list pipes")
+
+		return requests
+	}
+
+	for i := range list.Items {
+		pipe := &list.Items[i]
+		for _, ref := range kameletRefs(pipe) {
+			if ref.Name != kamelet.Name {
+				continue
+			}
+			if ref.Namespace != "" && ref.Namespace != kamelet.Namespace {
+				continue
+			}
+			if ref.Namespace == "" && pipe.Namespace != kamelet.Namespace && kamelet.Namespace != platform.GetOperatorNamespace() {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: pipe.Namespace,
+					Name:      pipe.Name,
+				},
+			})
+
+			break
+		}
+	}
+
+	return requests
+}
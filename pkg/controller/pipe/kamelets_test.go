@@ -0,0 +1,136 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func newKameletPipe() *v1.Pipe {
+	return &v1.Pipe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       v1.KameletKind,
+					Name:       "my-source",
+				},
+			},
+			Sink: v1.Endpoint{
+				URI: ptr.To("log:info"),
+			},
+		},
+	}
+}
+
+func newErrorKamelet() *v1.Kamelet {
+	kamelet := v1.NewKamelet("ns", "my-source")
+	kamelet.Spec.Template = &v1.Template{
+		RawMessage: []byte(`{"from":{"uri":"timer:tick","steps":[{"to":"kamelet:sink"}]}}`),
+	}
+	kamelet.Status.Phase = v1.KameletPhaseError
+	kamelet.Status.SetCondition(v1.KameletConditionReady, corev1.ConditionFalse,
+		v1.KameletConditionReasonInvalidDependency, "dependency camel:foo not found in Camel catalog")
+
+	return &kamelet
+}
+
+func TestNewPipeBlockedByKamelet(t *testing.T) {
+	pipe := newKameletPipe()
+	c, err := internal.NewFakeClient(pipe, newErrorKamelet())
+	require.NoError(t, err)
+
+	a := NewInitializeAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledPipe, err := a.Handle(context.TODO(), pipe)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PipePhaseError, handledPipe.Status.Phase)
+	cond := handledPipe.Status.GetCondition(v1.PipeConditionKameletsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, "KameletNotReady", cond.Reason)
+	assert.Equal(t, "Kamelet ns/my-source is not ready: dependency camel:foo not found in Camel catalog", cond.Message)
+	cond = handledPipe.Status.GetCondition(v1.PipeConditionReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, "KameletNotReady", cond.Reason)
+
+	// The Integration must not be created
+	it := v1.NewIntegration("ns", "my-pipe")
+	err = c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&it), &it)
+	require.Error(t, err)
+}
+
+func TestPipeUnblockedByKamelet(t *testing.T) {
+	pipe := newKameletPipe()
+	pipe.Status.Phase = v1.PipePhaseError
+	kamelet := newErrorKamelet()
+	kamelet.Status.Phase = v1.KameletPhaseReady
+	kamelet.Status.SetCondition(v1.KameletConditionReady, corev1.ConditionTrue, v1.KameletConditionReasonValid, "kamelet is valid")
+	c, err := internal.NewFakeClient(pipe, kamelet)
+	require.NoError(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	require.True(t, a.CanHandle(pipe))
+	handledPipe, err := a.Handle(context.TODO(), pipe)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PipePhaseCreating, handledPipe.Status.Phase)
+	cond := handledPipe.Status.GetCondition(v1.PipeConditionKameletsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+
+	it := v1.NewIntegration("ns", "my-pipe")
+	err = c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&it), &it)
+	require.NoError(t, err)
+}
+
+func TestKameletEnqueueRequests(t *testing.T) {
+	pipe := newKameletPipe()
+	otherPipe := newKameletPipe()
+	otherPipe.Name = "other-pipe"
+	otherPipe.Spec.Source = v1.Endpoint{URI: ptr.To("timer:tick")}
+	c, err := internal.NewFakeClient(pipe, otherPipe)
+	require.NoError(t, err)
+
+	requests := kameletEnqueueRequestsFromMapFunc(context.TODO(), c, newErrorKamelet())
+	require.Len(t, requests, 1)
+	assert.Equal(t, "my-pipe", requests[0].Name)
+}
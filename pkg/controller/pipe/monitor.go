@@ -81,6 +81,16 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 		return nil, err
 	}
 
+	// Do not propagate any change to the Integration until all the Kamelets are ready
+	target := pipe.DeepCopy()
+	if ready, err := checkKamelets(ctx, action.client, target); err != nil {
+		return nil, err
+	} else if !ready {
+		setKameletNotReady(target)
+
+		return target, nil
+	}
+
 	// Check if the integration needs to be changed
 	expected, err := CreateIntegrationFor(ctx, action.client, pipe)
 	if err != nil {
@@ -105,7 +115,6 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 			"traits-changed", !sameTraits)
 
 		// Pipe has changed and needs rebuild
-		target := pipe.DeepCopy()
 		// Rebuild the integration
 		target.Status.Phase = v1.PipePhaseNone
 		target.Status.SetCondition(
@@ -119,7 +128,6 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 	}
 
 	// Map integration phase and conditions to Pipe
-	target := pipe.DeepCopy()
 
 	switch it.Status.Phase {
 	case v1.IntegrationPhaseRunning:
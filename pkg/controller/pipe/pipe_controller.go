@@ -23,6 +23,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
@@ -127,9 +128,84 @@ func add(mgr manager.Manager, r reconcile.Reconciler, c client.Client) error {
 		return err
 	}
 
+	// Watch Kamelet phase changes to unblock or block the Pipes using it
+	err = ctrl.Watch(
+		source.Kind(mgr.GetCache(),
+			&v1.Kamelet{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, kamelet *v1.Kamelet) []reconcile.Request {
+				return kameletEnqueueRequestsFromMapFunc(ctx, c, kamelet)
+			}),
+			platform.FilteringFuncs[*v1.Kamelet]{
+				CreateFunc: func(e event.TypedCreateEvent[*v1.Kamelet]) bool {
+					return false
+				},
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1.Kamelet]) bool {
+					return e.ObjectOld.Status.Phase != e.ObjectNew.Status.Phase
+				},
+				DeleteFunc: func(e event.TypedDeleteEvent[*v1.Kamelet]) bool {
+					return false
+				},
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Watch IntegrationProfile to reconcile the Pipes using it
+	err = ctrl.Watch(
+		source.Kind(mgr.GetCache(),
+			&v1.IntegrationProfile{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, profile *v1.IntegrationProfile) []reconcile.Request {
+				return integrationProfileEnqueueRequestsFromMapFunc(ctx, c, profile)
+			}),
+			platform.FilteringFuncs[*v1.IntegrationProfile]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1.IntegrationProfile]) bool {
+					// Only spec changes are relevant to the pipes
+					return e.ObjectOld.Generation != e.ObjectNew.Generation
+				},
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func integrationProfileEnqueueRequestsFromMapFunc(ctx context.Context, c client.Client, profile *v1.IntegrationProfile) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &v1.PipeList{}
+
+	// Do global search in case of global operator (it may be using a profile from the operator namespace)
+	var opts []ctrl.ListOption
+	if !platform.IsCurrentOperatorGlobal() {
+		opts = append(opts, ctrl.InNamespace(profile.Namespace))
+	}
+
+	if err := c.List(ctx, list, opts...); err != nil {
+		Log.Error(err, "Failed to list pipes")
+
+		return requests
+	}
+
+	for i := range list.Items {
+		pipe := &list.Items[i]
+		if platform.IsIntegrationProfileReferenced(ctx, c, pipe, profile) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: pipe.Namespace,
+					Name:      pipe.Name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 var _ reconcile.Reconciler = &ReconcilePipe{}
 
 // ReconcilePipe reconciles a Pipe object.
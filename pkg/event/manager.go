@@ -137,6 +137,44 @@ func NotifyIntegrationPlatformUpdated(ctx context.Context, c client.Client, reco
 		"Integration Platform", newResource.Name, ReasonIntegrationPlatformPhaseUpdated, "")
 }
 
+// NotifyIntegrationProfileUpdated automatically generates events when an integration profile changes.
+func NotifyIntegrationProfileUpdated(ctx context.Context, c client.Client, recorder events.EventRecorder, old, newResource *v1.IntegrationProfile) {
+	if newResource == nil {
+		return
+	}
+	oldPhase := ""
+	var oldConditions []v1.ResourceCondition
+	if old != nil {
+		oldPhase = string(old.Status.Phase)
+		oldConditions = old.Status.GetConditions()
+	}
+	if newResource.Status.Phase != v1.IntegrationProfilePhaseNone {
+		notifyIfConditionUpdated(recorder, newResource, oldConditions, newResource.Status.GetConditions(),
+			"Integration Profile", newResource.Name, ReasonIntegrationProfileConditionChanged)
+	}
+	notifyIfPhaseUpdated(ctx, c, recorder, newResource, oldPhase, string(newResource.Status.Phase),
+		"Integration Profile", newResource.Name, ReasonIntegrationProfilePhaseUpdated, "")
+}
+
+// NotifyKameletUpdated automatically generates events when a Kamelet changes.
+func NotifyKameletUpdated(ctx context.Context, c client.Client, recorder events.EventRecorder, old, newResource *v1.Kamelet) {
+	if newResource == nil {
+		return
+	}
+	oldPhase := ""
+	var oldConditions []v1.ResourceCondition
+	if old != nil {
+		oldPhase = string(old.Status.Phase)
+		oldConditions = old.Status.GetConditions()
+	}
+	if newResource.Status.Phase != v1.KameletPhaseNone {
+		notifyIfConditionUpdated(recorder, newResource, oldConditions, newResource.Status.GetConditions(),
+			"Kamelet", newResource.Name, ReasonKameletConditionChanged)
+	}
+	notifyIfPhaseUpdated(ctx, c, recorder, newResource, oldPhase, string(newResource.Status.Phase),
+		"Kamelet", newResource.Name, ReasonKameletPhaseUpdated, "")
+}
+
 // NotifyCamelCatalogUpdated automatically generates events when a CamelCatalog changes.
 func NotifyCamelCatalogUpdated(ctx context.Context, c client.Client, recorder events.EventRecorder, old, newResource *v1.CamelCatalog) {
 	if newResource == nil {
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// kameletCache keeps the Kamelets loaded by the repositories that are expensive to read (archives, registries),
+// so that they are not loaded again each time a repository is instantiated.
+type kameletCache struct {
+	lock    sync.Mutex
+	entries map[string]kameletCacheEntry
+}
+
+type kameletCacheEntry struct {
+	// revision identifies the content the Kamelets were loaded from (a modification time, an image digest, ...)
+	revision string
+	// expiration is the time after which the revision must be verified again (zero means never)
+	expiration time.Time
+	kamelets   map[string]*v1.Kamelet
+}
+
+var repositoryCache = newKameletCache()
+
+func newKameletCache() *kameletCache {
+	return &kameletCache{
+		entries: make(map[string]kameletCacheEntry),
+	}
+}
+
+func (c *kameletCache) get(key string) (kameletCacheEntry, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+
+	return entry, ok
+}
+
+func (c *kameletCache) put(key string, entry kameletCacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[key] = entry
+}
+
+func (e kameletCacheEntry) expired(now time.Time) bool {
+	return !e.expiration.IsZero() && now.After(e.expiration)
+}
+
+func (e kameletCacheEntry) names() []string {
+	res := make([]string, 0, len(e.kamelets))
+	for name := range e.kamelets {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func (e kameletCacheEntry) lookup(name string) *v1.Kamelet {
+	if kamelet, ok := e.kamelets[name]; ok {
+		// return a copy, as the callers are allowed to change the Kamelet
+		return kamelet.DeepCopy()
+	}
+
+	return nil
+}
@@ -18,7 +18,20 @@ limitations under the License.
 package repository
 
 import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
 	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
 var fileSuffixes = []string{".kamelet.yaml", ".kamelet.yml", ".kamelet.json"}
@@ -51,3 +64,73 @@ func getKameletNameFromFile(fileName string) string {
 
 	return name
 }
+
+// parseKamelet decodes the content of a Kamelet file, either in YAML or in JSON format.
+func parseKamelet(fileName string, content []byte) (*v1.Kamelet, error) {
+	if strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml") {
+		var err error
+		content, err = yaml.ToJSON(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var kamelet v1.Kamelet
+	if err := json.Unmarshal(content, &kamelet); err != nil {
+		return nil, err
+	}
+
+	return &kamelet, nil
+}
+
+// extractKamelets reads all the Kamelet files contained in a (possibly gzipped) tar archive. When a version is
+// provided, only the Kamelets stored in the directory named after the version are taken in account.
+func extractKamelets(archive io.Reader, version string) (map[string]*v1.Kamelet, error) {
+	reader := bufio.NewReader(archive)
+	if magic, err := reader.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+
+		return extractKameletsFromTar(tar.NewReader(gz), version)
+	}
+
+	return extractKameletsFromTar(tar.NewReader(reader), version)
+}
+
+func extractKameletsFromTar(tr *tar.Reader, version string) (map[string]*v1.Kamelet, error) {
+	kamelets := make(map[string]*v1.Kamelet)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+		if version != "" && path.Base(path.Dir(name)) != version {
+			continue
+		}
+		if !isKameletFileName(path.Base(name)) {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		kamelet, err := parseKamelet(name, content)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse kamelet file %s: %w", name, err)
+		}
+		kamelets[kamelet.Name] = kamelet
+	}
+
+	return kamelets, nil
+}
@@ -0,0 +1,174 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// fileKameletRepository reads the Kamelets from a local directory or from a (possibly gzipped) tar archive,
+// typically mounted into the operator Pod from a volume.
+type fileKameletRepository struct {
+	path    string
+	version string
+}
+
+func newFileKameletRepository(path, version string) KameletRepository {
+	return &fileKameletRepository{
+		path:    path,
+		version: version,
+	}
+}
+
+// Enforce type.
+var _ KameletRepository = &fileKameletRepository{}
+
+func (c *fileKameletRepository) List(ctx context.Context) ([]string, error) {
+	entry, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.names(), nil
+}
+
+func (c *fileKameletRepository) Get(ctx context.Context, name string) (*v1.Kamelet, error) {
+	entry, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.lookup(name), nil
+}
+
+// load reads the Kamelets, unless the content has not changed since the last time it was read.
+func (c *fileKameletRepository) load() (kameletCacheEntry, error) {
+	revision, err := c.revision()
+	if err != nil {
+		return kameletCacheEntry{}, err
+	}
+
+	key := c.String()
+	if entry, ok := repositoryCache.get(key); ok && entry.revision == revision {
+		return entry, nil
+	}
+
+	var kamelets map[string]*v1.Kamelet
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return kameletCacheEntry{}, err
+	}
+	if info.IsDir() {
+		kamelets, err = c.loadDirectory()
+	} else {
+		kamelets, err = c.loadArchive()
+	}
+	if err != nil {
+		return kameletCacheEntry{}, err
+	}
+
+	entry := kameletCacheEntry{
+		revision: revision,
+		kamelets: kamelets,
+	}
+	repositoryCache.put(key, entry)
+
+	return entry, nil
+}
+
+// revision computes a marker which changes whenever the content of the repository changes.
+func (c *fileKameletRepository) revision() (string, error) {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return fmt.Sprintf("%d-%d", info.ModTime().UnixNano(), info.Size()), nil
+	}
+
+	dir := c.kameletsDir()
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	var latest int64
+	for _, file := range files {
+		// follow the symbolic links, as used by Kubernetes to project ConfigMap volumes
+		fi, err := os.Stat(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return "", err
+		}
+		latest = max(latest, fi.ModTime().UnixNano())
+	}
+
+	return strconv.FormatInt(latest, 10) + "-" + strconv.Itoa(len(files)), nil
+}
+
+func (c *fileKameletRepository) kameletsDir() string {
+	if c.version != "" {
+		return filepath.Join(c.path, c.version)
+	}
+
+	return c.path
+}
+
+func (c *fileKameletRepository) loadDirectory() (map[string]*v1.Kamelet, error) {
+	dir := c.kameletsDir()
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	kamelets := make(map[string]*v1.Kamelet)
+	for _, file := range files {
+		if file.IsDir() || !isKameletFileName(file.Name()) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		kamelet, err := parseKamelet(file.Name(), content)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse kamelet file %s: %w", file.Name(), err)
+		}
+		kamelets[kamelet.Name] = kamelet
+	}
+
+	return kamelets, nil
+}
+
+func (c *fileKameletRepository) loadArchive() (map[string]*v1.Kamelet, error) {
+	file, err := os.Open(c.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return extractKamelets(file, c.version)
+}
+
+func (c *fileKameletRepository) String() string {
+	return fmt.Sprintf("File[path=%s, version=%s]", c.path, c.version)
+}
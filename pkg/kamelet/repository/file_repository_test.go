@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKameletFile(name string) string {
+	return `apiVersion: camel.apache.org/v1
+kind: Kamelet
+metadata:
+  name: ` + name + `
+spec:
+  template:
+    from:
+      uri: timer:tick
+`
+}
+
+func testKameletArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+func TestFileRepositoryDirectory(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "my-source.kamelet.yaml"), []byte(testKameletFile("my-source")), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a kamelet"), 0o600))
+
+	repo := newFileKameletRepository(dir, "")
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-source"}, list)
+
+	kamelet, err := repo.Get(ctx, "my-source")
+	require.NoError(t, err)
+	require.NotNil(t, kamelet)
+	assert.Equal(t, "my-source", kamelet.Name)
+
+	kamelet, err = repo.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, kamelet)
+
+	// the repository content is refreshed when files change
+	next := filepath.Join(dir, "my-sink.kamelet.yaml")
+	require.NoError(t, os.WriteFile(next, []byte(testKameletFile("my-sink")), 0o600))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(next, future, future))
+	list, err = repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-sink", "my-source"}, list)
+}
+
+func TestFileRepositoryVersionedDirectory(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for _, version := range []string{"v1", "v2"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, version), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, version, "source-"+version+".kamelet.yaml"),
+			[]byte(testKameletFile("source-"+version)), 0o600))
+	}
+
+	list, err := newFileKameletRepository(dir, "v2").List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source-v2"}, list)
+
+	_, err = newFileKameletRepository(dir, "v3").List(ctx)
+	require.Error(t, err)
+}
+
+func TestFileRepositoryArchive(t *testing.T) {
+	ctx := context.Background()
+	archive := filepath.Join(t.TempDir(), "kamelets.tar.gz")
+	require.NoError(t, os.WriteFile(archive, testKameletArchive(t, map[string]string{
+		"kamelets/v1/source-v1.kamelet.yaml": testKameletFile("source-v1"),
+		"kamelets/v2/source-v2.kamelet.yaml": testKameletFile("source-v2"),
+		"kamelets/v2/README.md":              "not a kamelet",
+	}), 0o600))
+
+	list, err := newFileKameletRepository(archive, "").List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source-v1", "source-v2"}, list)
+
+	repo := newFileKameletRepository(archive, "v1")
+	list, err = repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source-v1"}, list)
+	kamelet, err := repo.Get(ctx, "source-v1")
+	require.NoError(t, err)
+	require.NotNil(t, kamelet)
+	require.NotNil(t, kamelet.Spec.Template)
+	assert.Contains(t, string(kamelet.Spec.Template.RawMessage), "timer:tick")
+}
+
+func TestFileRepositoryMissingPath(t *testing.T) {
+	_, err := newFileKameletRepository(filepath.Join(t.TempDir(), "missing"), "").List(context.Background())
+	require.Error(t, err)
+}
@@ -19,20 +19,16 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	neturl "net/url"
 	"os"
 	"sort"
-	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/google/go-github/v72/github"
 	"golang.org/x/oauth2"
-
-	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
 // Deprecated: to be removed in the future.
@@ -145,19 +141,8 @@ func downloadGithubKamelet(ctx context.Context, url string, httpClient *http.Cli
 	if err != nil {
 		return nil, err
 	}
-	if strings.HasSuffix(parsedURL.Path, ".yaml") || strings.HasSuffix(parsedURL.Path, ".yml") {
-		content, err = yaml.ToJSON(content)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	var kamelet v1.Kamelet
-	if err := json.Unmarshal(content, &kamelet); err != nil {
-		return nil, err
-	}
 
-	return &kamelet, nil
+	return parseKamelet(parsedURL.Path, content)
 }
 
 func (c *githubKameletRepository) String() string {
@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	ociKameletYAMLMediaType types.MediaType = "application/vnd.camel.kamelet.v1+yaml"
+	ociKameletJSONMediaType types.MediaType = "application/vnd.camel.kamelet.v1+json"
+)
+
+// PushOCI publishes the given Kamelet files, indexed by file name, as an OCI artifact that can be consumed
+// by an `oci:` repository. Each file is stored in its own layer, annotated with its file name.
+// The credentials are taken from the same environment variables used to pull, or from the Docker configuration.
+// It returns the digest reference of the pushed artifact.
+func PushOCI(ctx context.Context, ref string, files map[string][]byte) (string, error) {
+	ref = strings.TrimPrefix(ref, "oci:")
+	tag, err := name.NewTag(ref)
+	if err != nil {
+		return "", fmt.Errorf("expected format is oci:registry/repository[:tag], got: oci:%s: %w", ref, err)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("no kamelet to push to %s", ref)
+	}
+
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		if !isKameletFileName(fileName) {
+			return "", fmt.Errorf("%s is not a kamelet file name, expected one of the suffixes %s", fileName, strings.Join(fileSuffixes, ", "))
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	artifact := mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), types.OCIConfigJSON)
+	for _, fileName := range fileNames {
+		mediaType := ociKameletYAMLMediaType
+		if strings.HasSuffix(fileName, ".json") {
+			mediaType = ociKameletJSONMediaType
+		}
+		artifact, err = mutate.Append(artifact, mutate.Addendum{
+			Layer:     static.NewLayer(files[fileName], mediaType),
+			MediaType: mediaType,
+			Annotations: map[string]string{
+				ociTitleAnnotation: fileName,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := remote.Write(tag, artifact, remote.WithContext(ctx), ociAuthOption()); err != nil {
+		return "", fmt.Errorf("cannot push kamelets to %s: %w", ref, err)
+	}
+	digest, err := artifact.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return tag.Context().Digest(digest.String()).String(), nil
+}
+
+// ociAuthOption authenticates to the registry with the credentials of the environment variables, if any,
+// or else with the Docker configuration.
+func ociAuthOption() remote.Option {
+	if username := os.Getenv(ociUsernameEnvVar); username != "" {
+		return remote.WithAuth(&authn.Basic{Username: username, Password: os.Getenv(ociPasswordEnvVar)})
+	}
+
+	return remote.WithAuthFromKeychain(authn.DefaultKeychain)
+}
@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushOCI(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	ref := strings.TrimPrefix(server.URL, "http://") + "/camel/kamelets:v1"
+
+	digest, err := PushOCI(ctx, "oci:"+ref, map[string][]byte{
+		"my-source.kamelet.yaml": []byte(testKameletFile("my-source")),
+		"my-sink.kamelet.yaml":   []byte(testKameletFile("my-sink")),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, digest, "/camel/kamelets@sha256:")
+
+	for _, uri := range []string{"oci:" + ref, "oci:" + digest} {
+		repo, err := newFromURI(ctx, uri)
+		require.NoError(t, err)
+		list, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-sink", "my-source"}, list)
+	}
+}
+
+func TestPushOCIInvalidFileName(t *testing.T) {
+	_, err := PushOCI(context.Background(), "localhost:5000/camel/kamelets", map[string][]byte{
+		"my-source.yaml": []byte(testKameletFile("my-source")),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "my-source.yaml is not a kamelet file name")
+}
@@ -0,0 +1,204 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+const (
+	// ociTagResolutionPeriod is the period after which a tag is resolved again against the registry,
+	// to detect whether it has been moved to a different artifact.
+	ociTagResolutionPeriod = 5 * time.Minute
+	// ociTitleAnnotation is the annotation used by OCI artifacts (e.g., pushed with ORAS) to store the file name.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	// ociUsernameEnvVar and ociPasswordEnvVar can be used to provide the credentials of the registry.
+	ociUsernameEnvVar = "KAMELET_REPOSITORY_OCI_USERNAME"
+	ociPasswordEnvVar = "KAMELET_REPOSITORY_OCI_PASSWORD"
+)
+
+// ociKameletRepository reads the Kamelets packaged as an OCI artifact stored in a container registry.
+// Each layer of the artifact can either be a single Kamelet file (identified by the title annotation)
+// or a (possibly gzipped) tar archive containing Kamelet files.
+type ociKameletRepository struct {
+	// ref is either a tag or a digest reference
+	ref name.Reference
+}
+
+func newOCIKameletRepository(ref string) (KameletRepository, error) {
+	if slash := strings.Index(ref, "/"); slash <= 0 || slash == len(ref)-1 {
+		return nil, fmt.Errorf("expected format is oci:registry/repository[:tag|@digest], got: oci:%s", ref)
+	}
+	reference, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("expected format is oci:registry/repository[:tag|@digest], got: oci:%s: %w", ref, err)
+	}
+
+	return &ociKameletRepository{
+		ref: reference,
+	}, nil
+}
+
+// Enforce type.
+var _ KameletRepository = &ociKameletRepository{}
+
+func (c *ociKameletRepository) List(ctx context.Context) ([]string, error) {
+	entry, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.names(), nil
+}
+
+func (c *ociKameletRepository) Get(ctx context.Context, name string) (*v1.Kamelet, error) {
+	entry, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.lookup(name), nil
+}
+
+func (c *ociKameletRepository) isDigest() bool {
+	_, ok := c.ref.(name.Digest)
+
+	return ok
+}
+
+// load pulls the artifact, unless it has been already pulled. Artifacts referenced by digest are immutable,
+// whereas the ones referenced by tag are periodically resolved again.
+func (c *ociKameletRepository) load(ctx context.Context) (kameletCacheEntry, error) {
+	key := c.String()
+	now := time.Now()
+	cached, found := repositoryCache.get(key)
+	if found && !cached.expired(now) {
+		return cached, nil
+	}
+
+	descriptor, err := remote.Get(c.ref, remote.WithContext(ctx), ociAuthOption())
+	if err != nil {
+		return kameletCacheEntry{}, fmt.Errorf("cannot pull %s: %w", c.ref, err)
+	}
+
+	entry := kameletCacheEntry{
+		revision: descriptor.Digest.String(),
+	}
+	if !c.isDigest() {
+		entry.expiration = now.Add(ociTagResolutionPeriod)
+	}
+	if found && cached.revision == entry.revision {
+		entry.kamelets = cached.kamelets
+	} else {
+		entry.kamelets, err = c.fetchKamelets(ctx, descriptor)
+		if err != nil {
+			return kameletCacheEntry{}, err
+		}
+	}
+	repositoryCache.put(key, entry)
+
+	return entry, nil
+}
+
+func (c *ociKameletRepository) fetchKamelets(ctx context.Context, descriptor *remote.Descriptor) (map[string]*v1.Kamelet, error) {
+	image, err := descriptor.Image()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", c.ref, err)
+	}
+	manifest, err := image.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse manifest of %s: %w", c.ref, err)
+	}
+
+	kamelets := make(map[string]*v1.Kamelet)
+	for _, layer := range manifest.Layers {
+		title := layer.Annotations[ociTitleAnnotation]
+		isKamelet := isKameletFileName(title)
+		isArchive := strings.Contains(string(layer.MediaType), "tar")
+		if !isKamelet && !isArchive {
+			continue
+		}
+
+		if err := c.fetchLayer(ctx, layer.Digest.String(), func(r io.Reader) error {
+			if isKamelet {
+				content, err := io.ReadAll(r)
+				if err != nil {
+					return err
+				}
+				kamelet, err := parseKamelet(title, content)
+				if err != nil {
+					return fmt.Errorf("cannot parse kamelet file %s: %w", title, err)
+				}
+				kamelets[kamelet.Name] = kamelet
+
+				return nil
+			}
+
+			archived, err := extractKamelets(r, "")
+			if err != nil {
+				return err
+			}
+			for name, kamelet := range archived {
+				kamelets[name] = kamelet
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return kamelets, nil
+}
+
+// fetchLayer downloads the layer blob, whose content is verified against its digest while it's consumed.
+func (c *ociKameletRepository) fetchLayer(ctx context.Context, digest string, consumer func(io.Reader) error) error {
+	layer, err := remote.Layer(c.ref.Context().Digest(digest), remote.WithContext(ctx), ociAuthOption())
+	if err != nil {
+		return err
+	}
+	blob, err := layer.Compressed()
+	if err != nil {
+		return fmt.Errorf("cannot download layer %s of %s: %w", digest, c.ref, err)
+	}
+	defer blob.Close()
+
+	if err := consumer(blob); err != nil {
+		return err
+	}
+	// Drain the blob, so that its digest is verified even when the consumer stops reading early
+	if _, err := io.Copy(io.Discard, blob); err != nil {
+		return fmt.Errorf("cannot download layer %s of %s: %w", digest, c.ref, err)
+	}
+
+	return nil
+}
+
+func (c *ociKameletRepository) String() string {
+	return fmt.Sprintf("OCI[registry=%s, repository=%s, reference=%s]",
+		c.ref.Context().RegistryStr(), c.ref.Context().RepositoryStr(), c.ref.Identifier())
+}
@@ -0,0 +1,200 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	ocispec "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistry serves an artifact made of a single Kamelet layer and of a tar.gz layer, protected by a bearer token.
+type testRegistry struct {
+	server         *httptest.Server
+	manifest       []byte
+	blobs          map[string][]byte
+	manifestPulls  atomic.Int32
+	blobPulls      atomic.Int32
+	tokenRequested atomic.Int32
+	// basicAuth, when set, is the expected basic authorization, instead of the bearer token
+	basicAuth string
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	t.Helper()
+
+	r := &testRegistry{
+		blobs: make(map[string][]byte),
+	}
+	single := []byte(testKameletFile("single-source"))
+	archive := testKameletArchive(t, map[string]string{
+		"archived-sink.kamelet.yaml": testKameletFile("archived-sink"),
+	})
+	manifest := ocispec.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Config:        r.addBlob(types.OCIConfigJSON, []byte("{}"), nil),
+		Layers: []ocispec.Descriptor{
+			r.addBlob("application/vnd.camel.kamelet.v1+yaml", single, map[string]string{
+				ociTitleAnnotation: "single-source.kamelet.yaml",
+			}),
+			r.addBlob(types.OCILayer, archive, nil),
+			r.addBlob(types.OCIConfigJSON, []byte("{}"), nil),
+		},
+	}
+	var err error
+	r.manifest, err = json.Marshal(manifest)
+	require.NoError(t, err)
+
+	r.server = httptest.NewServer(http.HandlerFunc(r.serve))
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+func (r *testRegistry) addBlob(mediaType types.MediaType, content []byte, annotations map[string]string) ocispec.Descriptor {
+	digest, size, _ := ocispec.SHA256(bytes.NewReader(content))
+	r.blobs[digest.String()] = content
+
+	return ocispec.Descriptor{
+		MediaType:   mediaType,
+		Digest:      digest,
+		Size:        size,
+		Annotations: annotations,
+	}
+}
+
+func (r *testRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		r.tokenRequested.Add(1)
+		_, _ = w.Write([]byte(`{"token":"secret"}`))
+
+		return
+	}
+	if r.basicAuth != "" {
+		if req.Header.Get("Authorization") != r.basicAuth {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+	} else if req.Header.Get("Authorization") != "Bearer secret" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="`+r.server.URL+`/token",service="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	switch {
+	case req.URL.Path == "/v2/camel/kamelets/manifests/v1":
+		r.manifestPulls.Add(1)
+		w.Header().Set("Content-Type", string(types.OCIManifestSchema1))
+		_, _ = w.Write(r.manifest)
+	case strings.HasPrefix(req.URL.Path, "/v2/camel/kamelets/blobs/"):
+		r.blobPulls.Add(1)
+		blob, ok := r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/camel/kamelets/blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		_, _ = w.Write(blob)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *testRegistry) repository(t *testing.T, ref string) KameletRepository {
+	t.Helper()
+
+	repo, err := newFromURI(context.Background(), "oci:"+strings.TrimPrefix(r.server.URL, "http://")+"/"+ref)
+	require.NoError(t, err)
+
+	return repo
+}
+
+func TestOCIRepository(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+	repo := registry.repository(t, "camel/kamelets:v1")
+
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archived-sink", "single-source"}, list)
+
+	kamelet, err := repo.Get(ctx, "archived-sink")
+	require.NoError(t, err)
+	require.NotNil(t, kamelet)
+	assert.Equal(t, "archived-sink", kamelet.Name)
+
+	kamelet, err = repo.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, kamelet)
+
+	// the artifact is pulled only once, the config layer is ignored
+	assert.Equal(t, int32(1), registry.manifestPulls.Load())
+	assert.Equal(t, int32(2), registry.blobPulls.Load())
+	assert.Positive(t, registry.tokenRequested.Load())
+}
+
+func TestOCIRepositoryNotFound(t *testing.T) {
+	registry := newTestRegistry(t)
+	_, err := registry.repository(t, "camel/kamelets:v2").List(context.Background())
+	require.Error(t, err)
+}
+
+func TestOCIRepositoryDockerConfigCredentials(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.basicAuth = "Basic " + base64.StdEncoding.EncodeToString([]byte("camel:secret"))
+	dir := t.TempDir()
+	config := fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`,
+		strings.TrimPrefix(registry.server.URL, "http://"), base64.StdEncoding.EncodeToString([]byte("camel:secret")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(config), 0o600))
+	t.Setenv("DOCKER_CONFIG", dir)
+
+	list, err := registry.repository(t, "camel/kamelets:v1").List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archived-sink", "single-source"}, list)
+}
+
+func TestOCIRepositoryInvalidLayerDigest(t *testing.T) {
+	registry := newTestRegistry(t)
+	for digest, blob := range registry.blobs {
+		if bytes.Contains(blob, []byte("single-source")) {
+			registry.blobs[digest] = bytes.ReplaceAll(blob, []byte("single-source"), []byte("altered-source"))
+		}
+	}
+
+	_, err := registry.repository(t, "camel/kamelets:v1").List(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error verifying sha256 checksum")
+}
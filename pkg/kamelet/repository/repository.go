@@ -108,6 +108,22 @@ func newFromURI(ctx context.Context, uri string) (KameletRepository, error) {
 		}
 
 		return newGithubKameletRepository(ctx, owner, repo, path, version), nil
+	} else if after, ok := strings.CutPrefix(uri, "file:"); ok {
+		path := after
+		var version string
+		// The version is looked up in the last path element only, as the directories may contain '@'
+		name := strings.LastIndex(path, "/") + 1
+		if pos := strings.LastIndex(path[name:], "@"); pos >= 0 {
+			version = path[name+pos+1:]
+			path = path[0 : name+pos]
+		}
+		if path == "" {
+			return nil, fmt.Errorf("expected format is file:path[@version], got: %s", uri)
+		}
+
+		return newFileKameletRepository(path, version), nil
+	} else if after, ok := strings.CutPrefix(uri, "oci:"); ok {
+		return newOCIKameletRepository(after)
 	}
 
 	return nil, fmt.Errorf("invalid uri: %s", uri)
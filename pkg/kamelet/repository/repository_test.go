@@ -24,6 +24,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client/camel/clientset/versioned/fake"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -97,6 +98,58 @@ func TestURIParse(t *testing.T) {
 			uri:        "none",
 			repository: &emptyKameletRepository{},
 		},
+		{
+			uri: "file:/etc/kamelets",
+			repository: &fileKameletRepository{
+				path: "/etc/kamelets",
+			},
+		},
+		{
+			uri: "file:/etc/kamelets.tar.gz@v1.2.3",
+			repository: &fileKameletRepository{
+				path:    "/etc/kamelets.tar.gz",
+				version: "v1.2.3",
+			},
+		},
+		{
+			uri: "file:/home/user@example.com/kamelets",
+			repository: &fileKameletRepository{
+				path: "/home/user@example.com/kamelets",
+			},
+		},
+		{
+			uri: "file:/home/user@example.com/kamelets.tar.gz@v1.2.3",
+			repository: &fileKameletRepository{
+				path:    "/home/user@example.com/kamelets.tar.gz",
+				version: "v1.2.3",
+			},
+		},
+		{
+			uri:   "file:",
+			error: true,
+		},
+		{
+			uri: "oci:registry.internal:5000/camel/kamelets",
+			repository: &ociKameletRepository{
+				ref: name.MustParseReference("registry.internal:5000/camel/kamelets:latest"),
+			},
+		},
+		{
+			uri: "oci:registry.internal/camel/kamelets:v1.2.3",
+			repository: &ociKameletRepository{
+				ref: name.MustParseReference("registry.internal/camel/kamelets:v1.2.3"),
+			},
+		},
+		{
+			uri: "oci:localhost:5000/kamelets:v1.2.3@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			repository: &ociKameletRepository{
+				ref: name.MustParseReference("localhost:5000/kamelets@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+			},
+		},
+		{
+			uri:   "oci:kamelets",
+			error: true,
+		},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d-%s", i, test.uri), func(t *testing.T) {
@@ -116,6 +169,16 @@ func TestURIParse(t *testing.T) {
 				case *emptyKameletRepository:
 					_, ok := catalog.(*emptyKameletRepository)
 					assert.True(t, ok)
+				case *fileKameletRepository:
+					fc, ok := catalog.(*fileKameletRepository)
+					assert.True(t, ok)
+					assert.Equal(t, r.path, fc.path)
+					assert.Equal(t, r.version, fc.version)
+				case *ociKameletRepository:
+					oc, ok := catalog.(*ociKameletRepository)
+					assert.True(t, ok)
+					assert.Equal(t, r.ref.Name(), oc.ref.Name())
+					assert.Equal(t, r.ref.Context().Scheme(), oc.ref.Context().Scheme())
 				default:
 					t.Fatal("missing case")
 				}
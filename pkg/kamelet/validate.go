@@ -0,0 +1,213 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
+	"github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+// Severity is the severity of a validation issue.
+type Severity string
+
+const (
+	// SeverityError is an issue making the Kamelet invalid.
+	SeverityError Severity = "error"
+	// SeverityWarning is an issue likely making the Kamelet fail at runtime.
+	SeverityWarning Severity = "warning"
+	// SeverityNote is a deviation from the Kamelet conventions.
+	SeverityNote Severity = "note"
+)
+
+const (
+	// ReasonInvalidType is reported for a missing, or invalid, Kamelet type label.
+	ReasonInvalidType = "InvalidType"
+	// ReasonUndeclaredProperty is reported for a template placeholder not declared by the definition.
+	ReasonUndeclaredProperty = "UndeclaredProperty"
+	// ReasonUnknownComponent is reported for an endpoint whose component is not in the Camel catalog.
+	ReasonUnknownComponent = "UnknownComponent"
+)
+
+var (
+	// jsonSchemaTypes are the types a Kamelet property can declare.
+	jsonSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+	// placeholder matches the Kamelet property placeholders, i.e. {{name}}, {{?name}} or {{name:default}}.
+	placeholder = regexp.MustCompile(`\{\{\??([^{}:]+)(?::[^{}]*)?\}\}`)
+)
+
+// Issue is a problem found by the validation of a Kamelet.
+type Issue struct {
+	Severity Severity
+	// Reason is either a reason of the Kamelet Ready condition, or one of the reasons of this package.
+	Reason string
+	// Version is the Kamelet version the issue is found in, empty for the main spec.
+	Version string
+	Message string
+}
+
+// Validate checks the name, the type, and the spec of the Kamelet and all its versions
+// against the Camel catalog.
+func Validate(catalog *camel.RuntimeCatalog, kamelet *v1.Kamelet) []Issue {
+	var issues []Issue
+	add := func(severity Severity, reason, format string, args ...any) {
+		issues = append(issues, Issue{Severity: severity, Reason: reason, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if errs := validation.IsDNS1123Subdomain(kamelet.Name); len(errs) > 0 {
+		add(SeverityError, v1.KameletConditionReasonInvalidName, "invalid kamelet name %q: %s", kamelet.Name, strings.Join(errs, ", "))
+	} else if !v1.ValidKameletName(kamelet.Name) {
+		add(SeverityError, v1.KameletConditionReasonInvalidName, "kamelet name %q is reserved", kamelet.Name)
+	}
+
+	kameletType := kamelet.Labels[v1.KameletTypeLabel]
+	switch kameletType {
+	case v1.KameletTypeSource, v1.KameletTypeSink, v1.KameletTypeAction:
+		if !strings.HasSuffix(kamelet.Name, "-"+kameletType) {
+			add(SeverityNote, ReasonInvalidType,
+				"kamelet name %q does not end with -%s, as recommended for %s kamelets", kamelet.Name, kameletType, kameletType)
+		}
+	case "":
+		add(SeverityWarning, ReasonInvalidType, "missing label %s", v1.KameletTypeLabel)
+	default:
+		add(SeverityError, ReasonInvalidType, "invalid label %s=%s, expected one of: source|sink|action", v1.KameletTypeLabel, kameletType)
+	}
+
+	issues = append(issues, ValidateSpec(catalog, kamelet.Name, kameletType, kamelet.Spec.KameletSpecBase)...)
+	for _, version := range util.SortedMapKeys(kamelet.Spec.Versions) {
+		for _, issue := range ValidateSpec(catalog, kamelet.Name, kameletType, kamelet.Spec.Versions[version]) {
+			issue.Version = version
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// ValidateSpec checks the definition, the template or sources, the data types and the dependencies of a Kamelet spec.
+func ValidateSpec(catalog *camel.RuntimeCatalog, name, kameletType string, spec v1.KameletSpecBase) []Issue {
+	var issues []Issue
+	add := func(severity Severity, reason, format string, args ...any) {
+		issues = append(issues, Issue{Severity: severity, Reason: reason, Message: fmt.Sprintf(format, args...)})
+	}
+
+	properties := map[string]v1.JSONSchemaProp{}
+	if spec.Definition != nil {
+		properties = spec.Definition.Properties
+		for _, property := range spec.Definition.Required {
+			if _, ok := properties[property]; !ok {
+				add(SeverityError, v1.KameletConditionReasonInvalidProperty, "required property %q is not declared by the definition", property)
+			}
+		}
+		for _, property := range util.SortedMapKeys(properties) {
+			if t := properties[property].Type; t != "" && !slices.Contains(jsonSchemaTypes, t) {
+				add(SeverityError, v1.KameletConditionReasonInvalidProperty, "property %q has an invalid type %q", property, t)
+			}
+		}
+	}
+
+	if spec.Template == nil && len(spec.Sources) == 0 {
+		add(SeverityError, v1.KameletConditionReasonInvalidTemplate, "kamelet has neither a template nor sources")
+	}
+	if spec.Template != nil {
+		for _, match := range placeholder.FindAllStringSubmatch(string(spec.Template.RawMessage), -1) {
+			property := strings.TrimSpace(match[1])
+			// dotted placeholders reference global properties
+			if strings.Contains(property, ".") {
+				continue
+			}
+			if _, ok := properties[property]; !ok {
+				add(SeverityWarning, ReasonUndeclaredProperty, "property %q is used by the template but is not declared by the definition", property)
+			}
+		}
+
+		meta := source.NewMetadata()
+		flow, err := dsl.TemplateToYamlDSL(*spec.Template, name)
+		if err == nil {
+			src := v1.NewSourceSpec(name+".yaml", string(flow), v1.LanguageYaml)
+			err = source.InspectorForLanguage(catalog, v1.LanguageYaml).Extract(src, &meta)
+		}
+		if err != nil {
+			add(SeverityError, v1.KameletConditionReasonInvalidTemplate, "invalid template: %s", err.Error())
+		} else {
+			for _, uri := range slices.Concat(meta.FromURIs, meta.ToURIs) {
+				if !catalog.IsResolvable(uri) {
+					continue
+				}
+				if artifact, _ := catalog.DecodeComponent(uri); artifact == nil {
+					add(SeverityWarning, ReasonUnknownComponent,
+						"component for endpoint %q cannot be found in the Camel catalog %s", uri, catalog.GetRuntimeVersion())
+				}
+			}
+			switch kameletType {
+			case v1.KameletTypeSource:
+				if !slices.Contains(meta.ToURIs, "kamelet:sink") {
+					add(SeverityWarning, v1.KameletConditionReasonInvalidTemplate, "source kamelet does not send to kamelet:sink")
+				}
+			case v1.KameletTypeSink, v1.KameletTypeAction:
+				if !slices.Contains(meta.FromURIs, "kamelet:source") {
+					add(SeverityError, v1.KameletConditionReasonInvalidTemplate, "%s kamelet does not consume from kamelet:source", kameletType)
+				}
+			}
+		}
+	}
+	for _, src := range spec.Sources {
+		if src.Content == "" {
+			// sources stored in a ConfigMap are only resolved at runtime
+			continue
+		}
+		meta := source.NewMetadata()
+		if err := source.InspectorForLanguage(catalog, src.InferLanguage()).Extract(src, &meta); err != nil {
+			add(SeverityError, v1.KameletConditionReasonInvalidTemplate, "invalid source %s: %s", src.Name, err.Error())
+		}
+	}
+
+	for _, slot := range util.SortedMapKeys(spec.DataTypes) {
+		if slot != v1.TypeSlotIn && slot != v1.TypeSlotOut && slot != v1.TypeSlotError {
+			add(SeverityError, v1.KameletConditionReasonInvalidDataType, "invalid data type slot %q, expected one of: in|out|error", slot)
+
+			continue
+		}
+		dataTypes := spec.DataTypes[slot]
+		if dataTypes.Default != "" && len(dataTypes.Types) > 0 {
+			if _, ok := dataTypes.Types[dataTypes.Default]; !ok {
+				add(SeverityError, v1.KameletConditionReasonInvalidDataType, "default %s data type %q is not declared", slot, dataTypes.Default)
+			}
+		}
+		for _, format := range util.SortedMapKeys(dataTypes.Types) {
+			if err := camel.ValidateDependenciesE(catalog, dataTypes.Types[format].Dependencies); err != nil {
+				add(SeverityError, v1.KameletConditionReasonInvalidDependency, "%s data type %q: %s", slot, format, err.Error())
+			}
+		}
+	}
+
+	if err := camel.ValidateDependenciesE(catalog, spec.Dependencies); err != nil {
+		add(SeverityError, v1.KameletConditionReasonInvalidDependency, "%s", err.Error())
+	}
+
+	return issues
+}
@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestValidate(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	kamelet := v1.NewKamelet("ns", "my-sink")
+	kamelet.Labels = map[string]string{v1.KameletTypeLabel: v1.KameletTypeSink}
+	kamelet.Spec.Definition = &v1.JSONSchemaProps{
+		Required: []string{"topic"},
+		Properties: map[string]v1.JSONSchemaProp{
+			"count": {Type: "int"},
+		},
+	}
+	kamelet.Spec.Template = &v1.Template{
+		RawMessage: []byte(`{"from":{"uri":"timer:tick","steps":[{"to":"log:{{loggerName}}"}]}}`),
+	}
+	kamelet.Spec.Dependencies = []string{"camel:not-a-component"}
+	kamelet.Spec.Versions = map[string]v1.KameletSpecBase{
+		"v1": {},
+	}
+
+	assert.ElementsMatch(t, []Issue{
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidProperty,
+			Message:  `required property "topic" is not declared by the definition`,
+		},
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidProperty,
+			Message:  `property "count" has an invalid type "int"`,
+		},
+		{
+			Severity: SeverityWarning,
+			Reason:   ReasonUndeclaredProperty,
+			Message:  `property "loggerName" is used by the template but is not declared by the definition`,
+		},
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidTemplate,
+			Message:  "sink kamelet does not consume from kamelet:source",
+		},
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidDependency,
+			Message:  "dependency camel:not-a-component not found in Camel catalog",
+		},
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidTemplate,
+			Version:  "v1",
+			Message:  "kamelet has neither a template nor sources",
+		},
+	}, Validate(catalog, &kamelet))
+}
+
+func TestValidateNameAndType(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	kamelet := v1.NewKamelet("ns", "source")
+	kamelet.Labels = map[string]string{v1.KameletTypeLabel: "processor"}
+	kamelet.Spec.Template = &v1.Template{
+		RawMessage: []byte(`{"from":{"uri":"kamelet:source","steps":[{"to":"kamelet:sink"}]}}`),
+	}
+
+	assert.Equal(t, []Issue{
+		{
+			Severity: SeverityError,
+			Reason:   v1.KameletConditionReasonInvalidName,
+			Message:  `kamelet name "source" is reserved`,
+		},
+		{
+			Severity: SeverityError,
+			Reason:   ReasonInvalidType,
+			Message:  "invalid label camel.apache.org/kamelet.type=processor, expected one of: source|sink|action",
+		},
+	}, Validate(catalog, &kamelet))
+
+	kamelet.Name = "my-source"
+	kamelet.Labels = nil
+	assert.Equal(t, []Issue{
+		{
+			Severity: SeverityWarning,
+			Reason:   ReasonInvalidType,
+			Message:  "missing label camel.apache.org/kamelet.type",
+		},
+	}, Validate(catalog, &kamelet))
+}
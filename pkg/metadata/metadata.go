@@ -57,6 +57,10 @@ func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 	k = append(k, m1.Kamelets...)
 	k = append(k, m2.Kamelets...)
 
+	h := make([]src.HTTPEndpoint, 0, len(m1.HTTPEndpoints)+len(m2.HTTPEndpoints))
+	h = append(h, m1.HTTPEndpoints...)
+	h = append(h, m2.HTTPEndpoints...)
+
 	return src.Metadata{
 		FromURIs:             f,
 		ToURIs:               t,
@@ -65,6 +69,7 @@ func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 		ExposesHTTPServices:  m1.ExposesHTTPServices || m2.ExposesHTTPServices,
 		PassiveEndpoints:     m1.PassiveEndpoints && m2.PassiveEndpoints,
 		Kamelets:             k,
+		HTTPEndpoints:        h,
 	}
 }
 
@@ -274,6 +274,11 @@ func applyPlatformSpec(source *v1.IntegrationPlatform, target *v1.IntegrationPla
 		source.Status.Build.Registry.DeepCopyInto(&target.Status.Build.Registry)
 	}
 
+	if target.Status.Build.Signing == nil && source.Status.Build.Signing != nil {
+		log.Debugf("Integration Platform %s [%s]: setting image signing", target.Name, target.Namespace)
+		target.Status.Build.Signing = source.Status.Build.Signing.DeepCopy()
+	}
+
 	if err := target.Status.Traits.Merge(source.Status.Traits); err != nil {
 		log.Errorf(err, "Integration Platform %s [%s]: failed to merge traits", target.Name, target.Namespace)
 	} else if err := target.Status.Traits.Merge(target.Spec.Traits); err != nil {
@@ -48,6 +48,7 @@ type Platform struct {
 	BuildBaseImage       string
 	PublishStrategy      v1.IntegrationPlatformBuildPublishStrategy
 	Registry             v1.RegistrySpec
+	Signing              *v1.ImageSigningSpec
 	Maven                v1.MavenBuildSpec
 	MaxRunningBuilds     int32
 }
@@ -76,6 +77,7 @@ func getEnvPlatform() Platform {
 		BuildBaseImage:  GetEnvOrDefault("BUILD_BASE_IMAGE", defaults.BaseImage()),
 		PublishStrategy: publishStrategy(),
 		Registry:        registry,
+		Signing:         imageSigning(),
 		Maven: v1.MavenBuildSpec{
 			MavenSpec:    mavenSpec(),
 			Repositories: repositories(),
@@ -277,6 +279,26 @@ func registry() v1.RegistrySpec {
 	return registry
 }
 
+func imageSigning() *v1.ImageSigningSpec {
+	secret := GetEnvOrDefault("IMAGE_SIGNING_SECRET", "")
+	if secret == "" {
+		return nil
+	}
+	signing := v1.ImageSigningSpec{
+		Secret: secret,
+	}
+	if env, ok := os.LookupEnv("IMAGE_SIGNING_VERIFY"); ok {
+		verify, err := strconv.ParseBool(env)
+		if err != nil {
+			log.Error(err, "could not parse IMAGE_SIGNING_VERIFY environment variable, fallback to true")
+			verify = true
+		}
+		signing.Verify = &verify
+	}
+
+	return &signing
+}
+
 func repositories() []v1.Repository {
 	csvRepos := GetEnvOrDefault("MAVEN_REPOSITORIES", "")
 	if csvRepos != "" {
@@ -394,6 +416,7 @@ func FromIntegrationPlatform(itp *v1.IntegrationPlatform) Platform {
 		BuildBaseImage:      itp.Status.Build.BaseImage,
 		PublishStrategy:     itp.Status.Build.PublishStrategy,
 		Registry:            itp.Status.Build.Registry,
+		Signing:             itp.Status.Build.Signing,
 		Maven: v1.MavenBuildSpec{
 			MavenSpec: itp.Status.Build.Maven,
 		},
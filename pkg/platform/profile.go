@@ -59,3 +59,18 @@ func findIntegrationProfile(ctx context.Context, c k8sclient.Reader, o k8sclient
 
 	return nil, nil
 }
+
+// IsIntegrationProfileReferenced returns true if the given resource uses the given profile, honoring the same
+// resolution rules (resource namespace first, operator namespace as a fallback) used when applying the profile.
+func IsIntegrationProfileReferenced(ctx context.Context, c k8sclient.Reader, o k8sclient.Object, profile *v1.IntegrationProfile) bool {
+	if v1.GetIntegrationProfileAnnotation(o) != profile.Name {
+		return false
+	}
+
+	resolved, err := findIntegrationProfile(ctx, c, o)
+	if err != nil || resolved == nil {
+		return false
+	}
+
+	return resolved.Namespace == profile.Namespace && resolved.Name == profile.Name
+}
@@ -119,3 +119,37 @@ func TestFindIntegrationProfileInOperatorNamespace(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, found)
 }
+
+func TestIsIntegrationProfileReferenced(t *testing.T) {
+	profile := v1.IntegrationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "custom",
+			Namespace: "ns",
+		},
+	}
+	otherProfile := v1.IntegrationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "custom",
+			Namespace: "other",
+		},
+	}
+
+	c, err := internal.NewFakeClient(&profile, &otherProfile)
+	require.NoError(t, err)
+
+	integration := v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			Annotations: map[string]string{
+				v1.IntegrationProfileAnnotation: "custom",
+			},
+		},
+	}
+
+	assert.True(t, IsIntegrationProfileReferenced(context.TODO(), c, &integration, &profile))
+	assert.False(t, IsIntegrationProfileReferenced(context.TODO(), c, &integration, &otherProfile))
+
+	integration.Annotations[v1.IntegrationProfileAnnotation] = "another"
+	assert.False(t, IsIntegrationProfileReferenced(context.TODO(), c, &integration, &profile))
+}
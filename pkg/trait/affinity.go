@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
@@ -37,6 +38,10 @@ import (
 const (
 	affinityTraitID    = "affinity"
 	affinityTraitOrder = 1500
+
+	defaultAffinityMaxSkew = int32(1)
+	// The label set on the Pods of a Deployment, which identifies its revision.
+	podTemplateHashLabel = "pod-template-hash"
 )
 
 type affinityTrait struct {
@@ -58,8 +63,40 @@ func (t *affinityTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 	if ptr.Deref(t.PodAffinity, false) && ptr.Deref(t.PodAntiAffinity, false) {
 		return false, nil, errors.New("both pod affinity and pod anti-affinity can't be set simultaneously")
 	}
+	if t.MaxSkew != nil && *t.MaxSkew < 1 {
+		return false, nil, fmt.Errorf("max skew must be greater than zero: %d", *t.MaxSkew)
+	}
+	if t.WhenUnsatisfiable != "" && t.WhenUnsatisfiable != corev1.DoNotSchedule && t.WhenUnsatisfiable != corev1.ScheduleAnyway {
+		return false, nil, fmt.Errorf("unsupported when unsatisfiable action: %s", t.WhenUnsatisfiable)
+	}
+
+	return e.IntegrationInRunningPhases(), t.pdbCondition(e), nil
+}
+
+// pdbCondition warns when the disruption budget of the pdb trait allows more pods to be unavailable
+// than the spread constraints tolerate.
+func (t *affinityTrait) pdbCondition(e *Environment) *TraitCondition {
+	maxSkew, spread := t.spreadMaxSkew()
+	if !spread || e.Catalog == nil {
+		return nil
+	}
+	pdb, ok := e.Catalog.GetTrait(pdbTraitID).(*pdbTrait)
+	if !ok || !ptr.Deref(pdb.Enabled, false) || pdb.MaxUnavailable == "" {
+		return nil
+	}
+	maxUnavailable := intstr.Parse(pdb.MaxUnavailable)
+	if maxUnavailable.Type != intstr.Int || maxUnavailable.IntVal <= maxSkew {
+		return nil
+	}
 
-	return e.IntegrationInRunningPhases(), nil, nil
+	return NewIntegrationCondition(
+		affinityTraitID,
+		v1.IntegrationConditionTraitInfo,
+		corev1.ConditionTrue,
+		TraitConfigurationReason,
+		fmt.Sprintf("the pdb max unavailable (%d) is greater than the spread max skew (%d): "+
+			"a disruption may unbalance the pods more than the spread allows", maxUnavailable.IntVal, maxSkew),
+	)
 }
 
 func (t *affinityTrait) Apply(e *Environment) error {
@@ -80,10 +117,61 @@ func (t *affinityTrait) Apply(e *Environment) error {
 	if err := t.addPodAntiAffinity(e, podSpec); err != nil {
 		return err
 	}
+	t.addTopologySpreadConstraints(e, podSpec)
+	if t.PriorityClassName != "" {
+		podSpec.PriorityClassName = t.PriorityClassName
+	}
 
 	return nil
 }
 
+// spreadMaxSkew returns the maximum skew of the spread constraints, if the trait spreads the pods.
+func (t *affinityTrait) spreadMaxSkew() (int32, bool) {
+	if !ptr.Deref(t.Enabled, false) || (!ptr.Deref(t.ZoneSpread, false) && !ptr.Deref(t.HostSpread, false)) {
+		return 0, false
+	}
+
+	return ptr.Deref(t.MaxSkew, defaultAffinityMaxSkew), true
+}
+
+func (t *affinityTrait) addTopologySpreadConstraints(e *Environment, podSpec *corev1.PodSpec) {
+	maxSkew, spread := t.spreadMaxSkew()
+	if !spread {
+		return
+	}
+
+	topologyKeys := make([]string, 0, 2)
+	if ptr.Deref(t.ZoneSpread, false) {
+		topologyKeys = append(topologyKeys, corev1.LabelTopologyZone)
+	}
+	if ptr.Deref(t.HostSpread, false) {
+		topologyKeys = append(topologyKeys, corev1.LabelHostname)
+	}
+	whenUnsatisfiable := t.WhenUnsatisfiable
+	if whenUnsatisfiable == "" {
+		whenUnsatisfiable = corev1.DoNotSchedule
+	}
+	var matchLabelKeys []string
+	// Only the Pods of the same revision are taken into account while a Deployment rolls out
+	if e.Resources.GetDeploymentForIntegration(e.Integration) != nil {
+		matchLabelKeys = []string{podTemplateHashLabel}
+	}
+
+	for _, topologyKey := range topologyKeys {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			MatchLabelKeys: matchLabelKeys,
+		})
+	}
+}
+
 func (t *affinityTrait) addNodeAffinity(_ *Environment, podSpec *corev1.PodSpec) error {
 	t.filterNodeAffinityLabels()
 	if len(t.NodeAffinityLabels) == 0 {
@@ -257,3 +257,104 @@ func TestApplyNodeAffinityLabelsWithAllowList(t *testing.T) {
 	assert.Len(t, terms, 1)
 	assert.Equal(t, "kubernetes.io/hostname", terms[0].Key)
 }
+
+func TestConfigureAffinityTraitWithInvalidMaxSkewFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ZoneSpread = ptr.To(true)
+	affinityTrait.MaxSkew = ptr.To(int32(0))
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, condition, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, condition)
+	require.Error(t, err)
+}
+
+func TestConfigureAffinityTraitWithInvalidWhenUnsatisfiableFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.HostSpread = ptr.To(true)
+	affinityTrait.WhenUnsatisfiable = "Whatever"
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, condition, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, condition)
+	require.Error(t, err)
+}
+
+func TestApplyTopologySpreadConstraintsOnDeployment(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ZoneSpread = ptr.To(true)
+	affinityTrait.HostSpread = ptr.To(true)
+	affinityTrait.MaxSkew = ptr.To(int32(2))
+	affinityTrait.WhenUnsatisfiable = corev1.ScheduleAnyway
+	affinityTrait.PriorityClassName = "high-priority"
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	deployment.Labels = map[string]string{v1.IntegrationLabel: "integration-name"}
+	err := affinityTrait.Apply(environment)
+
+	require.NoError(t, err)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Equal(t, "high-priority", podSpec.PriorityClassName)
+	require.Len(t, podSpec.TopologySpreadConstraints, 2)
+	assert.Equal(t, corev1.LabelTopologyZone, podSpec.TopologySpreadConstraints[0].TopologyKey)
+	assert.Equal(t, corev1.LabelHostname, podSpec.TopologySpreadConstraints[1].TopologyKey)
+	for _, constraint := range podSpec.TopologySpreadConstraints {
+		assert.Equal(t, int32(2), constraint.MaxSkew)
+		assert.Equal(t, corev1.ScheduleAnyway, constraint.WhenUnsatisfiable)
+		assert.Equal(t, "integration-name", constraint.LabelSelector.MatchLabels[v1.IntegrationLabel])
+		assert.Equal(t, []string{"pod-template-hash"}, constraint.MatchLabelKeys)
+	}
+}
+
+func TestApplyTopologySpreadConstraintsOnKnativeService(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ZoneSpread = ptr.To(true)
+
+	environment, knativeService := createNominalKnativeServiceTraitTest()
+	err := affinityTrait.Apply(environment)
+
+	require.NoError(t, err)
+	constraints := knativeService.Spec.Template.Spec.TopologySpreadConstraints
+	require.Len(t, constraints, 1)
+	assert.Equal(t, corev1.LabelTopologyZone, constraints[0].TopologyKey)
+	assert.Equal(t, int32(1), constraints[0].MaxSkew)
+	assert.Equal(t, corev1.DoNotSchedule, constraints[0].WhenUnsatisfiable)
+	assert.Empty(t, constraints[0].MatchLabelKeys)
+}
+
+func TestApplyAffinityWithoutSpread(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.MaxSkew = ptr.To(int32(3))
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	err := affinityTrait.Apply(environment)
+
+	require.NoError(t, err)
+	assert.Empty(t, deployment.Spec.Template.Spec.TopologySpreadConstraints)
+	assert.Empty(t, deployment.Spec.Template.Spec.PriorityClassName)
+}
+
+func TestConfigureAffinityTraitWithInconsistentPdb(t *testing.T) {
+	environment, _ := createNominalDeploymentTraitTest()
+	environment.Catalog = NewCatalog(nil)
+	pdb, _ := environment.Catalog.GetTrait(pdbTraitID).(*pdbTrait)
+	pdb.Enabled = ptr.To(true)
+	pdb.MaxUnavailable = "3"
+
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ZoneSpread = ptr.To(true)
+	affinityTrait.MaxSkew = ptr.To(int32(2))
+	configured, condition, err := affinityTrait.Configure(environment)
+
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "the pdb max unavailable (3) is greater than the spread max skew (2)")
+
+	pdb.MaxUnavailable = "2"
+	_, condition, err = affinityTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+}
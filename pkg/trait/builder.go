@@ -297,6 +297,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 				BaseImage: t.getBaseImage(e),
 				Image:     imageName,
 				Registry:  e.Platform.Registry,
+				Signing:   e.Platform.Signing.DeepCopy(),
 			},
 		}}
 		if t.ImagePlatforms != nil {
@@ -313,6 +314,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 			PublishTask: v1.PublishTask{
 				BaseImage: t.getBaseImage(e),
 				Image:     imageName,
+				Signing:   e.Platform.Signing.DeepCopy(),
 			},
 			Tag: tag,
 		}})
@@ -134,6 +134,10 @@ func (t *containerTrait) configureImageIntegrationKit(e *Environment) error {
 			e.Integration.Spec.IntegrationKit)
 	}
 
+	if e.Integration.Status.Image != t.Image {
+		// the verified image refers to the former image
+		e.Integration.Status.VerifiedImage = ""
+	}
 	e.Integration.Status.Image = t.Image
 
 	return nil
@@ -143,9 +147,14 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	if e.ApplicationProperties == nil {
 		e.ApplicationProperties = make(map[string]string)
 	}
+	image := e.Integration.Status.Image
+	if e.Integration.Status.VerifiedImage != "" {
+		// deploy the image the signature of which has been verified, rather than its tag
+		image = e.Integration.Status.VerifiedImage
+	}
 	container := corev1.Container{
 		Name:  t.getContainerName(),
-		Image: e.Integration.Status.Image,
+		Image: image,
 		Env:   make([]corev1.EnvVar, 0),
 	}
 	if t.ImagePullPolicy != "" {
@@ -210,7 +219,7 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	}); err != nil {
 		return err
 	}
-	t.configureResources(&container)
+	t.configureResources(e, &container)
 	if !knative {
 		// Knative does not like anybody touching the container ports
 		t.configurePorts(&container)
@@ -304,7 +313,7 @@ func (t *containerTrait) configureService(e *Environment, container *corev1.Cont
 	container.Ports = append(container.Ports, containerPort)
 }
 
-func (t *containerTrait) configureResources(container *corev1.Container) {
+func (t *containerTrait) configureResources(e *Environment, container *corev1.Container) {
 	requestsList := container.Resources.Requests
 	limitsList := container.Resources.Limits
 	var err error
@@ -331,11 +340,27 @@ func (t *containerTrait) configureResources(container *corev1.Container) {
 	if err != nil {
 		t.L.Error(err, "unable to parse quantity", "limit-memory", t.getLimitMemory())
 	}
+	if recommendation := t.getAppliedRecommendation(e); recommendation != nil {
+		requestsList[corev1.ResourceCPU] = recommendation.RequestCPU
+		requestsList[corev1.ResourceMemory] = recommendation.RequestMemory
+		limitsList[corev1.ResourceCPU] = recommendation.LimitCPU
+		limitsList[corev1.ResourceMemory] = recommendation.LimitMemory
+	}
 
 	container.Resources.Requests = requestsList
 	container.Resources.Limits = limitsList
 }
 
+// getAppliedRecommendation returns the resources recommendation taken into account by the last rollout
+// of the Integration, if the trait is configured to apply the recommendations.
+func (t *containerTrait) getAppliedRecommendation(e *Environment) *v1.IntegrationResourcesRecommendation {
+	if !ptr.Deref(t.ApplyRecommendations, false) || e.Integration == nil || e.Integration.Status.Resources == nil {
+		return nil
+	}
+
+	return e.Integration.Status.Resources.Applied
+}
+
 func (t *containerTrait) configureCapabilities(e *Environment) {
 	if util.StringSliceExists(e.Integration.Status.Capabilities, v1.CapabilityRest) {
 		e.ApplicationProperties["camel.context.rest-configuration.component"] = "platform-http"
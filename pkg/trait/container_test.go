@@ -572,6 +572,57 @@ func TestUserDefaultResources(t *testing.T) {
 	assert.Equal(t, resource.MustParse("128Mi"), *d.Spec.Template.Spec.Containers[0].Resources.Requests.Memory())
 }
 
+func TestContainerAppliedRecommendation(t *testing.T) {
+	environment := createSettingContextEnvironment(t, v1.TraitProfileKubernetes)
+	environment.Integration.Spec.Traits.Container = &traitv1.ContainerTrait{
+		LimitMemory:          "2Gi",
+		ApplyRecommendations: ptr.To(true),
+	}
+	environment.Integration.Status.Resources = &v1.IntegrationResourcesStatus{
+		// The latest recommendation is only applied at the next rollout
+		Recommendation: &v1.IntegrationResourcesRecommendation{
+			RequestCPU:    resource.MustParse("300m"),
+			RequestMemory: resource.MustParse("300Mi"),
+			LimitCPU:      resource.MustParse("600m"),
+			LimitMemory:   resource.MustParse("600Mi"),
+		},
+		Applied: &v1.IntegrationResourcesRecommendation{
+			RequestCPU:    resource.MustParse("200m"),
+			RequestMemory: resource.MustParse("200Mi"),
+			LimitCPU:      resource.MustParse("400m"),
+			LimitMemory:   resource.MustParse("400Mi"),
+		},
+	}
+	traitCatalog := NewCatalog(nil)
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, d)
+	require.Len(t, d.Spec.Template.Spec.Containers, 1)
+	container := d.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, resource.MustParse("200m"), *container.Resources.Requests.Cpu())
+	assert.Equal(t, resource.MustParse("200Mi"), *container.Resources.Requests.Memory())
+	assert.Equal(t, resource.MustParse("400m"), *container.Resources.Limits.Cpu())
+	assert.Equal(t, resource.MustParse("400Mi"), *container.Resources.Limits.Memory())
+	// The JVM heap follows the applied memory limit
+	assert.Contains(t, container.Args, "-Xmx210M")
+}
+
+func TestContainerVerifiedImage(t *testing.T) {
+	environment := createSettingContextEnvironment(t, v1.TraitProfileKubernetes)
+	environment.Integration.Status.Image = "foo/bar:1.0.0"
+	environment.Integration.Status.VerifiedImage = "foo/bar@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	traitCatalog := NewCatalog(nil)
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, d)
+	require.Len(t, d.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, environment.Integration.Status.VerifiedImage, d.Spec.Template.Spec.Containers[0].Image)
+}
+
 func TestContainerPorts(t *testing.T) {
 	environment := createSettingContextEnvironment(t, v1.TraitProfileKubernetes)
 	environment.Integration.Spec.Traits = v1.Traits{
@@ -18,14 +18,18 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
 	"maps"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -100,6 +104,9 @@ func (t *deploymentTrait) ControllerStrategySelectorOrder() int {
 
 func (t *deploymentTrait) Apply(e *Environment) error {
 	deployment := t.getDeploymentFor(e)
+	if err := t.selectRolloutTrack(e, deployment); err != nil {
+		return err
+	}
 	e.Resources.Add(deployment)
 
 	e.Integration.Status.SetCondition(
@@ -112,6 +119,26 @@ func (t *deploymentTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// selectRolloutTrack labels the Pods of the Deployment, and selects them, so that they are told apart from the Pods
+// of a new version of the Integration being rolled out. As the selector of a Deployment is immutable, the Deployments
+// created by former versions of the operator are left selecting all the Pods of the Integration.
+func (t *deploymentTrait) selectRolloutTrack(e *Environment, deployment *appsv1.Deployment) error {
+	if t.Client != nil {
+		live := &appsv1.Deployment{}
+		err := t.Client.Get(e.Ctx, ctrl.ObjectKeyFromObject(deployment), live)
+		switch {
+		case err == nil && live.Spec.Selector != nil && live.Spec.Selector.MatchLabels[v1.IntegrationRolloutTrackLabel] == "":
+			return nil
+		case err != nil && !k8serrors.IsNotFound(err):
+			return fmt.Errorf("cannot get deployment %s: %w", deployment.Name, err)
+		}
+	}
+	deployment.Spec.Selector.MatchLabels[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackStable
+	deployment.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackStable
+
+	return nil
+}
+
 func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 	// create a copy to avoid sharing the underlying annotation map
 	annotations := make(map[string]string)
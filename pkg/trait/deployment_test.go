@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
@@ -120,6 +123,33 @@ func TestApplyDeploymentTraitWhileRunningIntegrationDoesSucceed(t *testing.T) {
 	assert.Equal(t, int32(60), *deployment.Spec.ProgressDeadlineSeconds)
 }
 
+func TestApplyDeploymentTraitSelectsRolloutTrack(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	require.NoError(t, deploymentTrait.Apply(environment))
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	require.NotNil(t, deployment)
+	assert.Equal(t, v1.IntegrationRolloutTrackStable, deployment.Spec.Selector.MatchLabels[v1.IntegrationRolloutTrackLabel])
+	assert.Equal(t, v1.IntegrationRolloutTrackStable, deployment.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel])
+}
+
+func TestApplyDeploymentTraitKeepsFormerSelector(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	environment.Integration.Namespace = "namespace"
+	live := &appsv1.Deployment{}
+	require.NoError(t, deploymentTrait.Client.Get(context.TODO(), ctrl.ObjectKey{Namespace: "namespace", Name: "integration-name"}, live))
+	live.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{v1.IntegrationLabel: "integration-name"}}
+	require.NoError(t, deploymentTrait.Client.Update(context.TODO(), live))
+
+	require.NoError(t, deploymentTrait.Apply(environment))
+
+	// The selector of the Deployment is immutable
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	require.NotNil(t, deployment)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: "integration-name"}, deployment.Spec.Selector.MatchLabels)
+	assert.NotContains(t, deployment.Spec.Template.Labels, v1.IntegrationRolloutTrackLabel)
+}
+
 func TestApplyDeploymentTraitWithProgressDeadline(t *testing.T) {
 	deploymentTrait, environment := createNominalDeploymentTest()
 	progressDeadlineSeconds := int32(120)
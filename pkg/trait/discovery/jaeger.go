@@ -19,8 +19,6 @@ package discovery
 
 import (
 	"context"
-	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/apache/camel-k/v2/pkg/client"
@@ -32,43 +30,21 @@ type JaegerTelemetryLocator struct {
 	allowHeadless bool
 }
 
-const (
-	jaegerPortName = "grpc-otlp"
-)
-
-func (loc *JaegerTelemetryLocator) FindEndpoint(ctx context.Context, c client.Client, l log.Logger, namespace string) (string, error) {
+func (loc *JaegerTelemetryLocator) FindEndpoint(ctx context.Context, c client.Client, l log.Logger, namespace string, protocol string) (*TelemetryEndpoint, error) {
 	opts := metav1.ListOptions{
 		LabelSelector: "app.kubernetes.io/part-of=jaeger,app.kubernetes.io/component=service-collector",
 	}
 	lst, err := c.CoreV1().Services(namespace).List(ctx, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	var candidates []string
+	var candidates []TelemetryEndpoint
 	for _, svc := range lst.Items {
 		if !loc.allowHeadless && strings.HasSuffix(svc.Name, "-headless") {
 			continue
 		}
-
-		for _, port := range svc.Spec.Ports {
-			if port.Name == jaegerPortName && port.Port > 0 {
-				candidates = append(candidates, fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", svc.Name, svc.Namespace, port.Port))
-			}
-		}
+		candidates = append(candidates, serviceEndpoints(svc, nil)...)
 	}
-	sort.Strings(candidates)
-	if len(candidates) > 0 {
-		for _, endpoint := range candidates {
-			l.Infof("Detected Jaeger endpoint at: %s", endpoint)
-		}
-
-		return candidates[0], nil
-	}
-
-	return "", nil
-}
 
-// registering the locator.
-func init() {
-	TelemetryLocators = append(TelemetryLocators, &JaegerTelemetryLocator{}, &JaegerTelemetryLocator{allowHeadless: true})
+	return selectEndpoint(l, "Jaeger", candidates, protocol), nil
 }
@@ -19,15 +19,109 @@ package discovery
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 )
 
-// TelemetryLocators contains available telemetry OTLP locators.
-var TelemetryLocators []TelemetryLocator
+const (
+	// ProtocolGRPC is the OTLP protocol over gRPC.
+	ProtocolGRPC = "grpc"
+	// ProtocolHTTP is the OTLP protocol over HTTP, with binary encoded payloads.
+	ProtocolHTTP = "http/protobuf"
+
+	otlpGRPCPort = 4317
+	otlpHTTPPort = 4318
+)
+
+// TelemetryLocators contains available telemetry OTLP locators, by order of precedence.
+var TelemetryLocators = []TelemetryLocator{
+	&ServiceTelemetryLocator{},
+	&OpenTelemetryCollectorLocator{},
+	&TempoTelemetryLocator{},
+	&JaegerTelemetryLocator{},
+	&JaegerTelemetryLocator{allowHeadless: true},
+}
 
 // TelemetryLocator is able to find the address of an available telemetry OTLP endpoint.
 type TelemetryLocator interface {
-	FindEndpoint(ctx context.Context, c client.Client, logger log.Logger, namespace string) (string, error)
+	// FindEndpoint returns the endpoint serving the given protocol, or any protocol, gRPC being preferred, if none
+	// is given. It returns nil if no endpoint is found.
+	FindEndpoint(ctx context.Context, c client.Client, logger log.Logger, namespace string, protocol string) (*TelemetryEndpoint, error)
+}
+
+// TelemetryEndpoint is an OTLP endpoint.
+type TelemetryEndpoint struct {
+	// URL is the address of the endpoint, its scheme being `https` when it requires TLS.
+	URL string
+	// Protocol is the OTLP protocol served by the endpoint.
+	Protocol string
+}
+
+// otlpProtocol returns the OTLP protocol served by the port, according to its name, or its number.
+func otlpProtocol(port corev1.ServicePort) string {
+	name := strings.ToLower(port.Name)
+	if strings.Contains(name, "otlp") {
+		switch {
+		case strings.Contains(name, "grpc"):
+			return ProtocolGRPC
+		case strings.Contains(name, "http"):
+			return ProtocolHTTP
+		}
+	}
+	switch port.Port {
+	case otlpGRPCPort:
+		return ProtocolGRPC
+	case otlpHTTPPort:
+		return ProtocolHTTP
+	}
+
+	return ""
+}
+
+// serviceEndpoints returns the OTLP endpoints exposed by the Service. The tls function reports whether
+// the endpoints of a protocol require TLS.
+func serviceEndpoints(svc corev1.Service, tls func(protocol string) bool) []TelemetryEndpoint {
+	var endpoints []TelemetryEndpoint
+	for _, port := range svc.Spec.Ports {
+		protocol := otlpProtocol(port)
+		if protocol == "" || port.Port <= 0 {
+			continue
+		}
+		scheme := "http"
+		if tls != nil && tls(protocol) {
+			scheme = "https"
+		}
+		endpoints = append(endpoints, TelemetryEndpoint{
+			URL:      fmt.Sprintf("%s://%s.%s.svc.cluster.local:%d", scheme, svc.Name, svc.Namespace, port.Port),
+			Protocol: protocol,
+		})
+	}
+
+	return endpoints
+}
+
+// selectEndpoint logs the detected endpoints, and returns the first one serving the requested protocol,
+// or any protocol, gRPC being preferred, if none is requested.
+func selectEndpoint(l log.Logger, kind string, candidates []TelemetryEndpoint, protocol string) *TelemetryEndpoint {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].URL < candidates[j].URL
+	})
+	var selected *TelemetryEndpoint
+	for i, endpoint := range candidates {
+		if protocol != "" && endpoint.Protocol != protocol {
+			continue
+		}
+		l.Infof("Detected %s endpoint at: %s (%s)", kind, endpoint.URL, endpoint.Protocol)
+		if selected == nil || (protocol == "" && selected.Protocol != ProtocolGRPC && endpoint.Protocol == ProtocolGRPC) {
+			selected = &candidates[i]
+		}
+	}
+
+	return selected
 }
@@ -0,0 +1,179 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func otlpService(name string, labels map[string]string, annotations map[string]string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns",
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: ports,
+		},
+	}
+}
+
+func findEndpoint(t *testing.T, locator TelemetryLocator, protocol string, objs ...runtime.Object) *TelemetryEndpoint {
+	t.Helper()
+	c, err := internal.NewFakeClient(objs...)
+	require.NoError(t, err)
+	endpoint, err := locator.FindEndpoint(context.TODO(), c, log.Log, "ns", protocol)
+	require.NoError(t, err)
+
+	return endpoint
+}
+
+func TestServiceTelemetryLocator(t *testing.T) {
+	labels := map[string]string{OTLPEndpointLabel: "true"}
+	svc := otlpService("collector", labels, map[string]string{OTLPTLSAnnotation: "grpc"},
+		corev1.ServicePort{Name: "otlp-http", Port: 4318},
+		corev1.ServicePort{Name: "receiver", Port: 4317},
+		corev1.ServicePort{Name: "metrics", Port: 8888},
+	)
+	unlabeled := otlpService("other", nil, nil, corev1.ServicePort{Name: "otlp-grpc", Port: 4317})
+
+	endpoint := findEndpoint(t, &ServiceTelemetryLocator{}, "", svc, unlabeled)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "https://collector.ns.svc.cluster.local:4317", Protocol: ProtocolGRPC}, *endpoint)
+
+	endpoint = findEndpoint(t, &ServiceTelemetryLocator{}, ProtocolHTTP, svc, unlabeled)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "http://collector.ns.svc.cluster.local:4318", Protocol: ProtocolHTTP}, *endpoint)
+
+	assert.Nil(t, findEndpoint(t, &ServiceTelemetryLocator{}, "", unlabeled))
+
+	svc.Annotations[OTLPTLSAnnotation] = "http"
+	endpoint = findEndpoint(t, &ServiceTelemetryLocator{}, ProtocolHTTP, svc)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "https://collector.ns.svc.cluster.local:4318", Protocol: ProtocolHTTP}, *endpoint)
+}
+
+func TestTempoTelemetryLocator(t *testing.T) {
+	distributor := otlpService("tempo-distributor", map[string]string{
+		"app.kubernetes.io/name":      "tempo",
+		"app.kubernetes.io/component": "distributor",
+	}, nil, corev1.ServicePort{Name: "otlp-http", Port: 4318})
+	querier := otlpService("tempo-querier", map[string]string{
+		"app.kubernetes.io/name":      "tempo",
+		"app.kubernetes.io/component": "querier",
+	}, nil, corev1.ServicePort{Name: "otlp-grpc", Port: 4317})
+	headless := otlpService("tempo-distributor-discovery", map[string]string{
+		"app.kubernetes.io/name":      "tempo",
+		"app.kubernetes.io/component": "distributor",
+	}, nil, corev1.ServicePort{Name: "otlp-grpc", Port: 4317})
+	headless.Spec.ClusterIP = corev1.ClusterIPNone
+
+	endpoint := findEndpoint(t, &TempoTelemetryLocator{}, "", distributor, querier, headless)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "http://tempo-distributor.ns.svc.cluster.local:4318", Protocol: ProtocolHTTP}, *endpoint)
+
+	assert.Nil(t, findEndpoint(t, &TempoTelemetryLocator{}, ProtocolGRPC, distributor, querier, headless))
+}
+
+func TestJaegerTelemetryLocator(t *testing.T) {
+	labels := map[string]string{
+		"app.kubernetes.io/part-of":   "jaeger",
+		"app.kubernetes.io/component": "service-collector",
+	}
+	collector := otlpService("jaeger-collector", labels, nil,
+		corev1.ServicePort{Name: "grpc-jaeger", Port: 14250},
+		corev1.ServicePort{Name: "http-otlp", Port: 4318},
+		corev1.ServicePort{Name: "grpc-otlp", Port: 4317},
+	)
+	headless := otlpService("jaeger-collector-headless", labels, nil, corev1.ServicePort{Name: "grpc-otlp", Port: 4317})
+
+	endpoint := findEndpoint(t, &JaegerTelemetryLocator{}, "", collector, headless)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "http://jaeger-collector.ns.svc.cluster.local:4317", Protocol: ProtocolGRPC}, *endpoint)
+
+	endpoint = findEndpoint(t, &JaegerTelemetryLocator{}, ProtocolHTTP, collector, headless)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, "http://jaeger-collector.ns.svc.cluster.local:4318", endpoint.URL)
+
+	endpoint = findEndpoint(t, &JaegerTelemetryLocator{allowHeadless: true}, "", headless)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, "http://jaeger-collector-headless.ns.svc.cluster.local:4317", endpoint.URL)
+}
+
+func TestOpenTelemetryCollectorLocator(t *testing.T) {
+	collector := &unstructured.Unstructured{}
+	collector.SetAPIVersion("opentelemetry.io/v1beta1")
+	collector.SetKind("OpenTelemetryCollector")
+	collector.SetName("otel")
+	collector.SetNamespace("ns")
+	require.NoError(t, unstructured.SetNestedMap(collector.Object, map[string]any{
+		"mode": "deployment",
+		"config": map[string]any{
+			"receivers": map[string]any{
+				"otlp": map[string]any{
+					"protocols": map[string]any{
+						"grpc": map[string]any{
+							"tls": map[string]any{
+								"cert_file": "/certs/tls.crt",
+							},
+						},
+						"http": map[string]any{},
+					},
+				},
+			},
+		},
+	}, "spec"))
+	sidecar := &unstructured.Unstructured{}
+	sidecar.SetAPIVersion("opentelemetry.io/v1beta1")
+	sidecar.SetKind("OpenTelemetryCollector")
+	sidecar.SetName("sidecar")
+	sidecar.SetNamespace("ns")
+	require.NoError(t, unstructured.SetNestedField(sidecar.Object, "sidecar", "spec", "mode"))
+	svc := otlpService("otel-collector", nil, nil,
+		corev1.ServicePort{Name: "otlp-grpc", Port: 4317},
+		corev1.ServicePort{Name: "otlp-http", Port: 4318},
+	)
+	sidecarSvc := otlpService("sidecar-collector", nil, nil, corev1.ServicePort{Name: "otlp-grpc", Port: 4317})
+
+	endpoint := findEndpoint(t, &OpenTelemetryCollectorLocator{}, "", collector, sidecar, svc, sidecarSvc)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "https://otel-collector.ns.svc.cluster.local:4317", Protocol: ProtocolGRPC}, *endpoint)
+
+	endpoint = findEndpoint(t, &OpenTelemetryCollectorLocator{}, ProtocolHTTP, collector, sidecar, svc, sidecarSvc)
+	require.NotNil(t, endpoint)
+	assert.Equal(t, TelemetryEndpoint{URL: "http://otel-collector.ns.svc.cluster.local:4318", Protocol: ProtocolHTTP}, *endpoint)
+
+	assert.Nil(t, findEndpoint(t, &OpenTelemetryCollectorLocator{}, "", svc))
+}
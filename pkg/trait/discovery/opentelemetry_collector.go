@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// openTelemetryCollectorListKind is the kind of the collectors managed by the OpenTelemetry Operator.
+var openTelemetryCollectorListKind = schema.GroupVersionKind{
+	Group:   "opentelemetry.io",
+	Version: "v1beta1",
+	Kind:    "OpenTelemetryCollectorList",
+}
+
+// OpenTelemetryCollectorLocator finds the OTLP endpoints of the collectors managed by the OpenTelemetry Operator.
+// An endpoint requires TLS when the OTLP receiver of the collector configures TLS for its protocol.
+type OpenTelemetryCollectorLocator struct{}
+
+func (loc *OpenTelemetryCollectorLocator) FindEndpoint(ctx context.Context, c client.Client, l log.Logger, namespace string, protocol string) (*TelemetryEndpoint, error) {
+	collectors := unstructured.UnstructuredList{}
+	collectors.SetGroupVersionKind(openTelemetryCollectorListKind)
+	if err := c.List(ctx, &collectors, ctrl.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+			// The OpenTelemetry Operator is not installed, or the operator is not allowed to read its collectors
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	var candidates []TelemetryEndpoint
+	for _, collector := range collectors.Items {
+		if mode, _, _ := unstructured.NestedString(collector.Object, "spec", "mode"); mode == "sidecar" {
+			// Sidecars are only reachable from the Pods they're injected into
+			continue
+		}
+		svc := corev1.Service{}
+		key := ctrl.ObjectKey{Namespace: namespace, Name: collector.GetName() + "-collector"}
+		if err := c.Get(ctx, key, &svc); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+		candidates = append(candidates, serviceEndpoints(svc, func(protocol string) bool {
+			receiverProtocol := "grpc"
+			if protocol == ProtocolHTTP {
+				receiverProtocol = "http"
+			}
+			_, found, _ := unstructured.NestedFieldNoCopy(collector.Object,
+				"spec", "config", "receivers", "otlp", "protocols", receiverProtocol, "tls")
+
+			return found
+		})...)
+	}
+
+	return selectEndpoint(l, "OpenTelemetry Collector", candidates, protocol), nil
+}
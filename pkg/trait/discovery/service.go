@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/boolean"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OTLPEndpointLabel marks the Services exposing an OTLP endpoint, when set to `true`.
+	OTLPEndpointLabel = "camel.apache.org/otlp-endpoint"
+	// OTLPTLSAnnotation declares the OTLP protocols requiring TLS on the labeled Services, either `true` for all of them,
+	// or a comma separated list of protocols, e.g., `grpc`, `http` standing for `http/protobuf`.
+	OTLPTLSAnnotation = "camel.apache.org/otlp-tls"
+)
+
+// ServiceTelemetryLocator finds the OTLP endpoints of the Services labeled with `camel.apache.org/otlp-endpoint=true`.
+// The protocols are detected from the names of the ports, containing `otlp` along with `grpc` or `http`,
+// or from the standard OTLP port numbers.
+type ServiceTelemetryLocator struct{}
+
+func (loc *ServiceTelemetryLocator) FindEndpoint(ctx context.Context, c client.Client, l log.Logger, namespace string, protocol string) (*TelemetryEndpoint, error) {
+	opts := metav1.ListOptions{
+		LabelSelector: OTLPEndpointLabel + "=" + boolean.TrueString,
+	}
+	lst, err := c.CoreV1().Services(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []TelemetryEndpoint
+	for _, svc := range lst.Items {
+		tls := strings.Split(svc.Annotations[OTLPTLSAnnotation], ",")
+		candidates = append(candidates, serviceEndpoints(svc, func(protocol string) bool {
+			for _, value := range tls {
+				value = strings.TrimSpace(value)
+				if value == boolean.TrueString || value == protocol || (value == "http" && protocol == ProtocolHTTP) {
+					return true
+				}
+			}
+
+			return false
+		})...)
+	}
+
+	return selectEndpoint(l, "OTLP", candidates, protocol), nil
+}
@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TempoTelemetryLocator finds the OTLP endpoints of Grafana Tempo, deployed either as a single binary or in
+// distributed mode, where the distributors receive the spans.
+type TempoTelemetryLocator struct{}
+
+func (loc *TempoTelemetryLocator) FindEndpoint(ctx context.Context, c client.Client, l log.Logger, namespace string, protocol string) (*TelemetryEndpoint, error) {
+	opts := metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/name=tempo",
+	}
+	lst, err := c.CoreV1().Services(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []TelemetryEndpoint
+	for _, svc := range lst.Items {
+		if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+			continue
+		}
+		if component := svc.Labels["app.kubernetes.io/component"]; component != "" && component != "distributor" {
+			continue
+		}
+		candidates = append(candidates, serviceEndpoints(svc, nil)...)
+	}
+
+	return selectEndpoint(l, "Tempo", candidates, protocol), nil
+}
@@ -20,12 +20,18 @@ package trait
 import (
 	"errors"
 	"fmt"
+	gopath "path"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -36,8 +42,19 @@ const (
 	gatewayTraitOrder = 2420
 
 	gatewayDefaultListener = "8080;HTTP"
+	// the maximum number of matches of an HTTPRoute rule, as defined by the Gateway API.
+	gatewayMaxRouteMatches = 64
 )
 
+// gatewayHTTPMethods are the HTTP methods supported by the HTTPRoute matches.
+var gatewayHTTPMethods = []gwv1.HTTPMethod{
+	gwv1.HTTPMethodGet, gwv1.HTTPMethodHead, gwv1.HTTPMethodPost, gwv1.HTTPMethodPut, gwv1.HTTPMethodDelete,
+	gwv1.HTTPMethodConnect, gwv1.HTTPMethodOptions, gwv1.HTTPMethodTrace, gwv1.HTTPMethodPatch,
+}
+
+// gatewayDurationRegexp validates the durations, as defined by the Gateway API (GEP-2257).
+var gatewayDurationRegexp = regexp.MustCompile(`^([0-9]{1,5}(h|m|s|ms)){1,4}$`)
+
 type gatewayTrait struct {
 	BaseTrait
 	traitv1.GatewayTrait `property:",squash"`
@@ -63,33 +80,240 @@ func (t *gatewayTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 			"No service available. Skipping the trait execution",
 		), nil
 	}
+	for _, timeout := range []string{t.RequestTimeout, t.BackendRequestTimeout} {
+		if timeout != "" && !gatewayDurationRegexp.MatchString(timeout) {
+			return false, nil, fmt.Errorf("invalid gateway timeout %q: expected a duration such as 30s or 1m30s", timeout)
+		}
+	}
 
 	return true, nil, nil
 }
 
 func (t *gatewayTrait) Apply(e *Environment) error {
 	service := e.Resources.GetUserServiceForIntegration(e.Integration)
-	gwName := e.Integration.GetName()
+	routeName := e.Integration.GetName()
+
+	parentRef := gwv1.ParentReference{}
+	message := "Service is exposed via a Gateway and HTTPRoute named " + routeName
+	if t.Gateway != "" {
+		// Attach to the existing shared Gateway
+		gwNamespace, gwName := splitNamespacedName(t.Gateway)
+		parentRef.Name = gwv1.ObjectName(gwName)
+		if gwNamespace != "" {
+			parentRef.Namespace = ptr.To(gwv1.Namespace(gwNamespace))
+		}
+		message = fmt.Sprintf("Service is exposed via the Gateway %s and HTTPRoute named %s", t.Gateway, routeName)
+	} else {
+		gw, err := buildGateway(routeName, e.Integration.GetNamespace(), t.ClassName, t.getListeners(), t.TLSCertificates)
+		if err != nil {
+			return err
+		}
+		e.Resources.Add(gw)
+		parentRef.Name = gwv1.ObjectName(gw.GetName())
+	}
+	if t.SectionName != "" {
+		parentRef.SectionName = ptr.To(gwv1.SectionName(t.SectionName))
+	}
 
-	gw, err := buildGateway(gwName, e.Integration.GetNamespace(), t.ClassName, t.getListeners())
+	servicePorts := extractPorts(service.Spec.Ports)
+	route := buildHTTPRoute(routeName, service.GetName(), e.Integration.GetNamespace(), parentRef, servicePorts)
+	for _, hostname := range t.Hostnames {
+		route.Spec.Hostnames = append(route.Spec.Hostnames, gwv1.Hostname(hostname))
+	}
+	matches, err := t.getRouteMatches(e)
 	if err != nil {
 		return err
 	}
-	e.Resources.Add(gw)
-	servicePorts := extractPorts(service.Spec.Ports)
-	route := buildHTTPRoute(gwName, gw.GetName(), service.GetName(), gw.GetNamespace(), servicePorts)
+	filters, err := t.getRouteFilters()
+	if err != nil {
+		return err
+	}
+	timeouts := t.getRouteTimeouts()
+	for i := range route.Spec.Rules {
+		route.Spec.Rules[i].Matches = matches
+		route.Spec.Rules[i].Filters = filters
+		route.Spec.Rules[i].Timeouts = timeouts
+	}
 	e.Resources.Add(route)
 
 	e.Integration.Status.SetCondition(
 		v1.IntegrationConditionExposureAvailable,
 		corev1.ConditionTrue,
 		"GatewayAvailable",
-		"Service is exposed via a Gateway and HTTPRoute named "+gwName,
+		message,
 	)
 
 	return nil
 }
 
+// getRouteMatches returns the matches of the HTTPRoute rules, either from the configured paths, or, when auto is enabled,
+// from the HTTP endpoints discovered in the sources and the paths of the OpenAPI specs, combined with the configured
+// header matches. No match, i.e., all the paths, is returned otherwise.
+func (t *gatewayTrait) getRouteMatches(e *Environment) ([]gwv1.HTTPRouteMatch, error) {
+	var endpoints []source.HTTPEndpoint
+	if len(t.Paths) > 0 {
+		for _, p := range t.Paths {
+			endpoint := source.HTTPEndpoint{Path: p}
+			if method, path, ok := strings.Cut(strings.TrimSpace(p), " "); ok {
+				endpoint = source.HTTPEndpoint{Method: strings.ToUpper(method), Path: strings.TrimSpace(path)}
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	} else if ptr.Deref(t.Auto, false) {
+		// The generated sources are left out, as the routes generated from the OpenAPI specs are matched below
+		if _, err := e.ConsumeMeta(true, func(meta metadata.IntegrationMetadata) bool {
+			endpoints = append(endpoints, meta.HTTPEndpoints...)
+
+			return true
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(t.Paths) > 0 || ptr.Deref(t.Auto, false) {
+		// The paths of the OpenAPI specs, if any, are matched by prefix, whether the other paths are explicit or discovered
+		for _, path := range openAPIPaths(e) {
+			endpoints = append(endpoints, source.HTTPEndpoint{Path: gopath.Join(path, "*")})
+		}
+	}
+
+	headers := make([]gwv1.HTTPHeaderMatch, 0, len(t.Headers))
+	for _, h := range t.Headers {
+		name, value, ok := strings.Cut(h, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("could not parse gateway header %q: expected name=value", h)
+		}
+		headers = append(headers, gwv1.HTTPHeaderMatch{
+			Type:  ptr.To(gwv1.HeaderMatchExact),
+			Name:  gwv1.HTTPHeaderName(name),
+			Value: value,
+		})
+	}
+
+	matches := make([]gwv1.HTTPRouteMatch, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !strings.HasPrefix(endpoint.Path, "/") {
+			return nil, fmt.Errorf("could not parse gateway path %q: expected an absolute path", endpoint.Path)
+		}
+		match := gwv1.HTTPRouteMatch{
+			Path: newHTTPPathMatch(endpoint.Path),
+		}
+		if endpoint.Method != "" {
+			if !slices.Contains(gatewayHTTPMethods, gwv1.HTTPMethod(endpoint.Method)) {
+				return nil, fmt.Errorf("unsupported HTTP method %q of gateway path %q", endpoint.Method, endpoint.Path)
+			}
+			match.Method = ptr.To(gwv1.HTTPMethod(endpoint.Method))
+		}
+		if len(headers) > 0 {
+			match.Headers = headers
+		}
+		if !slices.ContainsFunc(matches, func(m gwv1.HTTPRouteMatch) bool { return equality.Semantic.DeepEqual(m, match) }) {
+			matches = append(matches, match)
+		}
+	}
+	if len(matches) == 0 && len(headers) > 0 {
+		matches = append(matches, gwv1.HTTPRouteMatch{Headers: headers})
+	}
+	if len(matches) > gatewayMaxRouteMatches {
+		return nil, fmt.Errorf(
+			"the integration exposes %d HTTP endpoints, more than the %d matches supported by an HTTPRoute rule: "+
+				"use the gateway trait paths property instead", len(matches), gatewayMaxRouteMatches)
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	return matches, nil
+}
+
+// newHTTPPathMatch returns an exact path match, or a prefix match for the paths with path parameters
+// or a trailing wildcard.
+func newHTTPPathMatch(path string) *gwv1.HTTPPathMatch {
+	prefix, templated := path, false
+	if i := strings.IndexAny(path, "{*"); i >= 0 {
+		prefix, templated = path[:i], true
+	}
+	if !templated {
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchExact),
+			Value: ptr.To(path),
+		}
+	}
+	if prefix = strings.TrimSuffix(prefix, "/"); prefix == "" {
+		prefix = "/"
+	}
+
+	return &gwv1.HTTPPathMatch{
+		Type:  ptr.To(gwv1.PathMatchPathPrefix),
+		Value: ptr.To(prefix),
+	}
+}
+
+// getRouteFilters returns the request and response header modifier filters of the HTTPRoute rules.
+func (t *gatewayTrait) getRouteFilters() ([]gwv1.HTTPRouteFilter, error) {
+	var filters []gwv1.HTTPRouteFilter
+	requestHeaders, err := newHTTPHeaderFilter(t.RequestHeaders, t.RemoveRequestHeaders)
+	if err != nil {
+		return nil, err
+	}
+	if requestHeaders != nil {
+		filters = append(filters, gwv1.HTTPRouteFilter{
+			Type:                  gwv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: requestHeaders,
+		})
+	}
+	responseHeaders, err := newHTTPHeaderFilter(t.ResponseHeaders, t.RemoveResponseHeaders)
+	if err != nil {
+		return nil, err
+	}
+	if responseHeaders != nil {
+		filters = append(filters, gwv1.HTTPRouteFilter{
+			Type:                   gwv1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: responseHeaders,
+		})
+	}
+
+	return filters, nil
+}
+
+// newHTTPHeaderFilter returns the filter setting the given "name=value" headers and removing the given header names,
+// or nil if there is none.
+func newHTTPHeaderFilter(set []string, remove []string) (*gwv1.HTTPHeaderFilter, error) {
+	if len(set) == 0 && len(remove) == 0 {
+		return nil, nil
+	}
+	filter := gwv1.HTTPHeaderFilter{
+		Remove: remove,
+	}
+	for _, h := range set {
+		name, value, ok := strings.Cut(h, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("could not parse gateway header %q: expected name=value", h)
+		}
+		filter.Set = append(filter.Set, gwv1.HTTPHeader{
+			Name:  gwv1.HTTPHeaderName(name),
+			Value: value,
+		})
+	}
+
+	return &filter, nil
+}
+
+// getRouteTimeouts returns the timeouts of the HTTPRoute rules, or nil if none is configured.
+func (t *gatewayTrait) getRouteTimeouts() *gwv1.HTTPRouteTimeouts {
+	if t.RequestTimeout == "" && t.BackendRequestTimeout == "" {
+		return nil
+	}
+	timeouts := gwv1.HTTPRouteTimeouts{}
+	if t.RequestTimeout != "" {
+		timeouts.Request = ptr.To(gwv1.Duration(t.RequestTimeout))
+	}
+	if t.BackendRequestTimeout != "" {
+		timeouts.BackendRequest = ptr.To(gwv1.Duration(t.BackendRequestTimeout))
+	}
+
+	return &timeouts
+}
+
 func (t *gatewayTrait) getListeners() []string {
 	if t.Listeners != nil {
 		return t.Listeners
@@ -98,8 +322,9 @@ func (t *gatewayTrait) getListeners() []string {
 	return []string{gatewayDefaultListener}
 }
 
-// buildGateway provides the gateway with the associated listeners.
-func buildGateway(name, namespace, className string, listeners []string) (*gwv1.Gateway, error) {
+// buildGateway provides the gateway with the associated listeners, the HTTPS ones terminating TLS with the given
+// "[namespace/]name" certificates Secrets.
+func buildGateway(name, namespace, className string, listeners []string, certificates []string) (*gwv1.Gateway, error) {
 	gwListeners := make([]gwv1.Listener, 0, len(listeners))
 
 	for _, l := range listeners {
@@ -119,7 +344,7 @@ func buildGateway(name, namespace, className string, listeners []string) (*gwv1.
 		}
 
 		listenerName := fmt.Sprintf("%s-%d", name, port)
-		gwListeners = append(gwListeners, gwv1.Listener{
+		listener := gwv1.Listener{
 			Name:     gwv1.SectionName(listenerName),
 			Port:     port,
 			Protocol: gwv1.ProtocolType(protocol),
@@ -128,7 +353,25 @@ func buildGateway(name, namespace, className string, listeners []string) (*gwv1.
 					From: ptr.To(gwv1.NamespacesFromSame),
 				},
 			},
-		})
+		}
+		if listener.Protocol == gwv1.HTTPSProtocolType {
+			if len(certificates) == 0 {
+				return nil, errors.New("gateway listener " + l + " requires at least one TLS certificate")
+			}
+			listener.TLS = &gwv1.ListenerTLSConfig{
+				Mode: ptr.To(gwv1.TLSModeTerminate),
+			}
+			for _, c := range certificates {
+				certificateRef := gwv1.SecretObjectReference{}
+				certificateNamespace, certificateName := splitNamespacedName(c)
+				certificateRef.Name = gwv1.ObjectName(certificateName)
+				if certificateNamespace != "" {
+					certificateRef.Namespace = ptr.To(gwv1.Namespace(certificateNamespace))
+				}
+				listener.TLS.CertificateRefs = append(listener.TLS.CertificateRefs, certificateRef)
+			}
+		}
+		gwListeners = append(gwListeners, listener)
 	}
 
 	return &gwv1.Gateway{
@@ -152,7 +395,7 @@ func isSupported(protocol string) bool {
 }
 
 // buildHTTPRoute provides the most basic gateway builder method.
-func buildHTTPRoute(routeName, gatewayName, serviceName, namespace string, servicePorts []int32) *gwv1.HTTPRoute {
+func buildHTTPRoute(routeName, serviceName, namespace string, parentRef gwv1.ParentReference, servicePorts []int32) *gwv1.HTTPRoute {
 	rules := make([]gwv1.HTTPRouteRule, 0, len(servicePorts))
 
 	for _, p := range servicePorts {
@@ -184,9 +427,7 @@ func buildHTTPRoute(routeName, gatewayName, serviceName, namespace string, servi
 		Spec: gwv1.HTTPRouteSpec{
 			CommonRouteSpec: gwv1.CommonRouteSpec{
 				ParentRefs: []gwv1.ParentReference{
-					{
-						Name: gwv1.ObjectName(gatewayName),
-					},
+					parentRef,
 				},
 			},
 			Rules: rules,
@@ -202,3 +443,12 @@ func extractPorts(ports []corev1.ServicePort) []int32 {
 
 	return result
 }
+
+// splitNamespacedName splits a reference in the format "[namespace/]name".
+func splitNamespacedName(ref string) (string, string) {
+	if namespace, name, ok := strings.Cut(ref, "/"); ok {
+		return namespace, name
+	}
+
+	return "", ref
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -113,6 +114,221 @@ func TestConfigureGatewayTraitMissingService(t *testing.T) {
 	assert.Contains(t, condition.message, "No service available")
 }
 
+func TestConfigureGatewayTraitDiscoveredPaths(t *testing.T) {
+	gwTrait, environment := createNominalGatewayTest()
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	environment.CamelCatalog = catalog
+	environment.Integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name: "routes.yaml",
+				Content: `
+- rest:
+    path: "/api"
+    get:
+      - path: "/orders/{id}"
+        to: "direct:order"
+    post:
+      - path: "/orders"
+        to: "direct:create"
+`,
+			},
+		},
+	}
+	gwTrait.Auto = ptr.To(true)
+	gwTrait.Headers = []string{"x-tenant=acme"}
+	configured, _, err := gwTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, gwTrait.Apply(environment))
+
+	route := getGatewayTestHTTPRoute(environment)
+	require.NotNil(t, route)
+	tenant := []gwv1.HTTPHeaderMatch{{Type: ptr.To(gwv1.HeaderMatchExact), Name: "x-tenant", Value: "acme"}}
+	for _, rule := range route.Spec.Rules {
+		assert.Equal(t, []gwv1.HTTPRouteMatch{
+			{
+				Path:    &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchPathPrefix), Value: ptr.To("/api/orders")},
+				Method:  ptr.To(gwv1.HTTPMethodGet),
+				Headers: tenant,
+			},
+			{
+				Path:    &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchExact), Value: ptr.To("/api/orders")},
+				Method:  ptr.To(gwv1.HTTPMethodPost),
+				Headers: tenant,
+			},
+		}, rule.Matches)
+	}
+
+	// All the paths are matched by default
+	for _, auto := range []*bool{nil, ptr.To(false)} {
+		gwTrait.Auto = auto
+		gwTrait.Headers = nil
+		require.NoError(t, gwTrait.Apply(environment))
+		route = getGatewayTestHTTPRoute(environment)
+		require.NotNil(t, route)
+		assert.Nil(t, route.Spec.Rules[0].Matches)
+	}
+
+	// The paths of the OpenAPI specs are matched along with the discovered or explicit paths
+	openAPI, ok := environment.Catalog.GetTrait(openAPITraitID).(*openAPITrait)
+	require.True(t, ok)
+	openAPI.paths = []string{"/openapi"}
+	openAPIMatch := gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchPathPrefix), Value: ptr.To("/openapi")},
+	}
+	gwTrait.Auto = ptr.To(true)
+	require.NoError(t, gwTrait.Apply(environment))
+	route = getGatewayTestHTTPRoute(environment)
+	require.NotNil(t, route)
+	assert.Len(t, route.Spec.Rules[0].Matches, 3)
+	assert.Equal(t, openAPIMatch, route.Spec.Rules[0].Matches[2])
+
+	gwTrait.Auto = ptr.To(false)
+	gwTrait.Paths = []string{"/health"}
+	require.NoError(t, gwTrait.Apply(environment))
+	route = getGatewayTestHTTPRoute(environment)
+	require.NotNil(t, route)
+	assert.Equal(t, []gwv1.HTTPRouteMatch{
+		{Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchExact), Value: ptr.To("/health")}},
+		openAPIMatch,
+	}, route.Spec.Rules[0].Matches)
+}
+
+func TestConfigureGatewayTraitPathsFiltersAndTimeouts(t *testing.T) {
+	gwTrait, environment := createNominalGatewayTest()
+	gwTrait.Hostnames = []string{"orders.example.com"}
+	gwTrait.Paths = []string{"/health", "get /orders/*"}
+	gwTrait.RequestHeaders = []string{"x-forwarded-by=camel"}
+	gwTrait.RemoveRequestHeaders = []string{"x-internal"}
+	gwTrait.RemoveResponseHeaders = []string{"server"}
+	gwTrait.RequestTimeout = "30s"
+	gwTrait.BackendRequestTimeout = "10s"
+	configured, _, err := gwTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, gwTrait.Apply(environment))
+
+	route := getGatewayTestHTTPRoute(environment)
+	require.NotNil(t, route)
+	assert.Equal(t, []gwv1.Hostname{"orders.example.com"}, route.Spec.Hostnames)
+	require.Len(t, route.Spec.Rules, 2)
+	rule := route.Spec.Rules[0]
+	assert.Equal(t, []gwv1.HTTPRouteMatch{
+		{
+			Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchExact), Value: ptr.To("/health")},
+		},
+		{
+			Path:   &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchPathPrefix), Value: ptr.To("/orders")},
+			Method: ptr.To(gwv1.HTTPMethodGet),
+		},
+	}, rule.Matches)
+	assert.Equal(t, []gwv1.HTTPRouteFilter{
+		{
+			Type: gwv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gwv1.HTTPHeaderFilter{
+				Set:    []gwv1.HTTPHeader{{Name: "x-forwarded-by", Value: "camel"}},
+				Remove: []string{"x-internal"},
+			},
+		},
+		{
+			Type: gwv1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: &gwv1.HTTPHeaderFilter{
+				Remove: []string{"server"},
+			},
+		},
+	}, rule.Filters)
+	assert.Equal(t, &gwv1.HTTPRouteTimeouts{
+		Request:        ptr.To(gwv1.Duration("30s")),
+		BackendRequest: ptr.To(gwv1.Duration("10s")),
+	}, rule.Timeouts)
+}
+
+func TestConfigureGatewayTraitInvalidConfiguration(t *testing.T) {
+	gwTrait, environment := createNominalGatewayTest()
+	gwTrait.RequestTimeout = "30 seconds"
+	_, _, err := gwTrait.Configure(environment)
+	require.Error(t, err)
+
+	gwTrait, environment = createNominalGatewayTest()
+	gwTrait.Paths = []string{"FETCH /orders"}
+	_, _, err = gwTrait.Configure(environment)
+	require.NoError(t, err)
+	require.Error(t, gwTrait.Apply(environment))
+
+	gwTrait, environment = createNominalGatewayTest()
+	gwTrait.Headers = []string{"x-tenant"}
+	_, _, err = gwTrait.Configure(environment)
+	require.NoError(t, err)
+	require.Error(t, gwTrait.Apply(environment))
+}
+
+func TestConfigureGatewayTraitHTTPSListener(t *testing.T) {
+	gwTrait, environment := createNominalGatewayTest()
+	gwTrait.Listeners = []string{"8443;HTTPS"}
+	_, _, err := gwTrait.Configure(environment)
+	require.NoError(t, err)
+	err = gwTrait.Apply(environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires at least one TLS certificate")
+
+	gwTrait.TLSCertificates = []string{"my-cert", "certs/shared-cert"}
+	require.NoError(t, gwTrait.Apply(environment))
+	var gateway *gwv1.Gateway
+	environment.Resources.Visit(func(o runtime.Object) {
+		if conv, ok := o.(*gwv1.Gateway); ok {
+			gateway = conv
+		}
+	})
+	require.NotNil(t, gateway)
+	require.Len(t, gateway.Spec.Listeners, 1)
+	assert.Equal(t, &gwv1.ListenerTLSConfig{
+		Mode: ptr.To(gwv1.TLSModeTerminate),
+		CertificateRefs: []gwv1.SecretObjectReference{
+			{Name: "my-cert"},
+			{Name: "shared-cert", Namespace: ptr.To(gwv1.Namespace("certs"))},
+		},
+	}, gateway.Spec.Listeners[0].TLS)
+}
+
+func TestConfigureGatewayTraitSharedGateway(t *testing.T) {
+	gwTrait, environment := createNominalGatewayTest()
+	gwTrait.Gateway = "infra/shared-gateway"
+	gwTrait.SectionName = "https"
+	configured, _, err := gwTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, gwTrait.Apply(environment))
+
+	environment.Resources.Visit(func(o runtime.Object) {
+		_, ok := o.(*gwv1.Gateway)
+		assert.False(t, ok, "No Gateway should be generated")
+	})
+	route := getGatewayTestHTTPRoute(environment)
+	require.NotNil(t, route)
+	assert.Equal(t, []gwv1.ParentReference{
+		{
+			Name:        "shared-gateway",
+			Namespace:   ptr.To(gwv1.Namespace("infra")),
+			SectionName: ptr.To(gwv1.SectionName("https")),
+		},
+	}, route.Spec.ParentRefs)
+	assert.Equal(t, "Service is exposed via the Gateway infra/shared-gateway and HTTPRoute named integration-name",
+		environment.Integration.Status.GetCondition(v1.IntegrationConditionExposureAvailable).Message)
+}
+
+func getGatewayTestHTTPRoute(e *Environment) *gwv1.HTTPRoute {
+	var route *gwv1.HTTPRoute
+	e.Resources.Visit(func(o runtime.Object) {
+		if conv, ok := o.(*gwv1.HTTPRoute); ok {
+			route = conv
+		}
+	})
+
+	return route
+}
+
 func createNominalGatewayTest() (*gatewayTrait, *Environment) {
 	trait, _ := newGatewayTrait().(*gatewayTrait)
 	trait.Enabled = ptr.To(true)
@@ -0,0 +1,218 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	hpaTraitID = "hpa"
+
+	defaultHPAMinReplicas    = int32(1)
+	defaultHPAMaxReplicas    = int32(10)
+	defaultHPACPUUtilization = int32(80)
+)
+
+type hpaTrait struct {
+	BaseTrait
+	traitv1.HPATrait `property:",squash"`
+}
+
+func newHPATrait() Trait {
+	return &hpaTrait{
+		BaseTrait: NewBaseTrait(hpaTraitID, TraitOrderPostProcessResources),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	if kt, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait); ok && ptr.Deref(kt.Enabled, false) {
+		return false, nil, errors.New("hpa trait cannot be enabled together with keda trait")
+	}
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	if strategy != ControllerStrategyDeployment {
+		return false, nil, fmt.Errorf("horizontalpodautoscaler isn't supported with %s controller strategy", strategy)
+	}
+
+	if t.MinReplicas == nil {
+		t.MinReplicas = ptr.To(defaultHPAMinReplicas)
+	}
+	if t.MaxReplicas == nil {
+		t.MaxReplicas = ptr.To(max(defaultHPAMaxReplicas, *t.MinReplicas))
+	}
+	if *t.MinReplicas < 1 {
+		return false, nil, errors.New("minReplicas must be greater than 0")
+	}
+	if *t.MaxReplicas < *t.MinReplicas {
+		return false, nil, fmt.Errorf("maxReplicas (%d) must be greater than or equal to minReplicas (%d)", *t.MaxReplicas, *t.MinReplicas)
+	}
+	if _, err := t.customMetrics(); err != nil {
+		return false, nil, err
+	}
+	if t.CPUUtilization == nil && t.MemoryUtilization == nil && len(t.Metrics) == 0 {
+		t.CPUUtilization = ptr.To(defaultHPACPUUtilization)
+	}
+
+	return true, nil, nil
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	target := scaleTargetFor(e.Integration)
+	metrics, err := t.customMetrics()
+	if err != nil {
+		return err
+	}
+	if t.MemoryUtilization != nil {
+		metrics = append([]autoscalingv2.MetricSpec{resourceMetric(corev1.ResourceMemory, *t.MemoryUtilization)}, metrics...)
+	}
+	if t.CPUUtilization != nil {
+		metrics = append([]autoscalingv2.MetricSpec{resourceMetric(corev1.ResourceCPU, *t.CPUUtilization)}, metrics...)
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    e.Integration.Labels,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: target.APIVersion,
+				Kind:       target.Kind,
+				Name:       target.Name,
+			},
+			MinReplicas: t.MinReplicas,
+			MaxReplicas: *t.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+	if t.ScaleDownStabilizationWindowSeconds != nil {
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: t.ScaleDownStabilizationWindowSeconds,
+			},
+		}
+	}
+	e.Resources.Add(hpa)
+
+	// The autoscaler considers that the scaling is disabled when the target has no replicas,
+	// which is the case of the scale subresource when the replicas field is not set.
+	if e.Integration.Spec.Replicas == nil && e.Client != nil {
+		return t.initializeReplicas(e, target)
+	}
+
+	return nil
+}
+
+// initializeReplicas sets the replicas of the scale target to the minimum number of replicas. The replicas
+// are updated through the scale subresource, as the autoscaler does, so that the operator propagates the
+// replicas decided by the autoscaler to the Deployment, instead of reverting them.
+func (t *hpaTrait) initializeReplicas(e *Environment, target *corev1.ObjectReference) error {
+	scales, err := e.Client.ScalesClient()
+	if err != nil {
+		return err
+	}
+	gr := schema.GroupResource{
+		Group:    v1.SchemeGroupVersion.Group,
+		Resource: "integrations",
+	}
+	if target.Kind == v1.PipeKind {
+		gr.Resource = "pipes"
+	}
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      target.Name,
+			Namespace: e.Integration.Namespace,
+		},
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: *t.MinReplicas,
+		},
+	}
+	if _, err := scales.Scales(e.Integration.Namespace).Update(e.Ctx, gr, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("cannot initialize the replicas of %s %s: %w", target.Kind, target.Name, err)
+	}
+
+	return nil
+}
+
+// customMetrics parses the custom metrics, in the `<metric-name>=<target average value>` format.
+func (t *hpaTrait) customMetrics() ([]autoscalingv2.MetricSpec, error) {
+	metrics := make([]autoscalingv2.MetricSpec, 0, len(t.Metrics))
+	for _, metric := range t.Metrics {
+		name, value, ok := strings.Cut(metric, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid metric %q, expected format is <metric-name>=<target average value>", metric)
+		}
+		target, err := resource.ParseQuantity(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid target value for metric %q: %w", name, err)
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	return metrics, nil
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(utilization),
+			},
+		},
+	}
+}
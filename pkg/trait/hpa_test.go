@@ -0,0 +1,232 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/gzip"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestHPADefaults(t *testing.T) {
+	environment := createHPATestEnv(t, &traitv1.HPATrait{})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+		Name:       ServiceTestName,
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, ptr.To(int32(1)), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, ptr.To(int32(80)), hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Nil(t, hpa.Spec.Behavior)
+
+	// the replicas are initialized, otherwise the autoscaler considers the scaling disabled
+	assert.Equal(t, int32(1), getScaleReplicas(t, &environment, "integrations", ServiceTestName))
+}
+
+func TestHPAMetrics(t *testing.T) {
+	environment := createHPATestEnv(t, &traitv1.HPATrait{
+		MinReplicas:                         ptr.To(int32(2)),
+		MaxReplicas:                         ptr.To(int32(5)),
+		CPUUtilization:                      ptr.To(int32(70)),
+		MemoryUtilization:                   ptr.To(int32(60)),
+		Metrics:                             []string{"camel_exchanges_inflight=10"},
+		ScaleDownStabilizationWindowSeconds: ptr.To(int32(120)),
+	})
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, ptr.To(int32(2)), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 3)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, ptr.To(int32(70)), hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, corev1.ResourceMemory, hpa.Spec.Metrics[1].Resource.Name)
+	assert.Equal(t, ptr.To(int32(60)), hpa.Spec.Metrics[1].Resource.Target.AverageUtilization)
+	assert.Equal(t, autoscalingv2.PodsMetricSourceType, hpa.Spec.Metrics[2].Type)
+	assert.Equal(t, "camel_exchanges_inflight", hpa.Spec.Metrics[2].Pods.Metric.Name)
+	assert.Equal(t, "10", hpa.Spec.Metrics[2].Pods.Target.AverageValue.String())
+	require.NotNil(t, hpa.Spec.Behavior)
+	assert.Equal(t, ptr.To(int32(120)), hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+
+	// the replicas set by the user are left to the autoscaler
+	assert.Equal(t, int32(0), getScaleReplicas(t, &environment, "integrations", ServiceTestName))
+}
+
+func TestHPAPipeTarget(t *testing.T) {
+	environment := createHPATestEnv(t, &traitv1.HPATrait{})
+	environment.Integration.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+			Name:       "my-pipe",
+		},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, v1.PipeKind, hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, "my-pipe", hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, int32(1), getScaleReplicas(t, &environment, "pipes", "my-pipe"))
+}
+
+func TestHPAInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait traitv1.HPATrait
+		keda  bool
+		err   string
+	}{
+		{
+			name:  "max-lower-than-min",
+			trait: traitv1.HPATrait{MinReplicas: ptr.To(int32(3)), MaxReplicas: ptr.To(int32(2))},
+			err:   "maxReplicas (2) must be greater than or equal to minReplicas (3)",
+		},
+		{
+			name:  "invalid-metric",
+			trait: traitv1.HPATrait{Metrics: []string{"camel_exchanges_inflight"}},
+			err:   `invalid metric "camel_exchanges_inflight", expected format is <metric-name>=<target average value>`,
+		},
+		{
+			name:  "keda",
+			trait: traitv1.HPATrait{},
+			keda:  true,
+			err:   "hpa trait cannot be enabled together with keda trait",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			environment := createHPATestEnv(t, &test.trait)
+			if test.keda {
+				environment.Integration.Spec.Traits.Keda = &traitv1.KedaTrait{
+					Trait: traitv1.Trait{Enabled: ptr.To(true)},
+				}
+			}
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func getHPA(resources *kubernetes.Collection) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, res := range resources.Items() {
+		if hpa, ok := res.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			return hpa
+		}
+	}
+
+	return nil
+}
+
+func getScaleReplicas(t *testing.T, e *Environment, resource, name string) int32 {
+	t.Helper()
+
+	scales, err := e.Client.ScalesClient()
+	require.NoError(t, err)
+	gr := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: resource}
+	scale, err := scales.Scales(e.Integration.Namespace).Get(context.Background(), gr, name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return scale.Spec.Replicas
+}
+
+func createHPATestEnv(t *testing.T, hpa *traitv1.HPATrait) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	compressedRoute, err := gzip.CompressBase64([]byte(`from("platform-http:/hello").log("hello");`))
+	require.NoError(t, err)
+
+	hpa.Enabled = ptr.To(true)
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:        "routes.java",
+							Content:     string(compressedRoute),
+							Compression: true,
+						},
+						Language: v1.LanguageJavaSource,
+					},
+				},
+				Traits: v1.Traits{
+					HPA: hpa,
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+}
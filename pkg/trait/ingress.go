@@ -79,6 +79,11 @@ func (t *ingressTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 			return false, nil, nil
 		}
 	}
+	//nolint:staticcheck
+	if t.Path == "" && len(t.Paths) == 0 {
+		// Only expose the paths of the OpenAPI specs, if any
+		t.Paths = openAPIPaths(e)
+	}
 
 	//nolint:staticcheck
 	if t.Path != "" {
@@ -20,6 +20,9 @@ package trait
 import (
 	"fmt"
 	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
@@ -28,6 +31,9 @@ import (
 	"github.com/apache/camel-k/v2/pkg/metadata"
 	kedamapper "github.com/apache/camel-k/v2/pkg/trait/keda"
 	_ "github.com/apache/camel-k/v2/pkg/trait/keda/scalers"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -37,6 +43,10 @@ const (
 	kedaTraitID = "keda"
 )
 
+// kedaPlaceholderRegexp matches the values that are a property placeholder, with an optional default value,
+// possibly wrapped into a raw value.
+var kedaPlaceholderRegexp = regexp.MustCompile(`^(?:RAW\()?\{\{([^:{}]+)(:[^{}]*)?\}\}\)?$`)
+
 type kedaTrait struct {
 	BaseTrait
 	traitv1.KedaTrait `property:",squash"`
@@ -64,7 +74,7 @@ func (t *kedaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 
 func (t *kedaTrait) Apply(e *Environment) error {
 	triggers, auths := t.populateTriggers(e.Integration.Name, e.Integration.Namespace)
-	scaleTarget := t.getScaleTarget(e.Integration)
+	scaleTarget := scaleTargetFor(e.Integration)
 	scaledObject := &v1alpha1.ScaledObject{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1alpha1.SchemeGroupVersion.String(),
@@ -95,6 +105,7 @@ func (t *kedaTrait) Apply(e *Environment) error {
 func (t *kedaTrait) populateTriggers(itName, itNamespace string) ([]v1alpha1.ScaleTriggers, []*v1alpha1.TriggerAuthentication) {
 	var auths []*v1alpha1.TriggerAuthentication
 	triggers := make([]v1alpha1.ScaleTriggers, 0, len(t.Triggers))
+	authNames := make(map[string]bool)
 	for _, trigger := range t.Triggers {
 		scaleTrigger := v1alpha1.ScaleTriggers{
 			Type:       trigger.Type,
@@ -103,6 +114,11 @@ func (t *kedaTrait) populateTriggers(itName, itNamespace string) ([]v1alpha1.Sca
 		}
 		if trigger.Secrets != nil {
 			triggerAuth := populateTriggerAuth(trigger.Secrets, itName, itNamespace, trigger.Type)
+			// Several triggers of the same type may be discovered, e.g., for routes consuming different queues
+			for i := 1; authNames[triggerAuth.Name]; i++ {
+				triggerAuth.Name = fmt.Sprintf("%s-%s-%d", itName, trigger.Type, i)
+			}
+			authNames[triggerAuth.Name] = true
 			auths = append(auths, triggerAuth)
 			scaleTrigger.AuthenticationRef = &v1alpha1.ScaledObjectAuthRef{
 				Name: triggerAuth.Name,
@@ -141,8 +157,8 @@ func populateTriggerAuth(secrets []*traitv1.KedaSecret, itName, itNamespace, ked
 	return triggerAuth
 }
 
-// getScaleTarget returns either an Integration or a Pipe, if the Integration was created by a Pipe.
-func (t *kedaTrait) getScaleTarget(it *v1.Integration) *corev1.ObjectReference {
+// scaleTargetFor returns either an Integration or a Pipe, if the Integration was created by a Pipe.
+func scaleTargetFor(it *v1.Integration) *corev1.ObjectReference {
 	for _, o := range it.OwnerReferences {
 		if o.Kind == v1.PipeKind && strings.HasPrefix(o.APIVersion, v1.SchemeGroupVersion.Group) {
 			return &corev1.ObjectReference{
@@ -154,13 +170,13 @@ func (t *kedaTrait) getScaleTarget(it *v1.Integration) *corev1.ObjectReference {
 	}
 
 	return &corev1.ObjectReference{
-		APIVersion: it.APIVersion,
-		Kind:       it.Kind,
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
 		Name:       it.Name,
 	}
 }
 
-// autoDiscoverTriggers discovers KEDA triggers from Camel source URIs.
+// autoDiscoverTriggers discovers KEDA triggers from Camel source URIs, and from the properties of the source Kamelets.
 func (t *kedaTrait) autoDiscoverTriggers(e *Environment) error {
 	// Build set of manually configured trigger types
 	manualTypes := make(map[string]bool)
@@ -174,7 +190,7 @@ func (t *kedaTrait) autoDiscoverTriggers(e *Environment) error {
 	}
 
 	for _, fromURI := range meta.FromURIs {
-		trigger, err := kedamapper.MapToKedaTrigger(fromURI)
+		trigger, auth, err := mapToKedaTrigger(e, fromURI)
 		if err != nil {
 			return err
 		}
@@ -186,9 +202,153 @@ func (t *kedaTrait) autoDiscoverTriggers(e *Environment) error {
 					maps.Copy(trigger.Metadata, extra)
 				}
 			}
+			trigger.Secrets = kedaSecretsFor(e, auth)
 			t.Triggers = append(t.Triggers, *trigger)
 		}
 	}
 
 	return nil
 }
+
+// mapToKedaTrigger maps the URI to a KEDA trigger, and returns the credentials of the endpoint. The Kamelets are
+// mapped from their properties, as configured in the Integration.
+func mapToKedaTrigger(e *Environment, fromURI string) (*traitv1.KedaTrigger, map[string]string, error) {
+	kamelet, id, params, err := kedamapper.ParseKameletURI(fromURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	if kamelet == "" {
+		return kedamapper.MapToKedaTriggerWithAuth(fromURI)
+	}
+	props := kameletProperties(e, kamelet, id)
+	maps.Copy(props, params)
+	trigger, auth := kedamapper.MapKameletToKedaTrigger(kamelet, props)
+
+	return trigger, auth, nil
+}
+
+// kameletProperties returns the properties of the Kamelet, the ones specific to the given route id overriding
+// the ones shared by all its routes.
+func kameletProperties(e *Environment, kamelet, id string) map[string]string {
+	props := make(map[string]string)
+	kameletPrefix := "camel.kamelet." + kamelet + "."
+	idPrefix := kameletPrefix + id + "."
+	pairs := e.collectConfigurationPairs("property")
+	for _, pair := range pairs {
+		if name, ok := strings.CutPrefix(pair.Name, kameletPrefix); ok && !strings.Contains(name, ".") {
+			props[name] = pair.Value
+		}
+	}
+	if id != "" {
+		for _, pair := range pairs {
+			if name, ok := strings.CutPrefix(pair.Name, idPrefix); ok {
+				props[name] = pair.Value
+			}
+		}
+	}
+
+	return props
+}
+
+// kedaSecretsFor maps the credentials to the keys of the Secrets mounted by the mount trait that hold their value,
+// either as property placeholders resolved from a Secret mounted as config, or as files of a Secret mounted as
+// resource. The credentials that can't be mapped are left to the triggers configured manually.
+func kedaSecretsFor(e *Environment, auth map[string]string) []*traitv1.KedaSecret {
+	mount, ok := e.Catalog.GetTrait(mountTraitID).(*mountTrait)
+	if !ok || len(auth) == 0 {
+		return nil
+	}
+	mappings := make(map[string]map[string]string)
+	for _, parameter := range util.SortedStringMapKeys(auth) {
+		var name, key string
+		value := auth[parameter]
+		if groups := kedaPlaceholderRegexp.FindStringSubmatch(value); groups != nil {
+			name, key = configSecretFor(e, mount.Configs, groups[1])
+		} else if filepath.IsAbs(value) {
+			name, key = resourceSecretFor(mount.Resources, value)
+		}
+		if name == "" {
+			continue
+		}
+		if mappings[name] == nil {
+			mappings[name] = make(map[string]string)
+		}
+		mappings[name][key] = parameter
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+	kedaSecrets := make([]*traitv1.KedaSecret, 0, len(mappings))
+	for _, name := range slices.Sorted(maps.Keys(mappings)) {
+		kedaSecrets = append(kedaSecrets, &traitv1.KedaSecret{
+			Name:    name,
+			Mapping: mappings[name],
+		})
+	}
+
+	return kedaSecrets
+}
+
+// configSecretFor returns the Secret mounted as config holding the property with the given name.
+func configSecretFor(e *Environment, configs []string, property string) (string, string) {
+	for _, ref := range ExternalSecretReferences(configs) {
+		if ref.Key == property {
+			return ExternalSecretName(e.Integration), property
+		}
+	}
+	for _, c := range configs {
+		conf, err := utilResource.ParseConfig(c)
+		if err != nil || conf.StorageType() != utilResource.StorageTypeSecret {
+			continue
+		}
+		if conf.Key() != "" {
+			if conf.Key() == property {
+				return conf.Name(), property
+			}
+
+			continue
+		}
+		if e.Client == nil {
+			continue
+		}
+		// The whole Secret is mounted, so its keys must be looked up
+		secret, err := kubernetes.GetSecret(e.Ctx, e.Client, conf.Name(), e.Integration.Namespace)
+		if err != nil {
+			continue
+		}
+		if _, ok := secret.Data[property]; ok {
+			return conf.Name(), property
+		}
+		if _, ok := secret.StringData[property]; ok {
+			return conf.Name(), property
+		}
+	}
+
+	return "", ""
+}
+
+// resourceSecretFor returns the Secret mounted as resource that provides the file with the given path.
+func resourceSecretFor(resources []string, path string) (string, string) {
+	for _, r := range resources {
+		res, err := utilResource.ParseResource(r)
+		if err != nil || res.StorageType() != utilResource.StorageTypeSecret {
+			continue
+		}
+		if res.Key() != "" && res.DestinationPath() != "" {
+			if filepath.Clean(res.DestinationPath()) == filepath.Clean(path) {
+				return res.Name(), res.Key()
+			}
+
+			continue
+		}
+		dir := getMountPoint(res.Name(), res.DestinationPath(), string(res.StorageType()), string(res.ContentType()))
+		if filepath.Dir(path) != filepath.Clean(dir) {
+			continue
+		}
+		if key := filepath.Base(path); res.Key() == "" || res.Key() == key {
+			return res.Name(), key
+		}
+	}
+
+	return "", ""
+}
@@ -18,16 +18,37 @@ limitations under the License.
 package keda
 
 import (
+	"strings"
+
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 )
 
 // ScaleMapper defines the interface for mapping Camel component URIs to KEDA triggers.
+// An empty KEDA type means that the endpoint can't be mapped to any trigger.
 type ScaleMapper interface {
 	Component() string
 	Map(pathValue string, params map[string]string) (kedaType string, metadata map[string]string)
 }
 
-var registry = map[string]ScaleMapper{}
+// AuthMapper is optionally implemented by the mappers whose endpoints hold credentials that can be
+// used by the KEDA scaler.
+type AuthMapper interface {
+	// Auth returns the values of the credentials found in the params, indexed by the KEDA authentication
+	// parameter they map to.
+	Auth(params map[string]string) map[string]string
+}
+
+// KameletMapper defines the interface for mapping the properties of a source Kamelet to KEDA triggers.
+// It can implement AuthMapper to map the credentials found in the properties.
+type KameletMapper interface {
+	Kamelet() string
+	Map(props map[string]string) (kedaType string, metadata map[string]string)
+}
+
+var (
+	registry        = map[string]ScaleMapper{}
+	kameletRegistry = map[string]KameletMapper{}
+)
 
 func Register(m ScaleMapper) {
 	registry[m.Component()] = m
@@ -39,22 +60,81 @@ func GetMapper(component string) (ScaleMapper, bool) {
 	return m, ok
 }
 
+// RegisterKamelet registers the mapper of a source Kamelet.
+func RegisterKamelet(m KameletMapper) {
+	kameletRegistry[m.Kamelet()] = m
+}
+
+// GetKameletMapper returns the mapper registered for the given Kamelet, if any.
+func GetKameletMapper(kamelet string) (KameletMapper, bool) {
+	m, ok := kameletRegistry[kamelet]
+
+	return m, ok
+}
+
 func MapToKedaTrigger(rawURI string) (*traitv1.KedaTrigger, error) {
+	trigger, _, err := MapToKedaTriggerWithAuth(rawURI)
+
+	return trigger, err
+}
+
+// MapToKedaTriggerWithAuth maps the URI to a KEDA trigger, and returns the values of the credentials found
+// in the URI, indexed by the KEDA authentication parameter they map to.
+func MapToKedaTriggerWithAuth(rawURI string) (*traitv1.KedaTrigger, map[string]string, error) {
 	scheme, pathValue, params, err := ParseComponentURI(rawURI)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if scheme == "" {
-		return nil, nil
+		return nil, nil, nil
 	}
 	mapper, found := GetMapper(scheme)
 	if !found {
-		return nil, nil
+		return nil, nil, nil
 	}
 	kedaType, metadata := mapper.Map(pathValue, params)
+	if kedaType == "" {
+		return nil, nil, nil
+	}
 
 	return &traitv1.KedaTrigger{
 		Type:     kedaType,
 		Metadata: metadata,
-	}, nil
+	}, authFor(mapper, params), nil
+}
+
+// MapKameletToKedaTrigger maps a source Kamelet, configured with the given properties, to a KEDA trigger, and
+// returns the values of the credentials found in the properties, indexed by the KEDA authentication parameter
+// they map to.
+func MapKameletToKedaTrigger(kamelet string, props map[string]string) (*traitv1.KedaTrigger, map[string]string) {
+	mapper, found := GetKameletMapper(kamelet)
+	if !found {
+		return nil, nil
+	}
+	kedaType, metadata := mapper.Map(props)
+	if kedaType == "" {
+		return nil, nil
+	}
+
+	return &traitv1.KedaTrigger{
+		Type:     kedaType,
+		Metadata: metadata,
+	}, authFor(mapper, props)
+}
+
+func authFor(mapper any, params map[string]string) map[string]string {
+	if m, ok := mapper.(AuthMapper); ok {
+		return m.Auth(params)
+	}
+
+	return nil
+}
+
+// CopyParams copies the non-empty params into the metadata, using the given metadata keys.
+func CopyParams(metadata map[string]string, params map[string]string, keys map[string]string) {
+	for param, key := range keys {
+		if v := strings.TrimSpace(params[param]); v != "" {
+			metadata[key] = v
+		}
+	}
 }
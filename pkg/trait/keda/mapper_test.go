@@ -79,3 +79,20 @@ func TestParseComponentURI(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKameletURI(t *testing.T) {
+	name, id, params, err := ParseKameletURI("kamelet:aws-sqs-source/source?kameletVersion=v2")
+	require.NoError(t, err)
+	assert.Equal(t, "aws-sqs-source", name)
+	assert.Equal(t, "source", id)
+	assert.Equal(t, map[string]string{"kameletVersion": "v2"}, params)
+
+	name, id, _, err = ParseKameletURI("kamelet://timer-source")
+	require.NoError(t, err)
+	assert.Equal(t, "timer-source", name)
+	assert.Empty(t, id)
+
+	name, _, _, err = ParseKameletURI("kafka:orders")
+	require.NoError(t, err)
+	assert.Empty(t, name)
+}
@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// artemisManagementPort is the default port of the ActiveMQ Artemis management console.
+const artemisManagementPort = "8161"
+
+var brokerHostRegexp = regexp.MustCompile(`[a-z0-9+]+://([^:/?,)\s]+)`)
+
+// ArtemisScaler maps the queue consumers of the JMS and AMQP components to the KEDA artemis-queue scaler.
+type ArtemisScaler struct {
+	component string
+}
+
+// ArtemisKamelet maps the JMS Kamelets to the KEDA artemis-queue scaler.
+type ArtemisKamelet struct {
+	kamelet string
+	// brokerProperty is the property holding the URL of the broker
+	brokerProperty string
+}
+
+func init() {
+	keda.Register(&ArtemisScaler{component: "amqp"})
+	keda.Register(&ArtemisScaler{component: "jms"})
+	keda.RegisterKamelet(&ArtemisKamelet{kamelet: "jms-amqp-10-source", brokerProperty: "remoteURI"})
+	keda.RegisterKamelet(&ArtemisKamelet{kamelet: "jms-apache-artemis-source", brokerProperty: "brokerURL"})
+}
+
+func (s *ArtemisScaler) Component() string {
+	return s.component
+}
+
+func (s *ArtemisScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	destinationType, destinationName, found := strings.Cut(pathValue, ":")
+	if !found {
+		destinationType, destinationName = "queue", pathValue
+	}
+
+	return artemisQueue(destinationType, destinationName, "")
+}
+
+func (s *ArtemisScaler) Auth(params map[string]string) map[string]string {
+	return artemisAuth(params)
+}
+
+func (k *ArtemisKamelet) Kamelet() string {
+	return k.kamelet
+}
+
+func (k *ArtemisKamelet) Map(props map[string]string) (string, map[string]string) {
+	destinationType := props["destinationType"]
+	if destinationType == "" {
+		destinationType = "queue"
+	}
+
+	return artemisQueue(destinationType, props["destinationName"], props[k.brokerProperty])
+}
+
+func (k *ArtemisKamelet) Auth(props map[string]string) map[string]string {
+	return artemisAuth(props)
+}
+
+// artemisQueue maps a queue to the scaler metadata. Topics are not mapped, as their subscriptions don't
+// accumulate messages while the consumers are down.
+func artemisQueue(destinationType, destinationName, brokerURL string) (string, map[string]string) {
+	if destinationType != "queue" || destinationName == "" {
+		return "", nil
+	}
+	metadata := map[string]string{
+		"queueName":     destinationName,
+		"brokerAddress": destinationName,
+	}
+	if groups := brokerHostRegexp.FindStringSubmatch(brokerURL); groups != nil {
+		metadata["managementEndpoint"] = net.JoinHostPort(groups[1], artemisManagementPort)
+	}
+
+	return "artemis-queue", metadata
+}
+
+func artemisAuth(params map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, params, map[string]string{
+		"username": "username",
+		"password": "password",
+	})
+
+	return auth
+}
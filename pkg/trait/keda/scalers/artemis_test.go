@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestArtemisScalerMap(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		expectedType string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "amqp queue",
+			uri:          "amqp:queue:orders",
+			expectedType: "artemis-queue",
+			expectedMeta: map[string]string{"queueName": "orders", "brokerAddress": "orders"},
+		},
+		{
+			name:         "jms default destination type",
+			uri:          "jms:orders?concurrentConsumers=2",
+			expectedType: "artemis-queue",
+			expectedMeta: map[string]string{"queueName": "orders", "brokerAddress": "orders"},
+		},
+		{
+			name: "topic",
+			uri:  "amqp:topic:events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger, err := keda.MapToKedaTrigger(tt.uri)
+			require.NoError(t, err)
+			if tt.expectedType == "" {
+				assert.Nil(t, trigger)
+
+				return
+			}
+			require.NotNil(t, trigger)
+			assert.Equal(t, tt.expectedType, trigger.Type)
+			assert.Equal(t, tt.expectedMeta, trigger.Metadata)
+		})
+	}
+}
+
+func TestArtemisKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("jms-amqp-10-source", map[string]string{
+		"destinationName": "orders",
+		"remoteURI":       "amqp://artemis.messaging.svc:5672",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "artemis-queue", trigger.Type)
+	assert.Equal(t, map[string]string{
+		"queueName":          "orders",
+		"brokerAddress":      "orders",
+		"managementEndpoint": "artemis.messaging.svc:8161",
+	}, trigger.Metadata)
+	assert.Empty(t, auth)
+
+	trigger, auth = keda.MapKameletToKedaTrigger("jms-apache-artemis-source", map[string]string{
+		"destinationName": "orders",
+		"destinationType": "queue",
+		"brokerURL":       "tcp://artemis:61616",
+		"username":        "admin",
+		"password":        "{{artemis.password}}",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "artemis:8161", trigger.Metadata["managementEndpoint"])
+	assert.Equal(t, map[string]string{"username": "admin", "password": "{{artemis.password}}"}, auth)
+}
@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"fmt"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// AwsSqsScaler maps the AWS SQS consumers to the KEDA aws-sqs-queue scaler.
+type AwsSqsScaler struct{}
+
+// AwsSqsKamelet maps the aws-sqs-source Kamelet to the KEDA aws-sqs-queue scaler.
+type AwsSqsKamelet struct{}
+
+func init() {
+	keda.Register(&AwsSqsScaler{})
+	keda.RegisterKamelet(&AwsSqsKamelet{})
+}
+
+func (s *AwsSqsScaler) Component() string {
+	return "aws2-sqs"
+}
+
+func (s *AwsSqsScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	metadata := make(map[string]string)
+	queueURL := params["queueUrl"]
+	if queueURL == "" {
+		queueURL = sqsQueueURL(pathValue)
+	}
+	if queueURL == "" {
+		return "", nil
+	}
+	metadata["queueURL"] = queueURL
+	keda.CopyParams(metadata, params, map[string]string{
+		"region": "awsRegion",
+	})
+	if params["overrideEndpoint"] == "true" {
+		keda.CopyParams(metadata, params, map[string]string{
+			"uriEndpointOverride": "awsEndpoint",
+		})
+	}
+
+	return "aws-sqs-queue", metadata
+}
+
+func (s *AwsSqsScaler) Auth(params map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, params, map[string]string{
+		"accessKey":    "awsAccessKeyID",
+		"secretKey":    "awsSecretAccessKey",
+		"sessionToken": "awsSessionToken",
+	})
+
+	return auth
+}
+
+func (k *AwsSqsKamelet) Kamelet() string {
+	return "aws-sqs-source"
+}
+
+func (k *AwsSqsKamelet) Map(props map[string]string) (string, map[string]string) {
+	return (&AwsSqsScaler{}).Map(props["queueNameOrArn"], props)
+}
+
+func (k *AwsSqsKamelet) Auth(props map[string]string) map[string]string {
+	return (&AwsSqsScaler{}).Auth(props)
+}
+
+// sqsQueueURL returns the queue URL, or short name, expected by the scaler for the given queue name or ARN.
+func sqsQueueURL(queueNameOrArn string) string {
+	if !strings.HasPrefix(queueNameOrArn, "arn:") {
+		return queueNameOrArn
+	}
+	// arn:<partition>:sqs:<region>:<account>:<name>
+	parts := strings.Split(queueNameOrArn, ":")
+	if len(parts) != 6 || parts[2] != "sqs" {
+		return ""
+	}
+
+	return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", parts[3], parts[4], parts[5])
+}
@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestAwsSqsScalerMap(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		expectedMeta map[string]string
+		expectedAuth map[string]string
+	}{
+		{
+			name: "queue name",
+			uri:  "aws2-sqs:orders?region=eu-west-1&accessKey={{aws.access}}&secretKey={{aws.secret}}",
+			expectedMeta: map[string]string{
+				"queueURL":  "orders",
+				"awsRegion": "eu-west-1",
+			},
+			expectedAuth: map[string]string{
+				"awsAccessKeyID":     "{{aws.access}}",
+				"awsSecretAccessKey": "{{aws.secret}}",
+			},
+		},
+		{
+			name: "queue arn",
+			uri:  "aws2-sqs:arn:aws:sqs:us-east-1:123456789012:orders?useDefaultCredentialsProvider=true",
+			expectedMeta: map[string]string{
+				"queueURL": "https://sqs.us-east-1.amazonaws.com/123456789012/orders",
+			},
+			expectedAuth: map[string]string{},
+		},
+		{
+			name: "endpoint override",
+			uri:  "aws2-sqs:orders?overrideEndpoint=true&uriEndpointOverride=http://localstack:4566",
+			expectedMeta: map[string]string{
+				"queueURL":    "orders",
+				"awsEndpoint": "http://localstack:4566",
+			},
+			expectedAuth: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger, auth, err := keda.MapToKedaTriggerWithAuth(tt.uri)
+			require.NoError(t, err)
+			require.NotNil(t, trigger)
+			assert.Equal(t, "aws-sqs-queue", trigger.Type)
+			assert.Equal(t, tt.expectedMeta, trigger.Metadata)
+			assert.Equal(t, tt.expectedAuth, auth)
+		})
+	}
+}
+
+func TestAwsSqsKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("aws-sqs-source", map[string]string{
+		"queueNameOrArn": "orders",
+		"region":         "eu-west-1",
+		"accessKey":      "{{aws.access}}",
+		"secretKey":      "{{aws.secret}}",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "aws-sqs-queue", trigger.Type)
+	assert.Equal(t, map[string]string{"queueURL": "orders", "awsRegion": "eu-west-1"}, trigger.Metadata)
+	assert.Equal(t, map[string]string{
+		"awsAccessKeyID":     "{{aws.access}}",
+		"awsSecretAccessKey": "{{aws.secret}}",
+	}, auth)
+}
+
+func TestSqsQueueURLInvalidArn(t *testing.T) {
+	trigger, err := keda.MapToKedaTrigger("aws2-sqs:arn:aws:sns:us-east-1:123456789012:orders")
+	require.NoError(t, err)
+	assert.Nil(t, trigger)
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// azureServiceBusDomain is the domain of the fully qualified Azure Service Bus namespaces.
+const azureServiceBusDomain = ".servicebus.windows.net"
+
+// AzureServiceBusScaler maps the Azure Service Bus consumers to the KEDA azure-servicebus scaler.
+type AzureServiceBusScaler struct{}
+
+// AzureServiceBusKamelet maps the azure-servicebus-source Kamelet to the KEDA azure-servicebus scaler.
+type AzureServiceBusKamelet struct{}
+
+func init() {
+	keda.Register(&AzureServiceBusScaler{})
+	keda.RegisterKamelet(&AzureServiceBusKamelet{})
+}
+
+func (s *AzureServiceBusScaler) Component() string {
+	return "azure-servicebus"
+}
+
+func (s *AzureServiceBusScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	if pathValue == "" {
+		return "", nil
+	}
+	metadata := make(map[string]string)
+	if params["serviceBusType"] == "topic" {
+		if params["subscriptionName"] == "" {
+			return "", nil
+		}
+		metadata["topicName"] = pathValue
+		metadata["subscriptionName"] = params["subscriptionName"]
+	} else {
+		metadata["queueName"] = pathValue
+	}
+	if namespace := params["fullyQualifiedNamespace"]; namespace != "" {
+		metadata["namespace"] = strings.TrimSuffix(namespace, azureServiceBusDomain)
+	}
+
+	return "azure-servicebus", metadata
+}
+
+func (s *AzureServiceBusScaler) Auth(params map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, params, map[string]string{
+		"connectionString": "connection",
+	})
+
+	return auth
+}
+
+func (k *AzureServiceBusKamelet) Kamelet() string {
+	return "azure-servicebus-source"
+}
+
+func (k *AzureServiceBusKamelet) Map(props map[string]string) (string, map[string]string) {
+	return (&AzureServiceBusScaler{}).Map(props["topicOrQueueName"], props)
+}
+
+func (k *AzureServiceBusKamelet) Auth(props map[string]string) map[string]string {
+	return (&AzureServiceBusScaler{}).Auth(props)
+}
@@ -0,0 +1,79 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestAzureServiceBusScalerMap(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "queue",
+			uri:          "azure-servicebus:orders?connectionString=RAW({{azure.connection}})",
+			expectedMeta: map[string]string{"queueName": "orders"},
+		},
+		{
+			name: "topic subscription",
+			uri:  "azure-servicebus:events?serviceBusType=topic&subscriptionName=billing&fullyQualifiedNamespace=shop.servicebus.windows.net",
+			expectedMeta: map[string]string{
+				"topicName":        "events",
+				"subscriptionName": "billing",
+				"namespace":        "shop",
+			},
+		},
+		{
+			name: "topic without subscription",
+			uri:  "azure-servicebus:events?serviceBusType=topic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger, err := keda.MapToKedaTrigger(tt.uri)
+			require.NoError(t, err)
+			if tt.expectedMeta == nil {
+				assert.Nil(t, trigger)
+
+				return
+			}
+			require.NotNil(t, trigger)
+			assert.Equal(t, "azure-servicebus", trigger.Type)
+			assert.Equal(t, tt.expectedMeta, trigger.Metadata)
+		})
+	}
+}
+
+func TestAzureServiceBusKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("azure-servicebus-source", map[string]string{
+		"topicOrQueueName": "orders",
+		"connectionString": "{{azure.connection}}",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, map[string]string{"queueName": "orders"}, trigger.Metadata)
+	assert.Equal(t, map[string]string{"connection": "{{azure.connection}}"}, auth)
+}
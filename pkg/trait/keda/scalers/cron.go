@@ -0,0 +1,195 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+const (
+	// cronWindow is the number of minutes the Integration is kept up around each scheduled execution.
+	cronWindow = 5
+	// cronTimezone is the timezone of the schedules, that is the default timezone of the containers.
+	cronTimezone = "Etc/UTC"
+)
+
+var numberRegexp = regexp.MustCompile(`\d+`)
+
+// CronScaler maps the cron consumers to the KEDA cron scaler, scaling the Integration up from a few minutes
+// before each execution, as the scheduler doesn't fire the executions missed while it was down.
+type CronScaler struct{}
+
+// TimerScaler maps the timer consumers to the KEDA cron scaler, scaling the Integration up at the beginning of
+// each period, as the timer fires when it starts. Only the periods dividing an hour, or a day, are mapped.
+type TimerScaler struct{}
+
+// CronKamelet maps the cron-source Kamelet to the KEDA cron scaler.
+type CronKamelet struct{}
+
+// TimerKamelet maps the timer-source Kamelet to the KEDA cron scaler.
+type TimerKamelet struct{}
+
+func init() {
+	keda.Register(&CronScaler{})
+	keda.Register(&TimerScaler{})
+	keda.RegisterKamelet(&CronKamelet{})
+	keda.RegisterKamelet(&TimerKamelet{})
+}
+
+func (s *CronScaler) Component() string {
+	return "cron"
+}
+
+func (s *CronScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	start, end, ok := cronWindowFor(params["schedule"])
+	if !ok {
+		return "", nil
+	}
+
+	return cronTrigger(start, end)
+}
+
+func (s *TimerScaler) Component() string {
+	return "timer"
+}
+
+func (s *TimerScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	start, end, ok := timerWindowFor(params["period"])
+	if !ok {
+		return "", nil
+	}
+
+	return cronTrigger(start, end)
+}
+
+func (k *CronKamelet) Kamelet() string {
+	return "cron-source"
+}
+
+func (k *CronKamelet) Map(props map[string]string) (string, map[string]string) {
+	return (&CronScaler{}).Map("", props)
+}
+
+func (k *TimerKamelet) Kamelet() string {
+	return "timer-source"
+}
+
+func (k *TimerKamelet) Map(props map[string]string) (string, map[string]string) {
+	return (&TimerScaler{}).Map("", props)
+}
+
+func cronTrigger(start, end string) (string, map[string]string) {
+	return "cron", map[string]string{
+		"timezone":        cronTimezone,
+		"start":           start,
+		"end":             end,
+		"desiredReplicas": "1",
+	}
+}
+
+// cronWindowFor returns the standard cron expressions starting and ending the window around the executions of
+// the given Quartz, or standard, cron schedule. Only the schedules firing at a single minute are supported.
+func cronWindowFor(schedule string) (string, string, bool) {
+	fields := strings.Fields(schedule)
+	switch len(fields) {
+	case 5:
+	case 6, 7:
+		// Quartz schedules start with seconds, may end with years, and number the days of week from 1 (Sunday)
+		if len(fields) == 7 && fields[6] != "*" && fields[6] != "?" {
+			return "", "", false
+		}
+		fields = fields[1:6]
+		fields[4] = numberRegexp.ReplaceAllStringFunc(fields[4], func(day string) string {
+			n, _ := strconv.Atoi(day)
+
+			return strconv.Itoa((n + 6) % 7)
+		})
+	default:
+		return "", "", false
+	}
+	for i, field := range fields {
+		if strings.ContainsAny(field, "LW#") {
+			return "", "", false
+		}
+		if field == "?" {
+			fields[i] = "*"
+		}
+	}
+
+	minute, err := strconv.Atoi(fields[0])
+	if err != nil || minute < 0 || minute > 59 {
+		return "", "", false
+	}
+	start, end := minute-cronWindow, minute+cronWindow
+	startHour, endHour := fields[1], fields[1]
+	if start < 0 || end > 59 {
+		// The window overlaps two hours, which is expressible when the schedule fires every hour,
+		// or at a single hour without crossing the day
+		hour, err := strconv.Atoi(fields[1])
+		switch {
+		case fields[1] == "*" && fields[2] == "*" && fields[4] == "*":
+		case err == nil && start < 0 && hour > 0:
+			startHour = strconv.Itoa(hour - 1)
+		case err == nil && end > 59 && hour < 23:
+			endHour = strconv.Itoa(hour + 1)
+		default:
+			return "", "", false
+		}
+		start, end = (start+60)%60, end%60
+	}
+	rest := strings.Join(fields[2:], " ")
+
+	return fmt.Sprintf("%d %s %s", start, startHour, rest), fmt.Sprintf("%d %s %s", end, endHour, rest), true
+}
+
+// timerWindowFor returns the standard cron expressions starting and ending the window at the beginning of each
+// timer period, expressed in milliseconds or as a duration.
+func timerWindowFor(period string) (string, string, bool) {
+	var duration time.Duration
+	if ms, err := strconv.ParseInt(period, 10, 64); err == nil {
+		duration = time.Duration(ms) * time.Millisecond
+	} else if d, err := time.ParseDuration(period); err == nil {
+		duration = d
+	} else {
+		return "", "", false
+	}
+
+	switch {
+	case duration <= 0:
+		return "", "", false
+	case duration%time.Hour == 0 && 24%int(duration/time.Hour) == 0:
+		hours := "*"
+		if h := int(duration / time.Hour); h > 1 {
+			hours = fmt.Sprintf("*/%d", h)
+		}
+
+		return fmt.Sprintf("0 %s * * *", hours), fmt.Sprintf("%d %s * * *", cronWindow, hours), true
+	case duration%time.Minute == 0 && 60%int(duration/time.Minute) == 0 && int(duration/time.Minute) > 2*cronWindow:
+		m := int(duration / time.Minute)
+
+		return fmt.Sprintf("*/%d * * * *", m), fmt.Sprintf("%d-59/%d * * * *", cronWindow, m), true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestCronWindowFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		start    string
+		end      string
+		ok       bool
+	}{
+		{name: "quartz daily", schedule: "0 30 2 * * ?", start: "25 2 * * *", end: "35 2 * * *", ok: true},
+		{name: "quartz week days", schedule: "0 0/1 8 ? * 2-6", ok: false},
+		{name: "quartz monday", schedule: "0 10 8 ? * 2 *", start: "5 8 * * 1", end: "15 8 * * 1", ok: true},
+		{name: "unix hourly wrap", schedule: "0 * * * *", start: "55 * * * *", end: "5 * * * *", ok: true},
+		{name: "wrap on specific hour", schedule: "0 2 4 * * ?", start: "57 3 * * *", end: "7 4 * * *", ok: true},
+		{name: "wrap on midnight", schedule: "0 0 0 * * ?", ok: false},
+		{name: "last day of month", schedule: "0 30 2 L * ?", ok: false},
+		{name: "year", schedule: "0 30 2 * * ? 2030", ok: false},
+		{name: "invalid", schedule: "every day", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := cronWindowFor(tt.schedule)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+}
+
+func TestTimerWindowFor(t *testing.T) {
+	tests := []struct {
+		period string
+		start  string
+		end    string
+		ok     bool
+	}{
+		{period: "1800000", start: "*/30 * * * *", end: "5-59/30 * * * *", ok: true},
+		{period: "15m", start: "*/15 * * * *", end: "5-59/15 * * * *", ok: true},
+		{period: "1h", start: "0 * * * *", end: "5 * * * *", ok: true},
+		{period: "6h", start: "0 */6 * * *", end: "5 */6 * * *", ok: true},
+		{period: "10m", ok: false},
+		{period: "7h", ok: false},
+		{period: "1000", ok: false},
+		{period: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			start, end, ok := timerWindowFor(tt.period)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+}
+
+func TestCronScalerMap(t *testing.T) {
+	trigger, err := keda.MapToKedaTrigger("cron:nightly?schedule=0+30+2+*+*+?")
+	require.NoError(t, err)
+	require.NotNil(t, trigger)
+	assert.Equal(t, "cron", trigger.Type)
+	assert.Equal(t, map[string]string{
+		"timezone":        "Etc/UTC",
+		"start":           "25 2 * * *",
+		"end":             "35 2 * * *",
+		"desiredReplicas": "1",
+	}, trigger.Metadata)
+
+	trigger, err = keda.MapToKedaTrigger("timer:tick?period=1h")
+	require.NoError(t, err)
+	require.NotNil(t, trigger)
+	assert.Equal(t, "0 * * * *", trigger.Metadata["start"])
+}
+
+func TestCronKameletsMap(t *testing.T) {
+	trigger, _ := keda.MapKameletToKedaTrigger("cron-source", map[string]string{"schedule": "0 0 6 * * ?"})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "55 5 * * *", trigger.Metadata["start"])
+
+	trigger, _ = keda.MapKameletToKedaTrigger("timer-source", map[string]string{"period": "3600000"})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "5 * * * *", trigger.Metadata["end"])
+}
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"fmt"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// GooglePubSubScaler maps the Google Pub/Sub consumers to the KEDA gcp-pubsub scaler.
+type GooglePubSubScaler struct{}
+
+// GooglePubSubKamelet maps the google-pubsub-source Kamelet to the KEDA gcp-pubsub scaler. Its service account
+// key is not mapped, as the Kamelet expects it encoded in base64 while the scaler expects the JSON content.
+type GooglePubSubKamelet struct{}
+
+func init() {
+	keda.Register(&GooglePubSubScaler{})
+	keda.RegisterKamelet(&GooglePubSubKamelet{})
+}
+
+func (s *GooglePubSubScaler) Component() string {
+	return "google-pubsub"
+}
+
+func (s *GooglePubSubScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	projectID, subscription, _ := strings.Cut(pathValue, ":")
+
+	return pubSubSubscription(projectID, subscription)
+}
+
+// Auth maps the service account key when it's loaded from a file, that can be mounted from a Secret.
+func (s *GooglePubSubScaler) Auth(params map[string]string) map[string]string {
+	auth := make(map[string]string)
+	if location := params["serviceAccountKey"]; strings.HasPrefix(location, "file:") {
+		auth["GoogleApplicationCredentials"] = strings.TrimPrefix(location, "file:")
+	}
+
+	return auth
+}
+
+func (k *GooglePubSubKamelet) Kamelet() string {
+	return "google-pubsub-source"
+}
+
+func (k *GooglePubSubKamelet) Map(props map[string]string) (string, map[string]string) {
+	return pubSubSubscription(props["projectId"], props["subscriptionName"])
+}
+
+func pubSubSubscription(projectID, subscription string) (string, map[string]string) {
+	if projectID == "" || subscription == "" {
+		return "", nil
+	}
+
+	return "gcp-pubsub", map[string]string{
+		"subscriptionName": fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscription),
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestGooglePubSubScalerMap(t *testing.T) {
+	trigger, auth, err := keda.MapToKedaTriggerWithAuth(
+		"google-pubsub:my-project:orders-sub?serviceAccountKey=file:/etc/camel/resources.d/_secrets/gcp/key.json")
+	require.NoError(t, err)
+	require.NotNil(t, trigger)
+	assert.Equal(t, "gcp-pubsub", trigger.Type)
+	assert.Equal(t, map[string]string{"subscriptionName": "projects/my-project/subscriptions/orders-sub"}, trigger.Metadata)
+	assert.Equal(t, map[string]string{
+		"GoogleApplicationCredentials": "/etc/camel/resources.d/_secrets/gcp/key.json",
+	}, auth)
+}
+
+func TestGooglePubSubKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("google-pubsub-source", map[string]string{
+		"projectId":         "my-project",
+		"subscriptionName":  "orders-sub",
+		"serviceAccountKey": "{{gcp.key}}",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "projects/my-project/subscriptions/orders-sub", trigger.Metadata["subscriptionName"])
+	assert.Nil(t, auth)
+
+	trigger, _ = keda.MapKameletToKedaTrigger("google-pubsub-source", map[string]string{"projectId": "my-project"})
+	assert.Nil(t, trigger)
+}
@@ -18,13 +18,19 @@ limitations under the License.
 package scalers
 
 import (
+	"strings"
+
 	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
 )
 
 type KafkaScaler struct{}
 
+// KafkaKamelet maps the kafka-source Kamelet to the KEDA kafka scaler.
+type KafkaKamelet struct{}
+
 func init() {
 	keda.Register(&KafkaScaler{})
+	keda.RegisterKamelet(&KafkaKamelet{})
 }
 func (k *KafkaScaler) Component() string {
 	return "kafka"
@@ -43,3 +49,44 @@ func (k *KafkaScaler) Map(pathValue string, params map[string]string) (string, m
 
 	return "kafka", metadata
 }
+
+func (k *KafkaKamelet) Kamelet() string {
+	return "kafka-source"
+}
+
+func (k *KafkaKamelet) Map(props map[string]string) (string, map[string]string) {
+	metadata := make(map[string]string)
+	keda.CopyParams(metadata, props, map[string]string{
+		"topic":            "topic",
+		"bootstrapServers": "bootstrapServers",
+		"consumerGroup":    "consumerGroup",
+	})
+	if metadata["topic"] == "" {
+		return "", nil
+	}
+	if props["user"] != "" {
+		// The Kamelet defaults to SASL_SSL with the PLAIN mechanism
+		metadata["sasl"] = "plaintext"
+		switch strings.ToUpper(props["saslMechanism"]) {
+		case "SCRAM-SHA-256":
+			metadata["sasl"] = "scram_sha256"
+		case "SCRAM-SHA-512":
+			metadata["sasl"] = "scram_sha512"
+		}
+		if protocol := props["securityProtocol"]; protocol == "" || strings.HasSuffix(protocol, "SSL") {
+			metadata["tls"] = "enable"
+		}
+	}
+
+	return "kafka", metadata
+}
+
+func (k *KafkaKamelet) Auth(props map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, props, map[string]string{
+		"user":     "username",
+		"password": "password",
+	})
+
+	return auth
+}
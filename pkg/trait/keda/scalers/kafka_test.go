@@ -106,3 +106,22 @@ func TestMapToKedaTriggerEmpty(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, trigger)
 }
+
+func TestKafkaKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("kafka-source", map[string]string{
+		"topic":            "orders",
+		"bootstrapServers": "broker:9092",
+		"user":             "{{kafka.user}}",
+		"password":         "{{kafka.password}}",
+		"saslMechanism":    "SCRAM-SHA-512",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "kafka", trigger.Type)
+	assert.Equal(t, map[string]string{
+		"topic":            "orders",
+		"bootstrapServers": "broker:9092",
+		"sasl":             "scram_sha512",
+		"tls":              "enable",
+	}, trigger.Metadata)
+	assert.Equal(t, map[string]string{"username": "{{kafka.user}}", "password": "{{kafka.password}}"}, auth)
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"fmt"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// PostgreSQLKamelet maps the postgresql-source Kamelet to the KEDA postgresql scaler, counting the rows
+// returned by the query polled by the Kamelet.
+type PostgreSQLKamelet struct{}
+
+func init() {
+	keda.RegisterKamelet(&PostgreSQLKamelet{})
+}
+
+func (k *PostgreSQLKamelet) Kamelet() string {
+	return "postgresql-source"
+}
+
+func (k *PostgreSQLKamelet) Map(props map[string]string) (string, map[string]string) {
+	query := strings.TrimSuffix(strings.TrimSpace(props["query"]), ";")
+	if query == "" || props["serverName"] == "" || props["databaseName"] == "" {
+		return "", nil
+	}
+	metadata := map[string]string{
+		"query":            fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS pending", query),
+		"targetQueryValue": "10",
+		"host":             props["serverName"],
+		"port":             "5432",
+		"dbName":           props["databaseName"],
+		"sslmode":          "prefer",
+	}
+	keda.CopyParams(metadata, props, map[string]string{
+		"serverPort": "port",
+		"username":   "userName",
+	})
+
+	return "postgresql", metadata
+}
+
+func (k *PostgreSQLKamelet) Auth(props map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, props, map[string]string{
+		"password": "password",
+	})
+
+	return auth
+}
@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestPostgreSQLKameletMap(t *testing.T) {
+	trigger, auth := keda.MapKameletToKedaTrigger("postgresql-source", map[string]string{
+		"serverName":   "postgres.db.svc",
+		"serverPort":   "5433",
+		"databaseName": "shop",
+		"username":     "camel",
+		"password":     "{{postgres.password}}",
+		"query":        "SELECT * FROM orders WHERE processed = false;",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "postgresql", trigger.Type)
+	assert.Equal(t, map[string]string{
+		"query":            "SELECT COUNT(*) FROM (SELECT * FROM orders WHERE processed = false) AS pending",
+		"targetQueryValue": "10",
+		"host":             "postgres.db.svc",
+		"port":             "5433",
+		"dbName":           "shop",
+		"userName":         "camel",
+		"sslmode":          "prefer",
+	}, trigger.Metadata)
+	assert.Equal(t, map[string]string{"password": "{{postgres.password}}"}, auth)
+}
+
+func TestPostgreSQLKameletMapWithoutQuery(t *testing.T) {
+	trigger, _ := keda.MapKameletToKedaTrigger("postgresql-source", map[string]string{
+		"serverName":   "postgres.db.svc",
+		"databaseName": "shop",
+	})
+	assert.Nil(t, trigger)
+}
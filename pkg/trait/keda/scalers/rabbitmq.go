@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// RabbitMQScaler maps the Spring RabbitMQ consumers to the KEDA rabbitmq scaler.
+type RabbitMQScaler struct{}
+
+// RabbitMQKamelet maps the rabbitmq-source Kamelet to the KEDA rabbitmq scaler.
+type RabbitMQKamelet struct{}
+
+func init() {
+	keda.Register(&RabbitMQScaler{})
+	keda.RegisterKamelet(&RabbitMQKamelet{})
+}
+
+func (s *RabbitMQScaler) Component() string {
+	return "spring-rabbitmq"
+}
+
+func (s *RabbitMQScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	// The consumer reads from the first declared queue, as the scaler monitors a single queue
+	queue, _, _ := strings.Cut(params["queues"], ",")
+	queue = strings.TrimSpace(queue)
+	if queue == "" {
+		return "", nil
+	}
+	metadata := map[string]string{
+		"queueName": queue,
+		"mode":      "QueueLength",
+		"value":     "20",
+	}
+	if address, _, _ := strings.Cut(params["addresses"], ","); strings.TrimSpace(address) != "" {
+		metadata["host"] = "amqp://" + strings.TrimSpace(address) + "/" + strings.TrimPrefix(params["vhost"], "/")
+	}
+
+	return "rabbitmq", metadata
+}
+
+func (s *RabbitMQScaler) Auth(params map[string]string) map[string]string {
+	auth := make(map[string]string)
+	keda.CopyParams(auth, params, map[string]string{
+		"username": "username",
+		"password": "password",
+	})
+
+	return auth
+}
+
+func (k *RabbitMQKamelet) Kamelet() string {
+	return "rabbitmq-source"
+}
+
+func (k *RabbitMQKamelet) Map(props map[string]string) (string, map[string]string) {
+	return (&RabbitMQScaler{}).Map(props["exchangeName"], props)
+}
+
+func (k *RabbitMQKamelet) Auth(props map[string]string) map[string]string {
+	return (&RabbitMQScaler{}).Auth(props)
+}
@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestRabbitMQScalerMap(t *testing.T) {
+	trigger, auth, err := keda.MapToKedaTriggerWithAuth(
+		"spring-rabbitmq:orders?queues=new-orders,old-orders&addresses=rabbitmq:5672&username={{rabbit.user}}&password={{rabbit.password}}")
+	require.NoError(t, err)
+	require.NotNil(t, trigger)
+	assert.Equal(t, "rabbitmq", trigger.Type)
+	assert.Equal(t, map[string]string{
+		"queueName": "new-orders",
+		"mode":      "QueueLength",
+		"value":     "20",
+		"host":      "amqp://rabbitmq:5672/",
+	}, trigger.Metadata)
+	assert.Equal(t, map[string]string{"username": "{{rabbit.user}}", "password": "{{rabbit.password}}"}, auth)
+}
+
+func TestRabbitMQScalerMapWithoutQueue(t *testing.T) {
+	trigger, err := keda.MapToKedaTrigger("spring-rabbitmq:orders?routingKey=new")
+	require.NoError(t, err)
+	assert.Nil(t, trigger)
+}
+
+func TestRabbitMQKameletMap(t *testing.T) {
+	trigger, _ := keda.MapKameletToKedaTrigger("rabbitmq-source", map[string]string{
+		"exchangeName": "orders",
+		"queues":       "new-orders",
+		"addresses":    "rabbitmq:5672",
+		"vhost":        "/shop",
+	})
+	require.NotNil(t, trigger)
+	assert.Equal(t, "new-orders", trigger.Metadata["queueName"])
+	assert.Equal(t, "amqp://rabbitmq:5672/shop", trigger.Metadata["host"])
+}
@@ -55,3 +55,15 @@ func ParseComponentURI(rawURI string) (string, string, map[string]string, error)
 
 	return scheme, pathValue, params, nil
 }
+
+// ParseKameletURI extracts the Kamelet name, the route id, and the query parameters from a `kamelet:` URI.
+// It returns an empty name if the URI doesn't reference a Kamelet.
+func ParseKameletURI(rawURI string) (string, string, map[string]string, error) {
+	scheme, pathValue, params, err := ParseComponentURI(rawURI)
+	if err != nil || scheme != "kamelet" {
+		return "", "", nil, err
+	}
+	name, id, _ := strings.Cut(strings.TrimPrefix(pathValue, "//"), "/")
+
+	return name, id, params, nil
+}
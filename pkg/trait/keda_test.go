@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -227,6 +228,160 @@ func TestKedaAuthentication(t *testing.T) {
 		triggerAuths[0].Spec.SecretTargetRef)
 }
 
+func TestKedaAutoDiscoveryKamelet(t *testing.T) {
+	environment := autoDiscoveryEnvWithSource(t, "", nil, nil)
+	client, err := internal.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "aws-credentials",
+			Namespace: "ns",
+		},
+		Data: map[string][]byte{
+			"aws.access": []byte("access"),
+			"aws.secret": []byte("secret"),
+		},
+	})
+	require.NoError(t, err)
+	environment.Ctx = context.TODO()
+	environment.Client = client
+	environment.Integration.Spec.Sources[0] = v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "source.yaml",
+			Content: `
+- route:
+    from:
+      uri: "kamelet:aws-sqs-source/source"
+      steps:
+        - to: "log:info"
+`,
+		},
+		Language: v1.LanguageYaml,
+	}
+	environment.Integration.Spec.AddConfigurationProperty("camel.kamelet.aws-sqs-source.region = eu-west-1")
+	environment.Integration.Spec.AddConfigurationProperty("camel.kamelet.aws-sqs-source.source.queueNameOrArn = orders")
+	environment.Integration.Spec.AddConfigurationProperty("camel.kamelet.aws-sqs-source.source.accessKey = {{aws.access}}")
+	environment.Integration.Spec.AddConfigurationProperty("camel.kamelet.aws-sqs-source.source.secretKey = {{aws.secret}}")
+	environment.Integration.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"secret:aws-credentials"},
+	}
+	traitCatalog := environment.Catalog
+
+	_, _, err = traitCatalog.apply(&environment)
+
+	require.NoError(t, err)
+	scaledObject := getKedaScaledObject(environment.Resources)
+	require.NotNil(t, scaledObject)
+	require.Len(t, scaledObject.Spec.Triggers, 1)
+	assert.Equal(t, "aws-sqs-queue", scaledObject.Spec.Triggers[0].Type)
+	assert.Equal(t, map[string]string{"queueURL": "orders", "awsRegion": "eu-west-1"}, scaledObject.Spec.Triggers[0].Metadata)
+	require.NotNil(t, scaledObject.Spec.Triggers[0].AuthenticationRef)
+	assert.Equal(t, "test-aws-sqs-queue", scaledObject.Spec.Triggers[0].AuthenticationRef.Name)
+	triggerAuths := getKedaTriggersAuth(environment.Resources)
+	require.Len(t, triggerAuths, 1)
+	assert.ElementsMatch(t,
+		[]v1alpha1.AuthSecretTargetRef{
+			{Name: "aws-credentials", Key: "aws.access", Parameter: "awsAccessKeyID"},
+			{Name: "aws-credentials", Key: "aws.secret", Parameter: "awsSecretAccessKey"},
+		},
+		triggerAuths[0].Spec.SecretTargetRef)
+}
+
+func TestKedaAutoDiscoveryCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		mount    *traitv1.MountTrait
+		expected []*traitv1.KedaSecret
+	}{
+		{
+			name:   "secret key mounted as config",
+			source: `from("azure-servicebus:orders?connectionString=RAW({{azure.connection}})").log("${body}");`,
+			mount: &traitv1.MountTrait{
+				Configs: []string{"secret:azure/connection", "secret:azure/azure.connection"},
+			},
+			expected: []*traitv1.KedaSecret{
+				{Name: "azure", Mapping: map[string]string{"azure.connection": "connection"}},
+			},
+		},
+		{
+			name:   "placeholder",
+			source: `from("spring-rabbitmq:orders?queues=new-orders&username={{rabbit.user}}&password={{rabbit.password:changeit}}").log("${body}");`,
+			mount: &traitv1.MountTrait{
+				Configs: []string{"secret:rabbit/rabbit.user", "secret:rabbit-password/rabbit.password"},
+			},
+			expected: []*traitv1.KedaSecret{
+				{Name: "rabbit", Mapping: map[string]string{"rabbit.user": "username"}},
+				{Name: "rabbit-password", Mapping: map[string]string{"rabbit.password": "password"}},
+			},
+		},
+		{
+			name:   "file mounted as resource",
+			source: `from("google-pubsub:my-project:orders?serviceAccountKey=file:/etc/camel/resources.d/_secrets/gcp/key.json").log("${body}");`,
+			mount: &traitv1.MountTrait{
+				Resources: []string{"secret:gcp"},
+			},
+			expected: []*traitv1.KedaSecret{
+				{Name: "gcp", Mapping: map[string]string{"key.json": "GoogleApplicationCredentials"}},
+			},
+		},
+		{
+			name:   "file mounted at a custom path",
+			source: `from("google-pubsub:my-project:orders?serviceAccountKey=file:/deployments/gcp.json").log("${body}");`,
+			mount: &traitv1.MountTrait{
+				Resources: []string{"secret:gcp/service-account.json@/deployments/gcp.json"},
+			},
+			expected: []*traitv1.KedaSecret{
+				{Name: "gcp", Mapping: map[string]string{"service-account.json": "GoogleApplicationCredentials"}},
+			},
+		},
+		{
+			name:     "inline credentials",
+			source:   `from("aws2-sqs:orders?accessKey=access&secretKey=secret").log("${body}");`,
+			mount:    &traitv1.MountTrait{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			environment := autoDiscoveryEnvWithSource(t, tt.source, nil, nil)
+			environment.Integration.Spec.Traits.Mount = tt.mount
+			traitCatalog := environment.Catalog
+
+			_, _, err := traitCatalog.apply(&environment)
+
+			require.NoError(t, err)
+			keda, ok := environment.GetTrait("keda").(*kedaTrait)
+			require.True(t, ok)
+			require.Len(t, keda.Triggers, 1)
+			assert.Equal(t, tt.expected, keda.Triggers[0].Secrets)
+		})
+	}
+}
+
+func TestKedaAutoDiscoverySameTypeAuthentications(t *testing.T) {
+	environment := autoDiscoveryEnvWithSource(t, `
+from("spring-rabbitmq:orders?queues=new-orders&password={{rabbit.password}}").log("${body}");
+from("spring-rabbitmq:invoices?queues=new-invoices&password={{rabbit.password}}").log("${body}");
+`, nil, nil)
+	environment.Integration.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"secret:rabbit/rabbit.password"},
+	}
+	traitCatalog := environment.Catalog
+
+	_, _, err := traitCatalog.apply(&environment)
+
+	require.NoError(t, err)
+	scaledObject := getKedaScaledObject(environment.Resources)
+	require.NotNil(t, scaledObject)
+	require.Len(t, scaledObject.Spec.Triggers, 2)
+	names := []string{
+		scaledObject.Spec.Triggers[0].AuthenticationRef.Name,
+		scaledObject.Spec.Triggers[1].AuthenticationRef.Name,
+	}
+	assert.ElementsMatch(t, []string{"test-rabbitmq", "test-rabbitmq-1"}, names)
+	assert.Len(t, getKedaTriggersAuth(environment.Resources), 2)
+}
+
 func getKedaScaledObject(c *kubernetes.Collection) *v1alpha1.ScaledObject {
 	var scaledObject *v1alpha1.ScaledObject
 	c.Visit(func(res runtime.Object) {
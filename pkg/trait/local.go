@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/envvar"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+// localTraits are the traits contributing to an Integration executed outside of the cluster.
+var localTraits = []ID{
+	camelTraitID,
+	dependenciesTraitID,
+	environmentTraitID,
+	mountTraitID,
+	jvmTraitID,
+}
+
+// ApplyLocal executes the subset of traits required to run an Integration on the local host, without any cluster.
+// The Integration in the initialization phase gets its dependencies computed, whilst the Integration in a running phase,
+// backed by a ready IntegrationKit, gets the integration container command, environment and volumes configured.
+func ApplyLocal(ctx context.Context, integration *v1.Integration, kit *v1.IntegrationKit, catalog *camel.RuntimeCatalog) (*Environment, error) {
+	if integration == nil {
+		return nil, errors.New("integration is not set")
+	}
+	if catalog == nil {
+		return nil, errors.New("camel catalog is not set")
+	}
+
+	env := Environment{
+		Ctx:                   ctx,
+		Platform:              platform.SingletonPlatform,
+		CamelCatalog:          catalog,
+		Catalog:               NewCatalog(nil),
+		IntegrationKit:        kit,
+		Integration:           integration,
+		ExecutedTraits:        make([]Trait, 0),
+		Resources:             kubernetes.NewCollection(),
+		EnvVars:               make([]corev1.EnvVar, 0),
+		ApplicationProperties: make(map[string]string),
+	}
+	if err := env.Catalog.Configure(&env); err != nil {
+		return nil, err
+	}
+
+	// The traits contributing to the integration container expect a workload to be available
+	env.Resources.Add(newLocalDeployment(&env))
+	container := env.GetIntegrationContainer()
+
+	for _, t := range env.Catalog.AllTraits() {
+		if !slices.Contains(localTraits, t.ID()) {
+			continue
+		}
+		enabled, _, err := t.Configure(&env)
+		if err != nil {
+			return nil, fmt.Errorf("%s trait configuration failed: %w", t.ID(), err)
+		}
+		if enabled {
+			if err := t.Apply(&env); err != nil {
+				return nil, fmt.Errorf("%s trait execution failed: %w", t.ID(), err)
+			}
+			env.ExecutedTraits = append(env.ExecutedTraits, t)
+		}
+		if t.ID() == environmentTraitID && env.IntegrationInRunningPhases() {
+			// Feed the container the same way the container trait does for in-cluster workloads
+			envvar.SetVal(&container.Env, "CAMEL_K_CONF", filepath.Join(camel.BasePath, "application.properties"))
+			envvar.SetVal(&container.Env, "CAMEL_K_CONF_D", camel.ConfDPath)
+			for _, v := range env.EnvVars {
+				// Values provided by the Kubernetes downward API cannot be resolved locally
+				if v.ValueFrom == nil {
+					envvar.SetVar(&container.Env, v)
+				}
+			}
+		}
+	}
+
+	return &env, nil
+}
+
+func newLocalDeployment(e *Environment) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: e.GetIntegrationContainerName(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
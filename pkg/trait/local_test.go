@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/envvar"
+)
+
+func newLocalIntegration(phase v1.IntegrationPhase) *v1.Integration {
+	it := v1.NewIntegration("default", "local")
+	it.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "Test.java",
+				Content: `from("timer:tick").to("log:info");`,
+			},
+			Language: v1.LanguageJavaSource,
+		},
+	}
+	it.Spec.Traits.Environment = &traitv1.EnvironmentTrait{
+		Vars: []string{"MY_VAR=my-value"},
+	}
+	it.Status.Phase = phase
+
+	return &it
+}
+
+func TestApplyLocalComputesDependencies(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it := newLocalIntegration(v1.IntegrationPhaseInitialization)
+
+	env, err := ApplyLocal(context.TODO(), it, nil, catalog)
+	require.NoError(t, err)
+
+	assert.Contains(t, it.Status.Dependencies, "camel:timer")
+	assert.Contains(t, it.Status.Dependencies, "camel:log")
+	assert.Nil(t, env.GetIntegrationContainer().Command)
+	assert.NotNil(t, env.GetTrait(dependenciesTraitID))
+	assert.Nil(t, env.GetTrait(jvmTraitID))
+}
+
+func TestApplyLocalConfiguresContainer(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it := newLocalIntegration(v1.IntegrationPhaseRunning)
+	kit := v1.NewIntegrationKit("default", "local")
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	kit.Status.Artifacts = []v1.Artifact{
+		{ID: "camel-core.jar", Target: "dependencies/lib/main/camel-core.jar"},
+	}
+
+	env, err := ApplyLocal(context.TODO(), it, kit, catalog)
+	require.NoError(t, err)
+
+	container := env.GetIntegrationContainer()
+	require.NotNil(t, container)
+	assert.Equal(t, []string{"java"}, container.Command)
+	assert.Contains(t, container.Args, catalog.Runtime.ApplicationClass)
+	assert.Contains(t, container.Args, "-cp")
+	assert.Equal(t, "my-value", envvar.Get(container.Env, "MY_VAR").Value)
+	assert.Equal(t, "local", envvar.Get(container.Env, "CAMEL_K_INTEGRATION").Value)
+	// Values provided by the downward API are not available locally
+	assert.Nil(t, envvar.Get(container.Env, "NAMESPACE"))
+
+	// The source is mounted from the configmap generated by the camel trait
+	assert.NotNil(t, env.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "local-source-000"
+	}))
+	mounts := make([]string, 0, len(container.VolumeMounts))
+	for _, m := range container.VolumeMounts {
+		mounts = append(mounts, m.MountPath)
+	}
+	assert.Contains(t, mounts, "/etc/camel/sources/Test.java")
+	assert.Contains(t, mounts, "/etc/camel/application.properties")
+	assert.Nil(t, env.GetTrait(dependenciesTraitID))
+}
@@ -126,13 +126,16 @@ func (t *masterTrait) Apply(e *Environment) error {
 			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, dep)
 		}
 	} else if e.IntegrationInRunningPhases() {
-		// Master trait requires the ServiceAccount certain privileges
-		privileges, err := t.prepareRBAC(e.Client, e.Integration.Spec.ServiceAccountName, e.Integration.Name, e.Integration.Namespace)
-		if err != nil {
-			return err
+		// Master trait requires the ServiceAccount certain privileges,
+		// which are granted by the rbac trait when it's enabled
+		if _, ok := rbacServiceAccount(e); !ok {
+			privileges, err := t.prepareRBAC(e.Client, e.Integration.Spec.ServiceAccountName, e.Integration.Name, e.Integration.Namespace)
+			if err != nil {
+				return err
+			}
+			// Add the RBAC privileges
+			e.Resources.AddAll(privileges)
 		}
-		// Add the RBAC privileges
-		e.Resources.AddAll(privileges)
 
 		if e.CamelCatalog.Runtime.Capabilities["master"].RuntimeProperties != nil {
 			t.setCatalogConfiguration(e)
@@ -38,6 +38,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/apache/camel-k/v2/pkg/util/property"
 	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 )
 
 const (
@@ -66,8 +67,11 @@ func (t *mountTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 	}
 	// Validate resources and pvcs
 	for _, c := range t.Configs {
-		if !strings.HasPrefix(c, "configmap:") && !strings.HasPrefix(c, "secret:") {
-			return false, nil, fmt.Errorf("unsupported config %s, must be a configmap or secret resource", c)
+		if strings.HasPrefix(c, "configmap:") || strings.HasPrefix(c, "secret:") {
+			continue
+		}
+		if _, err := secrets.ParseReference(c); err != nil {
+			return false, nil, fmt.Errorf("unsupported config %s, must be a configmap or secret resource, or an external secret reference", c)
 		}
 	}
 	for _, r := range t.Resources {
@@ -154,6 +158,10 @@ func (t *mountTrait) configureVolumesAndMounts(
 ) error {
 	for _, c := range t.Configs {
 		if conf, parseErr := utilResource.ParseConfig(c); parseErr == nil {
+			if conf.StorageType() == utilResource.StorageTypeExternal {
+				// External secrets are materialized and mounted all together
+				continue
+			}
 			// Let Camel parse these resources as properties
 			destFilePath := t.mountResource(vols, mnts, icnts, conf)
 			e.appendCloudPropertiesLocation(destFilePath)
@@ -161,6 +169,17 @@ func (t *mountTrait) configureVolumesAndMounts(
 			return parseErr
 		}
 	}
+	if secret, err := t.externalSecretFor(e); err != nil {
+		return err
+	} else if secret != nil {
+		e.Resources.Add(secret)
+		conf, err := utilResource.ParseConfig("secret:" + secret.Name)
+		if err != nil {
+			return err
+		}
+		destFilePath := t.mountResource(vols, mnts, icnts, conf)
+		e.appendCloudPropertiesLocation(destFilePath)
+	}
 	for _, r := range t.Resources {
 		if res, parseErr := utilResource.ParseResource(r); parseErr == nil {
 			t.mountResource(vols, mnts, icnts, res)
@@ -316,6 +335,75 @@ func (t *mountTrait) configureCamelVolumesAndMounts(e *Environment, vols *[]core
 	}
 }
 
+// externalSecretFor materializes the values of the external secret references declared as configs into a Secret
+// owned by the Integration, where each value is stored with the key of its reference. The values are refreshed each
+// time the Integration is reconciled, as the operator rolls the Integration when any of them changes.
+func (t *mountTrait) externalSecretFor(e *Environment) (*corev1.Secret, error) {
+	data := make(map[string][]byte)
+	var materialized *corev1.Secret
+	for _, ref := range ExternalSecretReferences(t.Configs) {
+		if _, ok := data[ref.Key]; ok {
+			return nil, fmt.Errorf("duplicate key %s in external secret references, each key must be unique", ref.Key)
+		}
+		value, err := secrets.Resolve(secrets.ProviderContext{
+			Ctx:       e.Ctx,
+			Client:    e.Client,
+			Namespace: e.Integration.Namespace,
+		}, ref)
+		if err != nil {
+			// Keep the last value materialized for the Integration, if any, so that a transient error
+			// of the provider doesn't fail a running Integration
+			if materialized == nil && e.Client != nil {
+				materialized, _ = kubernetes.GetSecret(e.Ctx, e.Client, ExternalSecretName(e.Integration), e.Integration.Namespace)
+			}
+			if materialized == nil || materialized.Data[ref.Key] == nil {
+				return nil, err
+			}
+			t.L.ForIntegration(e.Integration).Errorf(err, "Unable to resolve external secret %s, keeping its last value", ref.String())
+			value = materialized.Data[ref.Key]
+		}
+		data[ref.Key] = value
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ExternalSecretName(e.Integration),
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Data: data,
+	}, nil
+}
+
+// ExternalSecretName returns the name of the Secret materializing the values of the external secret references
+// of the Integration.
+func ExternalSecretName(integration *v1.Integration) string {
+	return integration.Name + "-external-secrets"
+}
+
+// ExternalSecretReferences returns the external secret references declared in the given mount configs.
+func ExternalSecretReferences(configs []string) []secrets.Reference {
+	refs := make([]secrets.Reference, 0)
+	for _, c := range configs {
+		if conf, err := utilResource.ParseConfig(c); err == nil && conf.StorageType() == utilResource.StorageTypeExternal {
+			if ref, err := secrets.ParseReference(conf.Name()); err == nil {
+				refs = append(refs, *ref)
+			}
+		}
+	}
+
+	return refs
+}
+
 // mountResource add the resource to volumes and mounts and return the final path where the resource is mounted.
 func (t *mountTrait) mountResource(vols *[]corev1.Volume, mnts *[]corev1.VolumeMount, icnts *[]corev1.Container, conf *utilResource.Config) string {
 	refName, confName := sanitizeVolumeName(conf.Name(), vols)
@@ -19,6 +19,8 @@ package trait
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +38,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/gzip"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 )
 
 func TestMountVolumesEmpty(t *testing.T) {
@@ -753,3 +756,85 @@ func TestCACertVolume(t *testing.T) {
 		return false
 	})
 }
+
+func TestMountExternalSecrets(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "password"), []byte("s3cr3t"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "user"), []byte("admin"), 0o600))
+	secrets.RegisterProvider(secrets.NewFileProvider("test-mount", dir))
+
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.Configs = []string{
+		"configmap:my-cm", "test-mount:app#password", "test-mount:app#user",
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool {
+		return s.Name == "hello-external-secrets"
+	})
+	require.NotNil(t, secret)
+	assert.Equal(t, "hello", secret.Labels[v1.IntegrationLabel])
+	assert.Equal(t, map[string][]byte{"password": []byte("s3cr3t"), "user": []byte("admin")}, secret.Data)
+
+	d := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Name == "hello"
+	})
+	require.NotNil(t, d)
+	assert.Contains(t, d.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: "hello-external-secrets",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "hello-external-secrets"},
+		},
+	})
+	assert.Contains(t, environment.ApplicationProperties["camel.main.cloud-properties-location"],
+		"/etc/camel/conf.d/_secrets/hello-external-secrets")
+}
+
+func TestMountExternalSecretsUnresolved(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.Configs = []string{"kubernetes:credentials#password"}
+
+	// The value can't be resolved and has never been materialized
+	_, _, err := traitCatalog.apply(environment)
+	require.ErrorContains(t, err, "secret credentials not found")
+
+	// The last value materialized for the Integration is kept
+	require.NoError(t, environment.Client.Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hello-external-secrets",
+			Namespace: environment.Integration.Namespace,
+		},
+		Data: map[string][]byte{"password": []byte("s3cr3t")},
+	}))
+	traitCatalog = NewCatalog(nil)
+	environment.Catalog = traitCatalog
+	environment.Resources = kubernetes.NewCollection()
+	_, _, err = traitCatalog.apply(environment)
+	require.NoError(t, err)
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool {
+		return s.Name == "hello-external-secrets"
+	})
+	require.NotNil(t, secret)
+	assert.Equal(t, map[string][]byte{"password": []byte("s3cr3t")}, secret.Data)
+}
+
+func TestMountExternalSecretsErrors(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.Configs = []string{"unknown:app#password"}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.ErrorContains(t, err, `unknown secret provider "unknown"`)
+
+	traitCatalog = NewCatalog(nil)
+	environment = getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.Configs = []string{"kubernetes:my-secret"}
+
+	_, _, err = traitCatalog.apply(environment)
+	require.ErrorContains(t, err, "unsupported config kubernetes:my-secret")
+}
@@ -0,0 +1,334 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	networkPolicyTraitID = "network-policy"
+
+	strimziClusterLabel    = "strimzi.io/cluster"
+	strimziBootstrapSuffix = "-kafka-bootstrap"
+)
+
+var (
+	defaultKnativeNamespaces = []string{"knative-eventing", "knative-serving"}
+
+	// clusterServiceHostRegexp matches the cluster local host names of Kubernetes Services, e.g., `svc.ns.svc.cluster.local:8080`.
+	clusterServiceHostRegexp = regexp.MustCompile(
+		`(?:^|[/=,@])([a-z0-9](?:[-a-z0-9]*[a-z0-9])?)\.([a-z0-9](?:[-a-z0-9]*[a-z0-9])?)\.svc(?:\.cluster\.local)?(?::(\d+))?`)
+)
+
+type networkPolicyTrait struct {
+	BaseTrait
+	traitv1.NetworkPolicyTrait `property:",squash"`
+}
+
+// serviceEndpoint is a Kubernetes Service referenced by the Integration routes.
+type serviceEndpoint struct {
+	scheme    string
+	name      string
+	namespace string
+	port      int32
+}
+
+func newNetworkPolicyTrait() Trait {
+	return &networkPolicyTrait{
+		BaseTrait: NewBaseTrait(networkPolicyTraitID, TraitOrderPostProcessResources),
+	}
+}
+
+func (t *networkPolicyTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	for _, cidr := range slices.Concat(t.IngressCIDRs, t.EgressCIDRs) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return false, nil, fmt.Errorf("invalid CIDR block %q: %w", cidr, err)
+		}
+	}
+	if len(t.KnativeNamespaces) == 0 {
+		t.KnativeNamespaces = defaultKnativeNamespaces
+	}
+
+	return true, nil, nil
+}
+
+func (t *networkPolicyTrait) Apply(e *Environment) error {
+	var uris []string
+	var fromKnative bool
+	if _, err := e.ConsumeMeta(false, func(meta metadata.IntegrationMetadata) bool {
+		uris = append(uris, meta.FromURIs...)
+		uris = append(uris, meta.ToURIs...)
+		fromKnative = slices.ContainsFunc(meta.FromURIs, isKnativeURI)
+
+		return true
+	}); err != nil {
+		return err
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return err
+	}
+
+	ingress := t.ingressRules(e, strategy == ControllerStrategyKnativeService || fromKnative)
+	egress, err := t.egressRules(e, uris)
+	if err != nil {
+		return err
+	}
+
+	e.Resources.Add(&networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    e.Integration.Labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress,
+			},
+			Ingress: ingress,
+			Egress:  egress,
+		},
+	})
+
+	return nil
+}
+
+// ingressRules allows the traffic towards the ports exposed by the Integration container and,
+// when the Integration is served or fed by Knative, from the Knative data plane.
+func (t *networkPolicyTrait) ingressRules(e *Environment, knative bool) []networkingv1.NetworkPolicyIngressRule {
+	rules := make([]networkingv1.NetworkPolicyIngressRule, 0, 2)
+
+	if container := e.GetIntegrationContainer(); container != nil && len(container.Ports) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{}
+		for _, port := range container.Ports {
+			rule.Ports = append(rule.Ports, networkPolicyPort(port.Protocol, intstr.FromInt32(port.ContainerPort)))
+		}
+		for _, ns := range t.IngressNamespaces {
+			rule.From = append(rule.From, namespacePeer(ns))
+		}
+		for _, cidr := range t.IngressCIDRs {
+			rule.From = append(rule.From, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		rules = append(rules, rule)
+	}
+
+	if knative {
+		rule := networkingv1.NetworkPolicyIngressRule{}
+		for _, ns := range t.KnativeNamespaces {
+			rule.From = append(rule.From, namespacePeer(ns))
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// egressRules allows the traffic towards the endpoints referenced by the Integration routes and
+// towards the explicitly allowed namespaces and CIDR blocks.
+func (t *networkPolicyTrait) egressRules(e *Environment, uris []string) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	rules := make([]networkingv1.NetworkPolicyEgressRule, 0)
+	add := func(rule networkingv1.NetworkPolicyEgressRule) {
+		if slices.ContainsFunc(rules, func(r networkingv1.NetworkPolicyEgressRule) bool { return reflect.DeepEqual(r, rule) }) {
+			return
+		}
+		rules = append(rules, rule)
+	}
+
+	if ptr.Deref(t.AllowDNS, true) {
+		add(networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolUDP, intstr.FromInt32(53)),
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(53)),
+			},
+		})
+	}
+
+	knative := false
+	for _, uri := range uris {
+		if isKnativeURI(uri) {
+			knative = true
+
+			continue
+		}
+		for _, endpoint := range serviceEndpointsFor(uri) {
+			rule, err := t.serviceEgressRule(e, endpoint)
+			if err != nil {
+				return nil, err
+			}
+			add(rule)
+		}
+	}
+
+	if knative {
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, ns := range append([]string{e.Integration.Namespace}, t.KnativeNamespaces...) {
+			rule.To = append(rule.To, namespacePeer(ns))
+		}
+		add(rule)
+	}
+
+	if len(t.EgressNamespaces) > 0 || len(t.EgressCIDRs) > 0 {
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, ns := range t.EgressNamespaces {
+			rule.To = append(rule.To, namespacePeer(ns))
+		}
+		for _, cidr := range t.EgressCIDRs {
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		add(rule)
+	}
+
+	return rules, nil
+}
+
+// serviceEgressRule allows the traffic towards the pods backing the given Service. The Kafka brokers of a
+// Strimzi cluster are selected by the cluster label, as the clients connect to each broker after the bootstrap.
+func (t *networkPolicyTrait) serviceEgressRule(e *Environment, endpoint serviceEndpoint) (networkingv1.NetworkPolicyEgressRule, error) {
+	peer := namespacePeer(endpoint.namespace)
+	rule := networkingv1.NetworkPolicyEgressRule{}
+	if endpoint.port != 0 {
+		rule.Ports = []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(endpoint.port))}
+	}
+
+	if cluster, ok := strings.CutSuffix(endpoint.name, strimziBootstrapSuffix); ok && endpoint.scheme == "kafka" {
+		peer.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{strimziClusterLabel: cluster}}
+		rule.To = []networkingv1.NetworkPolicyPeer{peer}
+
+		return rule, nil
+	}
+
+	if e.Client != nil {
+		svc, err := kubernetes.LookupService(e.Ctx, e.Client, endpoint.namespace, endpoint.name)
+		if err != nil {
+			return rule, err
+		}
+		if svc != nil && len(svc.Spec.Selector) > 0 {
+			peer.PodSelector = &metav1.LabelSelector{MatchLabels: svc.Spec.Selector}
+			rule.Ports = serviceTargetPorts(svc, endpoint.port)
+		}
+	}
+	rule.To = []networkingv1.NetworkPolicyPeer{peer}
+
+	return rule, nil
+}
+
+// serviceEndpointsFor returns the Kubernetes Services referenced by the given endpoint URI, either as host
+// (e.g., `http://svc.ns.svc.cluster.local`) or as parameter (e.g., `kafka:topic?brokers=svc.ns.svc:9092`).
+func serviceEndpointsFor(uri string) []serviceEndpoint {
+	if unescaped, err := url.QueryUnescape(uri); err == nil {
+		uri = unescaped
+	}
+	scheme, _, _ := strings.Cut(uri, ":")
+
+	endpoints := make([]serviceEndpoint, 0)
+	for _, match := range clusterServiceHostRegexp.FindAllStringSubmatch(uri, -1) {
+		endpoint := serviceEndpoint{
+			scheme:    scheme,
+			name:      match[1],
+			namespace: match[2],
+		}
+		if port, err := strconv.ParseInt(match[3], 10, 32); err == nil {
+			endpoint.port = int32(port)
+		} else if scheme == "https" {
+			endpoint.port = 443
+		} else if scheme == "http" {
+			endpoint.port = 80
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints
+}
+
+// serviceTargetPorts returns the pod ports targeted by the given Service port, or by all the Service ports
+// when the port is unknown.
+func serviceTargetPorts(svc *corev1.Service, port int32) []networkingv1.NetworkPolicyPort {
+	ports := make([]networkingv1.NetworkPolicyPort, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		if port != 0 && p.Port != port {
+			continue
+		}
+		target := p.TargetPort
+		if target.Type == intstr.Int && target.IntVal == 0 {
+			target = intstr.FromInt32(p.Port)
+		}
+		ports = append(ports, networkPolicyPort(p.Protocol, target))
+	}
+
+	return ports
+}
+
+func networkPolicyPort(protocol corev1.Protocol, port intstr.IntOrString) networkingv1.NetworkPolicyPort {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+
+	return networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(protocol),
+		Port:     ptr.To(port),
+	}
+}
+
+func namespacePeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				corev1.LabelMetadataName: namespace,
+			},
+		},
+	}
+}
+
+func isKnativeURI(uri string) bool {
+	return strings.HasPrefix(uri, "knative:")
+}
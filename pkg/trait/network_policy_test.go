@@ -0,0 +1,235 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestNetworkPolicyFromRoutes(t *testing.T) {
+	svc := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-svc",
+			Namespace: "other",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "my-app"},
+			Ports: []corev1.ServicePort{
+				{Port: 8080, TargetPort: intstr.FromInt32(9090)},
+				{Port: 8443, TargetPort: intstr.FromString("https")},
+			},
+		},
+	}
+	environment := createNetworkPolicyTestEnv(t, &traitv1.NetworkPolicyTrait{}, `
+- from:
+    uri: "platform-http:/hello"
+    steps:
+      - to: "http://my-svc.other.svc.cluster.local:8080/api"
+      - to:
+          uri: "kafka:my-topic"
+          parameters:
+            brokers: "my-cluster-kafka-bootstrap.kafka.svc:9092"
+`, svc)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	np := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, np)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: ServiceTestName}, np.Spec.PodSelector.MatchLabels)
+	assert.ElementsMatch(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, np.Spec.PolicyTypes)
+
+	require.Len(t, np.Spec.Ingress, 1)
+	assert.Empty(t, np.Spec.Ingress[0].From)
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{
+		networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(8080)),
+	}, np.Spec.Ingress[0].Ports)
+
+	require.Len(t, np.Spec.Egress, 3)
+	// DNS
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}}, np.Spec.Egress[0].To)
+	// Service
+	assert.Equal(t, networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "other"}},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-app"}},
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(9090))},
+	}, np.Spec.Egress[1])
+	// Strimzi cluster
+	assert.Equal(t, networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "kafka"}},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{strimziClusterLabel: "my-cluster"}},
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(9092))},
+	}, np.Spec.Egress[2])
+}
+
+func TestNetworkPolicyKnativeAndAllowances(t *testing.T) {
+	environment := createNetworkPolicyTestEnv(t, &traitv1.NetworkPolicyTrait{
+		IngressNamespaces: []string{"monitoring"},
+		IngressCIDRs:      []string{"10.1.0.0/16"},
+		EgressNamespaces:  []string{"shared"},
+		EgressCIDRs:       []string{"192.168.0.1/32"},
+		AllowDNS:          ptr.To(false),
+	}, `
+- from:
+    uri: "knative:endpoint/source"
+    steps:
+      - to: "knative:channel/messages"
+`)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	np := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, np)
+
+	require.Len(t, np.Spec.Ingress, 2)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		namespacePeer("monitoring"),
+		{IPBlock: &networkingv1.IPBlock{CIDR: "10.1.0.0/16"}},
+	}, np.Spec.Ingress[0].From)
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{
+		networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(8080)),
+	}, np.Spec.Ingress[0].Ports)
+	// the Knative data plane delivers the events
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		namespacePeer("knative-eventing"),
+		namespacePeer("knative-serving"),
+	}, np.Spec.Ingress[1].From)
+	assert.Empty(t, np.Spec.Ingress[1].Ports)
+
+	require.Len(t, np.Spec.Egress, 2)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		namespacePeer("ns"),
+		namespacePeer("knative-eventing"),
+		namespacePeer("knative-serving"),
+	}, np.Spec.Egress[0].To)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		namespacePeer("shared"),
+		{IPBlock: &networkingv1.IPBlock{CIDR: "192.168.0.1/32"}},
+	}, np.Spec.Egress[1].To)
+}
+
+func TestNetworkPolicyInvalidCIDR(t *testing.T) {
+	environment := createNetworkPolicyTestEnv(t, &traitv1.NetworkPolicyTrait{
+		EgressCIDRs: []string{"10.0.0.1"},
+	}, `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid CIDR block "10.0.0.1"`)
+}
+
+func TestServiceEndpointsFor(t *testing.T) {
+	assert.Equal(t, []serviceEndpoint{{scheme: "https", name: "svc", namespace: "ns", port: 443}},
+		serviceEndpointsFor("https://svc.ns.svc.cluster.local/path"))
+	assert.Equal(t, []serviceEndpoint{
+		{scheme: "kafka", name: "c-kafka-bootstrap", namespace: "kafka", port: 9092},
+		{scheme: "kafka", name: "other", namespace: "kafka", port: 9093},
+	}, serviceEndpointsFor("kafka:topic?brokers=c-kafka-bootstrap.kafka.svc%3A9092%2Cother.kafka.svc%3A9093"))
+	assert.Empty(t, serviceEndpointsFor("http://example.com/svc"))
+}
+
+func getNetworkPolicy(resources *kubernetes.Collection) *networkingv1.NetworkPolicy {
+	for _, res := range resources.Items() {
+		if np, ok := res.(*networkingv1.NetworkPolicy); ok {
+			return np
+		}
+	}
+
+	return nil
+}
+
+func createNetworkPolicyTestEnv(t *testing.T, np *traitv1.NetworkPolicyTrait, route string, objects ...runtime.Object) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	np.Enabled = ptr.To(true)
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "routes.yaml",
+							Content: route,
+						},
+						Language: v1.LanguageYaml,
+					},
+				},
+				Traits: v1.Traits{
+					NetworkPolicy: np,
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+}
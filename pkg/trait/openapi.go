@@ -0,0 +1,175 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	openAPITraitID    = "openapi"
+	openAPITraitOrder = 300
+
+	// The base path where the Integration serves the OpenAPI specs.
+	openAPIServePath = "/openapi"
+)
+
+var openAPIMissingOperations = []string{"fail", "ignore", "mock"}
+
+type openAPITrait struct {
+	BasePlatformTrait
+	traitv1.OpenAPITrait `property:",squash"`
+
+	paths []string
+}
+
+// openAPISpecFile is an OpenAPI spec stored in a ConfigMap.
+type openAPISpecFile struct {
+	configmap string
+	key       string
+	spec      *dsl.OpenAPISpec
+}
+
+func newOpenAPITrait() Trait {
+	return &openAPITrait{
+		BasePlatformTrait: NewBasePlatformTrait(openAPITraitID, openAPITraitOrder),
+	}
+}
+
+func (t *openAPITrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || len(t.Configmaps) == 0 {
+		return false, nil, nil
+	}
+	if t.MissingOperation != "" && !slices.Contains(openAPIMissingOperations, t.MissingOperation) {
+		return false, nil, fmt.Errorf("unsupported missing operation %q, must be one of %s",
+			t.MissingOperation, strings.Join(openAPIMissingOperations, ", "))
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseInitialization) || e.IntegrationInRunningPhases(), nil, nil
+}
+
+func (t *openAPITrait) Apply(e *Environment) error {
+	files, err := t.lookupSpecFiles(e)
+	if err != nil {
+		return err
+	}
+
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		// Generate the REST DSL routes, so that their dependencies are part of the kit
+		for idx, file := range files {
+			content, err := dsl.OpenAPIToYamlDSL("file:"+file.mountPath(), file.servePath(), t.MissingOperation)
+			if err != nil {
+				return err
+			}
+			e.Integration.Status.AddOrReplaceGeneratedSources(v1.SourceSpec{
+				DataSpec: v1.DataSpec{
+					Name:    fmt.Sprintf("%s-openapi-%03d.yaml", e.Integration.Name, idx),
+					Content: string(content),
+				},
+				Language: v1.LanguageYaml,
+			})
+		}
+
+		return nil
+	}
+
+	// Mount the specs, and make the exposed paths available to the traits exposing the Integration
+	mount, ok := e.Catalog.GetTrait(mountTraitID).(*mountTrait)
+	if !ok {
+		return fmt.Errorf("cannot mount the OpenAPI specs of %s: missing mount trait", e.Integration.Name)
+	}
+	t.paths = []string{openAPIServePath}
+	for _, file := range files {
+		util.StringSliceUniqueAdd(&mount.Resources, "configmap:"+file.configmap)
+		for _, prefix := range file.spec.PathPrefixes() {
+			util.StringSliceUniqueAdd(&t.paths, prefix)
+		}
+	}
+	slices.Sort(t.paths)
+
+	return nil
+}
+
+// lookupSpecFiles returns the OpenAPI specs stored in the ConfigMaps, sorted by ConfigMap and key.
+func (t *openAPITrait) lookupSpecFiles(e *Environment) ([]openAPISpecFile, error) {
+	files := make([]openAPISpecFile, 0, len(t.Configmaps))
+	served := make(map[string]string)
+	for _, name := range t.Configmaps {
+		cm, err := kubernetes.GetConfigMap(e.Ctx, e.Client, name, e.Integration.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the OpenAPI spec ConfigMap %s: %w", name, err)
+		}
+		keys := make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			switch strings.ToLower(filepath.Ext(key)) {
+			case ".yaml", ".yml", ".json":
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("the ConfigMap %s doesn't hold any OpenAPI spec (.yaml, .yml or .json)", name)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			spec, err := dsl.ParseOpenAPISpec([]byte(cm.Data[key]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid OpenAPI spec %s in ConfigMap %s: %w", key, name, err)
+			}
+			// The specs are served by file name
+			if other, ok := served[key]; ok && other != name {
+				return nil, fmt.Errorf("the OpenAPI spec %s is provided by both the ConfigMaps %s and %s", key, other, name)
+			}
+			served[key] = name
+			files = append(files, openAPISpecFile{configmap: name, key: key, spec: spec})
+		}
+	}
+
+	return files, nil
+}
+
+// mountPath returns the path where the spec is mounted as a resource.
+func (f openAPISpecFile) mountPath() string {
+	return filepath.Join(camel.ResourcesConfigmapsMountPath, f.configmap, f.key)
+}
+
+// servePath returns the path where the Integration serves the spec.
+func (f openAPISpecFile) servePath() string {
+	return openAPIServePath + "/" + f.key
+}
+
+// openAPIPaths returns the prefixes of the paths exposed by the OpenAPI specs of the Integration, if any.
+func openAPIPaths(e *Environment) []string {
+	if e.Catalog == nil {
+		return nil
+	}
+	if t, ok := e.Catalog.GetTrait(openAPITraitID).(*openAPITrait); ok {
+		return t.paths
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const openAPITestSpec = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+  /pets/{petId}:
+    get:
+      operationId: getPet
+`
+
+func TestOpenAPIGeneratesRoutes(t *testing.T) {
+	environment := createOpenAPITestEnv(t, v1.IntegrationPhaseInitialization, map[string]string{
+		"petstore.yaml": openAPITestSpec,
+		"README.md":     "not a spec",
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	sources := environment.Integration.Status.GeneratedSources
+	require.Len(t, sources, 1)
+	assert.Equal(t, ServiceTestName+"-openapi-000.yaml", sources[0].Name)
+	assert.Equal(t, v1.LanguageYaml, sources[0].Language)
+	assert.Contains(t, sources[0].Content, "specification: file:/etc/camel/resources.d/_configmaps/petstore/petstore.yaml")
+	assert.Contains(t, sources[0].Content, "missingOperation: mock")
+	assert.Contains(t, sources[0].Content, "uri: platform-http:/openapi/petstore.yaml?httpMethodRestrict=GET")
+}
+
+func TestOpenAPIMountsSpecs(t *testing.T) {
+	environment := createOpenAPITestEnv(t, v1.IntegrationPhaseDeploying, map[string]string{
+		"petstore.yaml": openAPITestSpec,
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	mount, ok := environment.Catalog.GetTrait(mountTraitID).(*mountTrait)
+	require.True(t, ok)
+	assert.Contains(t, mount.Resources, "configmap:petstore")
+	assert.Equal(t, []string{"/openapi", "/pets"}, openAPIPaths(&environment))
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	mounted := false
+	for _, m := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+		if m.MountPath == camel.ResourcesConfigmapsMountPath+"/petstore" {
+			mounted = true
+		}
+	}
+	assert.True(t, mounted)
+}
+
+func TestOpenAPIInvalidSpec(t *testing.T) {
+	environment := createOpenAPITestEnv(t, v1.IntegrationPhaseInitialization, map[string]string{
+		"petstore.yaml": "openapi: 2.0\npaths: {}\n",
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid OpenAPI spec petstore.yaml in ConfigMap petstore")
+}
+
+func TestOpenAPIMissingConfigMap(t *testing.T) {
+	environment := createOpenAPITestEnv(t, v1.IntegrationPhaseInitialization, nil)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot get the OpenAPI spec ConfigMap petstore")
+}
+
+func TestOpenAPIInvalidMissingOperation(t *testing.T) {
+	environment := createOpenAPITestEnv(t, v1.IntegrationPhaseInitialization, map[string]string{
+		"petstore.yaml": openAPITestSpec,
+	})
+	environment.Integration.Spec.Traits.OpenAPI.MissingOperation = "skip"
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported missing operation "skip", must be one of fail, ignore, mock`)
+}
+
+func createOpenAPITestEnv(t *testing.T, phase v1.IntegrationPhase, data map[string]string) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	if data != nil {
+		require.NoError(t, client.Create(context.Background(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "petstore",
+				Namespace: "ns",
+			},
+			Data: data,
+		}))
+	}
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: phase,
+			},
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					OpenAPI: &traitv1.OpenAPITrait{
+						Configmaps:       []string{"petstore"},
+						MissingOperation: "mock",
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+}
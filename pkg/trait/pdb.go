@@ -19,6 +19,7 @@ package trait
 
 import (
 	"errors"
+	"strconv"
 
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +70,10 @@ func (t *pdbTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 func (t *pdbTrait) Apply(e *Environment) error {
 	if t.MaxUnavailable == "" && t.MinAvailable == "" {
 		t.MaxUnavailable = "1"
+		// A disruption must not unbalance the pods more than the spread constraints allow
+		if maxSkew, spread := affinitySpreadMaxSkew(e); spread {
+			t.MaxUnavailable = strconv.Itoa(int(maxSkew))
+		}
 	}
 
 	pdb := t.podDisruptionBudgetFor(e.Integration)
@@ -107,3 +112,16 @@ func (t *pdbTrait) podDisruptionBudgetFor(integration *v1.Integration) *policyv1
 
 	return pdb
 }
+
+// affinitySpreadMaxSkew returns the maximum skew of the spread constraints of the affinity trait, if it spreads the pods.
+func affinitySpreadMaxSkew(e *Environment) (int32, bool) {
+	if e.Catalog == nil {
+		return 0, false
+	}
+	affinity, ok := e.Catalog.GetTrait(affinityTraitID).(*affinityTrait)
+	if !ok {
+		return 0, false
+	}
+
+	return affinity.spreadMaxSkew()
+}
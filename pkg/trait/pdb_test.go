@@ -76,6 +76,18 @@ func TestPdbIsCreatedWithMinAvailable(t *testing.T) {
 	assert.Equal(t, int32(2), pdb.Spec.MinAvailable.IntVal)
 }
 
+func TestPdbMaxUnavailableFollowsAffinitySpread(t *testing.T) {
+	pdbTrait, environment, _ := createPdbTest()
+	environment.Catalog = NewCatalog(nil)
+	affinity, _ := environment.Catalog.GetTrait(affinityTraitID).(*affinityTrait)
+	affinity.Enabled = ptr.To(true)
+	affinity.ZoneSpread = ptr.To(true)
+	affinity.MaxSkew = ptr.To(int32(2))
+
+	pdb := pdbCreatedCheck(t, pdbTrait, environment)
+	assert.Equal(t, int32(2), pdb.Spec.MaxUnavailable.IntVal)
+}
+
 func pdbCreatedCheck(t *testing.T, pdbTrait *pdbTrait, environment *Environment) *policyv1.PodDisruptionBudget {
 	t.Helper()
 
@@ -0,0 +1,399 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	authorization "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+const (
+	rbacTraitID = "rbac"
+)
+
+// rbacResource is a namespaced resource the Integration is granted access to.
+type rbacResource struct {
+	group    string
+	resource string
+}
+
+var (
+	// rbacComponentResources maps the Camel components to the namespaced resources they access.
+	rbacComponentResources = map[string]rbacResource{
+		"kubernetes-config-maps":               {resource: "configmaps"},
+		"kubernetes-cronjob":                   {group: "batch", resource: "cronjobs"},
+		"kubernetes-deployments":               {group: "apps", resource: "deployments"},
+		"kubernetes-events":                    {resource: "events"},
+		"kubernetes-hpa":                       {group: "autoscaling", resource: "horizontalpodautoscalers"},
+		"kubernetes-job":                       {group: "batch", resource: "jobs"},
+		"kubernetes-persistent-volumes-claims": {resource: "persistentvolumeclaims"},
+		"kubernetes-pods":                      {resource: "pods"},
+		"kubernetes-replication-controllers":   {resource: "replicationcontrollers"},
+		"kubernetes-resources-quota":           {resource: "resourcequotas"},
+		"kubernetes-secrets":                   {resource: "secrets"},
+		"kubernetes-service-accounts":          {resource: "serviceaccounts"},
+		"kubernetes-services":                  {resource: "services"},
+		"openshift-build-configs":              {group: "build.openshift.io", resource: "buildconfigs"},
+		"openshift-builds":                     {group: "build.openshift.io", resource: "builds"},
+		"openshift-deploymentconfigs":          {group: "apps.openshift.io", resource: "deploymentconfigs"},
+	}
+	// rbacUnsupportedComponents are the Camel components accessing cluster scoped, or arbitrary, resources,
+	// whose permissions can't be derived into a Role.
+	rbacUnsupportedComponents = []string{
+		"kubernetes-custom-resources",
+		"kubernetes-namespaces",
+		"kubernetes-nodes",
+		"kubernetes-persistent-volumes",
+	}
+
+	rbacConsumerVerbs = []string{"get", "list", "watch"}
+	rbacProducerVerbs = []string{"create", "delete", "get", "list", "patch"}
+	// rbacLockVerbs are the verbs required on the lock used for the leader election.
+	rbacLockVerbs = []string{"create", "delete", "get", "list", "patch", "update"}
+	// rbacOperationVerbs maps the prefix of the producer operations to the verb they require.
+	rbacOperationVerbs = map[string]string{
+		"create": "create",
+		"delete": "delete",
+		"get":    "get",
+		"list":   "list",
+		"update": "update",
+	}
+)
+
+type rbacTrait struct {
+	BaseTrait
+	traitv1.RBACTrait `property:",squash"`
+
+	rules []rbacv1.PolicyRule
+}
+
+func newRBACTrait() Trait {
+	return &rbacTrait{
+		BaseTrait: NewBaseTrait(rbacTraitID, TraitOrderPostProcessResources),
+	}
+}
+
+func (t *rbacTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if e.Integration.Spec.ServiceAccountName != "" {
+		return false, NewIntegrationCondition(
+			rbacTraitID,
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			fmt.Sprintf("the integration runs with the %s ServiceAccount: no RBAC is generated", e.Integration.Spec.ServiceAccountName),
+		), nil
+	}
+
+	verbs := make(map[rbacResource][]string)
+	for _, rule := range t.Rules {
+		resource, ruleVerbs, err := parseRBACRule(rule)
+		if err != nil {
+			return false, nil, err
+		}
+		addRBACVerbs(verbs, resource, ruleVerbs...)
+	}
+
+	var unsupported []string
+	if ptr.Deref(t.Auto, true) {
+		if _, err := e.ConsumeMeta(false, func(meta metadata.IntegrationMetadata) bool {
+			for _, endpoint := range meta.FromURIs {
+				unsupported = append(unsupported, t.addComponentVerbs(e, verbs, endpoint, false)...)
+			}
+			for _, endpoint := range meta.ToURIs {
+				unsupported = append(unsupported, t.addComponentVerbs(e, verbs, endpoint, true)...)
+			}
+
+			return true
+		}); err != nil {
+			return false, nil, err
+		}
+	}
+	denied, err := t.removeDeniedVerbs(e, verbs)
+	if err != nil {
+		return false, nil, err
+	}
+	t.rules = policyRules(verbs)
+
+	var messages []string
+	if len(unsupported) > 0 {
+		slices.Sort(unsupported)
+		messages = append(messages, fmt.Sprintf("the %s components require permissions that can't be derived: they must be granted explicitly",
+			strings.Join(slices.Compact(unsupported), ", ")))
+	}
+	if len(denied) > 0 {
+		messages = append(messages, fmt.Sprintf("the %s permissions are not held by the operator: they must be granted to the %s ServiceAccount explicitly",
+			strings.Join(denied, ", "), e.Integration.Name))
+	}
+	var condition *TraitCondition
+	if len(messages) > 0 {
+		condition = NewIntegrationCondition(
+			rbacTraitID,
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			strings.Join(messages, "; "),
+		)
+	}
+
+	return true, condition, nil
+}
+
+// removeDeniedVerbs removes the verbs the operator can't grant, as it doesn't hold them in the Integration namespace,
+// and returns them in the `<resource>[.<api-group>]:<verb>` form.
+func (t *rbacTrait) removeDeniedVerbs(e *Environment, verbs map[rbacResource][]string) ([]string, error) {
+	if e.Client == nil || len(verbs) == 0 {
+		return nil, nil
+	}
+	// Retrieve the permissions granted to the operator ServiceAccount, as it is not allowed to escalate its own privileges
+	ssrr := &authorization.SelfSubjectRulesReview{
+		Spec: authorization.SelfSubjectRulesReviewSpec{
+			Namespace: e.Integration.Namespace,
+		},
+	}
+	ssrr, err := e.Client.AuthorizationV1().SelfSubjectRulesReviews().Create(e.Ctx, ssrr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var denied []string
+	for resource, resourceVerbs := range verbs {
+		granted := make([]string, 0, len(resourceVerbs))
+		for _, verb := range resourceVerbs {
+			if rbacRulesAllow(ssrr.Status.ResourceRules, resource, verb) {
+				granted = append(granted, verb)
+			} else {
+				denied = append(denied, resource.String()+":"+verb)
+			}
+		}
+		if len(granted) == 0 {
+			delete(verbs, resource)
+		} else {
+			verbs[resource] = granted
+		}
+	}
+	slices.Sort(denied)
+
+	return denied, nil
+}
+
+// rbacRulesAllow returns true if the rules grant the verb on any object of the resource.
+func rbacRulesAllow(rules []authorization.ResourceRule, resource rbacResource, verb string) bool {
+	for _, rule := range rules {
+		if len(rule.ResourceNames) > 0 {
+			continue
+		}
+		if util.StringSliceContainsAnyOf(rule.Verbs, verb, "*") &&
+			util.StringSliceContainsAnyOf(rule.APIGroups, resource.group, "*") &&
+			util.StringSliceContainsAnyOf(rule.Resources, resource.resource, "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addComponentVerbs adds the verbs required by the Camel component of the given endpoint, and returns the component
+// when its permissions can't be derived.
+func (t *rbacTrait) addComponentVerbs(e *Environment, verbs map[rbacResource][]string, endpoint string, producer bool) []string {
+	component := uri.GetComponent(endpoint)
+	if component == masterComponent {
+		// The lock used for the leader election, and the pods contending it
+		lock := rbacResource{group: "coordination.k8s.io", resource: "leases"}
+		if e.Catalog != nil {
+			if master, ok := e.Catalog.GetTrait(masterComponent).(*masterTrait); ok && strings.EqualFold(ptr.Deref(master.ResourceType, ""), "ConfigMap") {
+				lock = rbacResource{resource: "configmaps"}
+			}
+		}
+		addRBACVerbs(verbs, lock, rbacLockVerbs...)
+		addRBACVerbs(verbs, rbacResource{resource: "pods"}, rbacConsumerVerbs...)
+
+		return nil
+	}
+	if slices.Contains(rbacUnsupportedComponents, component) {
+		return []string{component}
+	}
+	resource, ok := rbacComponentResources[component]
+	if !ok {
+		return nil
+	}
+	if !producer {
+		addRBACVerbs(verbs, resource, rbacConsumerVerbs...)
+
+		return nil
+	}
+
+	// The operation can also be set with a header, in which case any operation is granted
+	operation := uri.GetQueryParameter(endpoint, "operation")
+	for prefix, verb := range rbacOperationVerbs {
+		if operation != "" && strings.HasPrefix(operation, prefix) {
+			addRBACVerbs(verbs, resource, verb)
+
+			return nil
+		}
+	}
+	addRBACVerbs(verbs, resource, rbacProducerVerbs...)
+
+	return nil
+}
+
+func (t *rbacTrait) Apply(e *Environment) error {
+	name := e.Integration.Name
+	e.Resources.Add(&corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: t.objectMeta(e),
+	})
+	if len(t.rules) > 0 {
+		e.Resources.Add(&rbacv1.Role{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Role",
+				APIVersion: rbacv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: t.objectMeta(e),
+			Rules:      t.rules,
+		})
+		e.Resources.Add(&rbacv1.RoleBinding{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "RoleBinding",
+				APIVersion: rbacv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: t.objectMeta(e),
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      rbacv1.ServiceAccountKind,
+					Namespace: e.Integration.Namespace,
+					Name:      name,
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     name,
+			},
+		})
+	}
+
+	if podSpec := e.GetIntegrationPodSpec(); podSpec != nil {
+		podSpec.ServiceAccountName = name
+	}
+
+	return nil
+}
+
+func (t *rbacTrait) objectMeta(e *Environment) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      e.Integration.Name,
+		Namespace: e.Integration.Namespace,
+		Labels: map[string]string{
+			v1.IntegrationLabel: e.Integration.Name,
+		},
+	}
+}
+
+// parseRBACRule parses a rule in the form `<resource>[.<api-group>]:<verb>[,<verb>...]`.
+func parseRBACRule(rule string) (rbacResource, []string, error) {
+	resource, verbs, ok := strings.Cut(rule, ":")
+	resource = strings.TrimSpace(resource)
+	if !ok || resource == "" || strings.TrimSpace(verbs) == "" {
+		return rbacResource{}, nil, fmt.Errorf("invalid rule %q: it must be in the form <resource>[.<api-group>]:<verb>[,<verb>...]", rule)
+	}
+	name, group, _ := strings.Cut(resource, ".")
+	ruleVerbs := make([]string, 0)
+	for verb := range strings.SplitSeq(verbs, ",") {
+		if verb = strings.TrimSpace(verb); verb != "" {
+			ruleVerbs = append(ruleVerbs, verb)
+		}
+	}
+
+	return rbacResource{group: group, resource: name}, ruleVerbs, nil
+}
+
+func (r rbacResource) String() string {
+	if r.group == "" {
+		return r.resource
+	}
+
+	return r.resource + "." + r.group
+}
+
+func addRBACVerbs(verbs map[rbacResource][]string, resource rbacResource, add ...string) {
+	resourceVerbs := verbs[resource]
+	for _, verb := range add {
+		util.StringSliceUniqueAdd(&resourceVerbs, verb)
+	}
+	verbs[resource] = resourceVerbs
+}
+
+// policyRules returns one rule per resource, sorted by API group and resource.
+func policyRules(verbs map[rbacResource][]string) []rbacv1.PolicyRule {
+	resources := make([]rbacResource, 0, len(verbs))
+	for resource := range verbs {
+		resources = append(resources, resource)
+	}
+	slices.SortFunc(resources, func(a, b rbacResource) int {
+		if c := strings.Compare(a.group, b.group); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.resource, b.resource)
+	})
+
+	rules := make([]rbacv1.PolicyRule, 0, len(resources))
+	for _, resource := range resources {
+		resourceVerbs := slices.Clone(verbs[resource])
+		slices.Sort(resourceVerbs)
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{resource.group},
+			Resources: []string{resource.resource},
+			Verbs:     resourceVerbs,
+		})
+	}
+
+	return rules
+}
+
+// rbacServiceAccount returns the ServiceAccount generated by the rbac trait, if it's enabled.
+func rbacServiceAccount(e *Environment) (string, bool) {
+	if e.Catalog == nil || e.Integration.Spec.ServiceAccountName != "" {
+		return "", false
+	}
+	rbac, ok := e.Catalog.GetTrait(rbacTraitID).(*rbacTrait)
+	if !ok || !ptr.Deref(rbac.Enabled, false) {
+		return "", false
+	}
+
+	return e.Integration.Name, true
+}
@@ -0,0 +1,308 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authorization "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestRBACFromComponents(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{}, `
+- from:
+    uri: "master:lock:kubernetes-pods:default"
+    steps:
+      - to: "kubernetes-config-maps:default?operation=listConfigMaps"
+      - to: "kubernetes-deployments:default"
+      - to: "kubernetes-nodes:default?operation=listNodes"
+`)
+
+	conditions, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	sa := getRBACResource[*corev1.ServiceAccount](environment.Resources)
+	require.NotNil(t, sa)
+	assert.Equal(t, ServiceTestName, sa.Name)
+	assert.Equal(t, "ns", sa.Namespace)
+
+	role := getRBACResource[*rbacv1.Role](environment.Resources)
+	require.NotNil(t, role)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"list"}},
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "watch"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"create", "delete", "get", "list", "patch"}},
+		{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"create", "delete", "get", "list", "patch", "update"}},
+	}, role.Rules)
+
+	binding := getRBACResource[*rbacv1.RoleBinding](environment.Resources)
+	require.NotNil(t, binding)
+	assert.Equal(t, rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: ServiceTestName}, binding.RoleRef)
+	assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "ns", Name: ServiceTestName}}, binding.Subjects)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ServiceTestName, deployment.Spec.Template.Spec.ServiceAccountName)
+	// The RBAC of the master trait is superseded
+	roles := 0
+	for _, r := range environment.Resources.Items() {
+		if _, ok := r.(*rbacv1.Role); ok {
+			roles++
+		}
+	}
+	assert.Equal(t, 1, roles)
+
+	var condition *TraitCondition
+	for _, c := range conditions {
+		if c.traitID == rbacTraitID {
+			condition = c
+		}
+	}
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "the kubernetes-nodes components require permissions that can't be derived")
+}
+
+func TestRBACExplicitRules(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{
+		Auto:  ptr.To(false),
+		Rules: []string{"deployments.apps:get, patch", "leases.coordination.k8s.io:get", "configmaps:get"},
+	}, `
+- from:
+    uri: "kubernetes-pods:default"
+    steps:
+      - to: "log:info"
+`)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	role := getRBACResource[*rbacv1.Role](environment.Resources)
+	require.NotNil(t, role)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "patch"}},
+		{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"get"}},
+	}, role.Rules)
+}
+
+func TestRBACDeniedVerbs(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{
+		Rules: []string{"pods:get,update", "deploymentconfigs.apps.openshift.io:get"},
+	}, `
+- from:
+    uri: "kubernetes-resources-quota:default"
+    steps:
+      - to: "kubernetes-config-maps:default?operation=updateConfigMap"
+`)
+
+	conditions, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	role := getRBACResource[*rbacv1.Role](environment.Resources)
+	require.NotNil(t, role)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+	}, role.Rules)
+
+	var condition *TraitCondition
+	for _, c := range conditions {
+		if c.traitID == rbacTraitID {
+			condition = c
+		}
+	}
+	require.NotNil(t, condition)
+	assert.Equal(t, "the configmaps:update, deploymentconfigs.apps.openshift.io:get, pods:update, resourcequotas:get, resourcequotas:list, "+
+		"resourcequotas:watch permissions are not held by the operator: they must be granted to the "+ServiceTestName+" ServiceAccount explicitly",
+		condition.message)
+}
+
+func TestRBACWithoutRules(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{}, `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	assert.NotNil(t, getRBACResource[*corev1.ServiceAccount](environment.Resources))
+	assert.Nil(t, getRBACResource[*rbacv1.Role](environment.Resources))
+	assert.Nil(t, getRBACResource[*rbacv1.RoleBinding](environment.Resources))
+}
+
+func TestRBACInvalidRule(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{Rules: []string{"configmaps"}}, `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid rule "configmaps"`)
+}
+
+func TestRBACWithServiceAccount(t *testing.T) {
+	environment := createRBACTestEnv(t, &traitv1.RBACTrait{}, `
+- from:
+    uri: "kubernetes-pods:default"
+    steps:
+      - to: "log:info"
+`)
+	environment.Integration.Spec.ServiceAccountName = "my-sa"
+
+	conditions, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	assert.Nil(t, getRBACResource[*corev1.ServiceAccount](environment.Resources))
+	assert.Nil(t, getRBACResource[*rbacv1.Role](environment.Resources))
+	var condition *TraitCondition
+	for _, c := range conditions {
+		if c.traitID == rbacTraitID {
+			condition = c
+		}
+	}
+	require.NotNil(t, condition)
+	assert.Equal(t, "the integration runs with the my-sa ServiceAccount: no RBAC is generated", condition.message)
+}
+
+func TestParseRBACRule(t *testing.T) {
+	resource, verbs, err := parseRBACRule("leases.coordination.k8s.io:get,list")
+	require.NoError(t, err)
+	assert.Equal(t, rbacResource{group: "coordination.k8s.io", resource: "leases"}, resource)
+	assert.Equal(t, []string{"get", "list"}, verbs)
+
+	_, _, err = parseRBACRule(":get")
+	require.Error(t, err)
+	_, _, err = parseRBACRule("pods:")
+	require.Error(t, err)
+}
+
+func getRBACResource[T *corev1.ServiceAccount | *rbacv1.Role | *rbacv1.RoleBinding](resources *kubernetes.Collection) T {
+	for _, r := range resources.Items() {
+		if res, ok := r.(T); ok {
+			return res
+		}
+	}
+
+	return nil
+}
+
+func createRBACTestEnv(t *testing.T, rbac *traitv1.RBACTrait, route string) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	// The permissions held by the operator
+	fakeClient, ok := client.(*internal.FakeClient)
+	require.True(t, ok)
+	clientset, ok := fakeClient.Interface.(*fakeclientset.Clientset)
+	require.True(t, ok)
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorization.SelfSubjectRulesReview{
+			Status: authorization.SubjectRulesReviewStatus{
+				ResourceRules: []authorization.ResourceRule{
+					{
+						APIGroups: []string{""},
+						Resources: []string{"configmaps", "pods", "secrets"},
+						Verbs:     []string{"create", "delete", "get", "list", "patch", "watch"},
+					},
+					{
+						APIGroups: []string{"apps"},
+						Resources: []string{"deployments"},
+						Verbs:     []string{"create", "delete", "get", "list", "patch", "watch"},
+					},
+					{
+						APIGroups: []string{"coordination.k8s.io"},
+						Resources: []string{"leases"},
+						Verbs:     []string{"*"},
+					},
+					{
+						APIGroups:     []string{""},
+						Resources:     []string{"resourcequotas"},
+						ResourceNames: []string{"my-quota"},
+						Verbs:         []string{"get"},
+					},
+				},
+			},
+		}, nil
+	})
+
+	rbac.Enabled = ptr.To(true)
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "routes.yaml",
+							Content: route,
+						},
+						Language: v1.LanguageYaml,
+					},
+				},
+				Traits: v1.Traits{
+					RBAC: rbac,
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+}
@@ -0,0 +1,450 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	rolloutTraitID    = "rollout"
+	rolloutTraitOrder = 2450
+
+	rolloutCanarySuffix = "-canary"
+	rolloutStableSuffix = "-stable"
+
+	defaultRolloutStepDurationSeconds     = int32(60)
+	defaultRolloutProgressDeadlineSeconds = int32(300)
+
+	nginxCanaryAnnotation       = "nginx.ingress.kubernetes.io/canary"
+	nginxCanaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
+)
+
+var defaultRolloutSteps = []int32{10, 50}
+
+type rolloutTrait struct {
+	BaseTrait
+	traitv1.RolloutTrait `property:",squash"`
+}
+
+func newRolloutTrait() Trait {
+	return &rolloutTrait{
+		BaseTrait: NewBaseTrait(rolloutTraitID, rolloutTraitOrder),
+	}
+}
+
+func (t *rolloutTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if t.Strategy != "" && t.Strategy != traitv1.RolloutStrategyCanary && t.Strategy != traitv1.RolloutStrategyBlueGreen {
+		return false, nil, fmt.Errorf("unsupported rollout strategy: %s", t.Strategy)
+	}
+	for _, weight := range t.Steps {
+		if weight < 0 || weight > 100 {
+			return false, nil, fmt.Errorf("rollout steps must be percentages between 0 and 100: %d", weight)
+		}
+	}
+
+	// The rollout only applies to Integrations deployed as a Deployment
+	deployment := e.Resources.GetDeploymentForIntegration(e.Integration)
+	if deployment == nil {
+		return false, nil, nil
+	}
+	if deployment.Spec.Selector.MatchLabels[v1.IntegrationRolloutTrackLabel] != v1.IntegrationRolloutTrackStable {
+		return false, NewIntegrationCondition(
+			"Rollout",
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			"the Deployment does not tell apart the Pods of the previous and new versions: it must be recreated for the rollouts to apply",
+		), nil
+	}
+
+	return true, nil, nil
+}
+
+func (t *rolloutTrait) Apply(e *Environment) error {
+	deployment := e.Resources.GetDeploymentForIntegration(e.Integration)
+	containerName := e.GetIntegrationContainerName()
+
+	live, err := t.getDeployment(e, deployment.Name)
+	if err != nil {
+		return err
+	}
+	canary, err := t.getDeployment(e, deployment.Name+rolloutCanarySuffix)
+	if err != nil {
+		return err
+	}
+
+	rollout := t.rolloutFor(e, live, containerName, podSpecImage(&deployment.Spec.Template.Spec, containerName))
+	// The Service only routes the traffic to the Pods of the version that is currently serving,
+	// the traffic routed to the new version is split by the route, gateway or ingress traits
+	if service := e.Resources.GetUserServiceForIntegration(e.Integration); service != nil {
+		service.Spec.Selector[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackStable
+	}
+
+	switch {
+	case rollout != nil && rollout.Phase == v1.IntegrationRolloutPhaseProgressing:
+		return t.progress(e, deployment, live, rollout)
+	case rollout != nil && rollout.Phase == v1.IntegrationRolloutPhaseRolledBack:
+		// Keep running the previous version until a new version is deployed
+		pinPodTemplate(deployment, live)
+	case rollout != nil && rollout.Phase == v1.IntegrationRolloutPhasePromoted &&
+		!isPromotionComplete(live, containerName, rollout.CanaryImage):
+		// Keep the Pods of the new version serving until the Deployment has been updated
+		replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+		if canary != nil {
+			replicas = ptr.Deref(canary.Spec.Replicas, replicas)
+		}
+		e.Resources.Add(canaryDeploymentFor(deployment, replicas))
+
+		return nil
+	}
+
+	if canary != nil {
+		t.removeCanaryResources(e, deployment.Name)
+	}
+
+	return nil
+}
+
+// rolloutFor returns the rollout of the Integration, and starts a new one when the version being
+// deployed differs from the version that is currently running.
+func (t *rolloutTrait) rolloutFor(e *Environment, live *appsv1.Deployment, containerName, image string) *v1.IntegrationRolloutStatus {
+	if rollout := e.Integration.Status.Rollout; rollout != nil && rollout.CanaryImage == image {
+		return rollout
+	}
+	// Any previous rollout targeted another version, and is obsolete
+	e.Integration.Status.Rollout = nil
+	if live == nil || image == "" {
+		return nil
+	}
+	stableImage := podSpecImage(&live.Spec.Template.Spec, containerName)
+	if stableImage == "" || stableImage == image {
+		return nil
+	}
+
+	strategy := t.Strategy
+	if strategy == "" {
+		strategy = traitv1.RolloutStrategyCanary
+	}
+	steps := rolloutSteps(strategy, t.Steps)
+	now := metav1.Now()
+	e.Integration.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:              v1.IntegrationRolloutPhaseProgressing,
+		Strategy:           strategy,
+		StableImage:        stableImage,
+		CanaryImage:        image,
+		Weight:             steps[0],
+		LastTransitionTime: &now,
+		Message:            rolloutStepMessage(0, steps),
+	}
+
+	return e.Integration.Status.Rollout
+}
+
+// progress deploys the new version next to the previous one, and splits the traffic between them.
+func (t *rolloutTrait) progress(e *Environment, deployment, live *appsv1.Deployment, rollout *v1.IntegrationRolloutStatus) error {
+	replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+	canaryReplicas := replicas
+	if rollout.Strategy != traitv1.RolloutStrategyBlueGreen {
+		canaryReplicas = max(int32(math.Ceil(float64(replicas)*float64(rollout.Weight)/100)), 1)
+	}
+	e.Resources.Add(canaryDeploymentFor(deployment, canaryReplicas))
+	pinPodTemplate(deployment, live)
+
+	service := e.Resources.GetUserServiceForIntegration(e.Integration)
+	if service == nil {
+		return nil
+	}
+	stableService := trackServiceFor(service, v1.IntegrationRolloutTrackStable, rolloutStableSuffix)
+	canaryService := trackServiceFor(service, v1.IntegrationRolloutTrackCanary, rolloutCanarySuffix)
+	e.Resources.Add(stableService)
+	e.Resources.Add(canaryService)
+	splitTraffic(e, service.Name, stableService.Name, canaryService.Name, rollout.Weight)
+
+	return nil
+}
+
+func (t *rolloutTrait) getDeployment(e *Environment, name string) (*appsv1.Deployment, error) {
+	if t.Client == nil {
+		return nil, nil
+	}
+	deployment := &appsv1.Deployment{}
+	key := ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: name}
+	if err := t.Client.Get(e.Ctx, key, deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("cannot get deployment %s: %w", name, err)
+	}
+
+	return deployment, nil
+}
+
+// removeCanaryResources registers a post action deleting the resources created for the last rollout.
+func (t *rolloutTrait) removeCanaryResources(e *Environment, name string) {
+	e.PostActions = append(e.PostActions, func(env *Environment) error {
+		namespace := env.Integration.Namespace
+		resources := []ctrl.Object{
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name + rolloutCanarySuffix}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name + rolloutCanarySuffix}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name + rolloutStableSuffix}},
+			&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name + rolloutCanarySuffix}},
+		}
+		for _, resource := range resources {
+			err := t.Client.Delete(env.Ctx, resource, ctrl.PropagationPolicy(metav1.DeletePropagationBackground))
+			if err != nil && !k8serrors.IsNotFound(err) {
+				t.L.ForIntegration(env.Integration).Errorf(err, "cannot delete rollout resource: %s", resource.GetName())
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpdateRollout moves the rollout of a new version of the Integration forward, according to the health of the
+// new version. Once the new version has been healthy for the configured step duration, the rollout moves to the
+// next step, or the new version is promoted after the last step. The rollout is rolled back when the new version
+// is failing, or when it does not become healthy within the configured progress deadline.
+func UpdateRollout(e *Environment, healthy bool, failure string, now metav1.Time) {
+	rollout := e.Integration.Status.Rollout
+	if rollout == nil || rollout.Phase != v1.IntegrationRolloutPhaseProgressing {
+		return
+	}
+	config := traitv1.RolloutTrait{}
+	if rt, ok := e.GetTrait(rolloutTraitID).(*rolloutTrait); ok {
+		config = rt.RolloutTrait
+	}
+
+	elapsed := time.Duration(0)
+	if rollout.LastTransitionTime != nil {
+		elapsed = now.Sub(rollout.LastTransitionTime.Time)
+	}
+	deadline := time.Duration(ptr.Deref(config.ProgressDeadlineSeconds, defaultRolloutProgressDeadlineSeconds)) * time.Second
+	stepDuration := time.Duration(ptr.Deref(config.StepDurationSeconds, defaultRolloutStepDurationSeconds)) * time.Second
+
+	switch {
+	case failure != "":
+		rollout.Phase = v1.IntegrationRolloutPhaseRolledBack
+		rollout.Message = "new version rolled back: " + failure
+	case !healthy && elapsed > deadline:
+		rollout.Phase = v1.IntegrationRolloutPhaseRolledBack
+		rollout.Message = fmt.Sprintf("new version rolled back: not healthy after %s", deadline)
+	case !healthy || elapsed < stepDuration:
+		return
+	default:
+		steps := rolloutSteps(rollout.Strategy, config.Steps)
+		next := int(rollout.Step) + 1
+		if next < len(steps) {
+			rollout.Step++
+			rollout.Weight = steps[next]
+			rollout.Message = rolloutStepMessage(next, steps)
+		} else {
+			rollout.Phase = v1.IntegrationRolloutPhasePromoted
+			rollout.Weight = 100
+			rollout.Message = "new version promoted"
+		}
+	}
+	rollout.LastTransitionTime = &now
+}
+
+func rolloutSteps(strategy traitv1.RolloutStrategy, steps []int32) []int32 {
+	if strategy == traitv1.RolloutStrategyBlueGreen {
+		return []int32{0}
+	}
+	if len(steps) > 0 {
+		return steps
+	}
+
+	return defaultRolloutSteps
+}
+
+func rolloutStepMessage(step int, steps []int32) string {
+	return fmt.Sprintf("step %d/%d: %d%% of the traffic routed to the new version", step+1, len(steps), steps[step])
+}
+
+// canaryDeploymentFor returns a Deployment running the new version of the Integration next to the given one.
+func canaryDeploymentFor(deployment *appsv1.Deployment, replicas int32) *appsv1.Deployment {
+	canary := deployment.DeepCopy()
+	canary.Name = deployment.Name + rolloutCanarySuffix
+	canary.Spec.Replicas = &replicas
+	canary.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1.IntegrationLabel:             deployment.Spec.Selector.MatchLabels[v1.IntegrationLabel],
+			v1.IntegrationRolloutTrackLabel: v1.IntegrationRolloutTrackCanary,
+		},
+	}
+	if canary.Labels == nil {
+		canary.Labels = make(map[string]string)
+	}
+	// The canary is told apart from the Deployment of the Integration, that drives its status
+	canary.Labels[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackCanary
+	canary.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackCanary
+
+	return canary
+}
+
+// pinPodTemplate makes the Deployment keep running the Pods of the deployed version.
+func pinPodTemplate(deployment, live *appsv1.Deployment) {
+	if live == nil {
+		return
+	}
+	deployment.Spec.Template = *live.Spec.Template.DeepCopy()
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = make(map[string]string)
+	}
+	deployment.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel] = v1.IntegrationRolloutTrackStable
+}
+
+// isPromotionComplete returns true when all the replicas of the Deployment run the given image.
+func isPromotionComplete(live *appsv1.Deployment, containerName, image string) bool {
+	if live == nil {
+		return true
+	}
+	replicas := ptr.Deref(live.Spec.Replicas, 1)
+
+	return podSpecImage(&live.Spec.Template.Spec, containerName) == image &&
+		live.Status.ObservedGeneration >= live.Generation &&
+		live.Status.UpdatedReplicas >= replicas &&
+		live.Status.AvailableReplicas >= replicas
+}
+
+func podSpecImage(spec *corev1.PodSpec, containerName string) string {
+	for _, container := range spec.Containers {
+		if container.Name == containerName {
+			return container.Image
+		}
+	}
+
+	return ""
+}
+
+// trackServiceFor returns a Service selecting the Pods of a single version of the Integration.
+func trackServiceFor(service *corev1.Service, track, suffix string) *corev1.Service {
+	svc := service.DeepCopy()
+	svc.Name = service.Name + suffix
+	delete(svc.Labels, "camel.apache.org/service.type")
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.Selector = map[string]string{
+		v1.IntegrationLabel:             service.Spec.Selector[v1.IntegrationLabel],
+		v1.IntegrationRolloutTrackLabel: track,
+	}
+
+	return svc
+}
+
+// splitTraffic routes the given percentage of the traffic exposed by the route, gateway or ingress traits
+// to the new version of the Integration, and the remaining traffic to the previous version.
+func splitTraffic(e *Environment, service, stable, canary string, weight int32) {
+	e.Resources.VisitRoute(func(route *routev1.Route) {
+		if route.Spec.To.Name != service {
+			return
+		}
+		route.Spec.To = routev1.RouteTargetReference{Kind: "Service", Name: stable, Weight: ptr.To(100 - weight)}
+		route.Spec.AlternateBackends = []routev1.RouteTargetReference{
+			{Kind: "Service", Name: canary, Weight: ptr.To(weight)},
+		}
+	})
+
+	var canaryIngresses []*networkingv1.Ingress
+	e.Resources.Visit(func(object runtime.Object) {
+		switch o := object.(type) {
+		case *gwv1.HTTPRoute:
+			for i := range o.Spec.Rules {
+				o.Spec.Rules[i].BackendRefs = splitBackendRefs(o.Spec.Rules[i].BackendRefs, service, stable, canary, weight)
+			}
+		case *networkingv1.Ingress:
+			if !retargetIngress(o, service, stable) {
+				return
+			}
+			canaryIngress := o.DeepCopy()
+			canaryIngress.Name = o.Name + rolloutCanarySuffix
+			retargetIngress(canaryIngress, stable, canary)
+			if canaryIngress.Annotations == nil {
+				canaryIngress.Annotations = make(map[string]string)
+			}
+			canaryIngress.Annotations[nginxCanaryAnnotation] = "true"
+			canaryIngress.Annotations[nginxCanaryWeightAnnotation] = strconv.Itoa(int(weight))
+			canaryIngresses = append(canaryIngresses, canaryIngress)
+		}
+	})
+	for _, ingress := range canaryIngresses {
+		e.Resources.Add(ingress)
+	}
+}
+
+func splitBackendRefs(refs []gwv1.HTTPBackendRef, service, stable, canary string, weight int32) []gwv1.HTTPBackendRef {
+	split := make([]gwv1.HTTPBackendRef, 0, len(refs)+1)
+	for _, ref := range refs {
+		if string(ref.Name) != service {
+			split = append(split, ref)
+
+			continue
+		}
+		stableRef := *ref.DeepCopy()
+		stableRef.Name = gwv1.ObjectName(stable)
+		stableRef.Weight = ptr.To(100 - weight)
+		canaryRef := *ref.DeepCopy()
+		canaryRef.Name = gwv1.ObjectName(canary)
+		canaryRef.Weight = ptr.To(weight)
+		split = append(split, stableRef, canaryRef)
+	}
+
+	return split
+}
+
+// retargetIngress replaces the given service in the backends of the Ingress, and returns true if any was found.
+func retargetIngress(ingress *networkingv1.Ingress, from, to string) bool {
+	found := false
+	for i := range ingress.Spec.Rules {
+		if ingress.Spec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range ingress.Spec.Rules[i].HTTP.Paths {
+			backend := ingress.Spec.Rules[i].HTTP.Paths[j].Backend.Service
+			if backend != nil && backend.Name == from {
+				backend.Name = to
+				found = true
+			}
+		}
+	}
+
+	return found
+}
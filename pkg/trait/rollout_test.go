@@ -0,0 +1,362 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestRolloutCanaryStarts(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1")
+	environment.Resources.Add(&routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-it", Namespace: "ns"},
+		Spec: routev1.RouteSpec{
+			To: routev1.RouteTargetReference{Kind: "Service", Name: "my-it"},
+		},
+	})
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	assert.Nil(t, condition)
+	require.NoError(t, trait.Apply(environment))
+
+	rollout := environment.Integration.Status.Rollout
+	require.NotNil(t, rollout)
+	assert.Equal(t, v1.IntegrationRolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, traitv1.RolloutStrategyCanary, rollout.Strategy)
+	assert.Equal(t, "my-image:1", rollout.StableImage)
+	assert.Equal(t, "my-image:2", rollout.CanaryImage)
+	assert.Equal(t, int32(10), rollout.Weight)
+
+	stable := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it" })
+	require.NotNil(t, stable)
+	assert.Equal(t, "my-image:1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, v1.IntegrationRolloutTrackStable, stable.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel])
+	assert.Equal(t, int32(3), *stable.Spec.Replicas)
+
+	canary := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it-canary" })
+	require.NotNil(t, canary)
+	assert.Equal(t, "my-image:2", canary.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, v1.IntegrationRolloutTrackCanary, canary.Spec.Template.Labels[v1.IntegrationRolloutTrackLabel])
+	assert.Equal(t, v1.IntegrationRolloutTrackCanary, canary.Spec.Selector.MatchLabels[v1.IntegrationRolloutTrackLabel])
+	assert.Equal(t, int32(1), *canary.Spec.Replicas)
+
+	// The Service of the Integration does not select the Pods of the new version
+	service := environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "my-it" })
+	assert.Equal(t, v1.IntegrationRolloutTrackStable, service.Spec.Selector[v1.IntegrationRolloutTrackLabel])
+	canaryService := environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "my-it-canary" })
+	require.NotNil(t, canaryService)
+	assert.Equal(t, v1.IntegrationRolloutTrackCanary, canaryService.Spec.Selector[v1.IntegrationRolloutTrackLabel])
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "my-it-stable" }))
+
+	route := environment.Resources.GetRoute(func(r *routev1.Route) bool { return true })
+	assert.Equal(t, "my-it-stable", route.Spec.To.Name)
+	assert.Equal(t, ptr.To(int32(90)), route.Spec.To.Weight)
+	require.Len(t, route.Spec.AlternateBackends, 1)
+	assert.Equal(t, "my-it-canary", route.Spec.AlternateBackends[0].Name)
+	assert.Equal(t, ptr.To(int32(10)), route.Spec.AlternateBackends[0].Weight)
+}
+
+func TestRolloutBlueGreenStarts(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1")
+	trait.Strategy = traitv1.RolloutStrategyBlueGreen
+	environment.Resources.Add(buildHTTPRoute("my-it", "my-it", "ns", gwv1.ParentReference{Name: "my-it"}, []int32{80}))
+	environment.Resources.Add(&networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-it", Namespace: "ns"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path: "/",
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{Name: "my-it"},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	})
+
+	require.NoError(t, trait.Apply(environment))
+
+	rollout := environment.Integration.Status.Rollout
+	require.NotNil(t, rollout)
+	assert.Equal(t, traitv1.RolloutStrategyBlueGreen, rollout.Strategy)
+	assert.Equal(t, int32(0), rollout.Weight)
+
+	canary := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it-canary" })
+	require.NotNil(t, canary)
+	assert.Equal(t, int32(3), *canary.Spec.Replicas)
+
+	service := environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "my-it" })
+	assert.Equal(t, v1.IntegrationRolloutTrackStable, service.Spec.Selector[v1.IntegrationRolloutTrackLabel])
+
+	var httpRoute *gwv1.HTTPRoute
+	var ingresses []*networkingv1.Ingress
+	environment.Resources.Visit(func(object runtime.Object) {
+		switch o := object.(type) {
+		case *gwv1.HTTPRoute:
+			httpRoute = o
+		case *networkingv1.Ingress:
+			ingresses = append(ingresses, o)
+		}
+	})
+	require.NotNil(t, httpRoute)
+	refs := httpRoute.Spec.Rules[0].BackendRefs
+	require.Len(t, refs, 2)
+	assert.Equal(t, gwv1.ObjectName("my-it-stable"), refs[0].Name)
+	assert.Equal(t, ptr.To(int32(100)), refs[0].Weight)
+	assert.Equal(t, gwv1.ObjectName("my-it-canary"), refs[1].Name)
+	assert.Equal(t, ptr.To(int32(0)), refs[1].Weight)
+
+	require.Len(t, ingresses, 2)
+	assert.Equal(t, "my-it-stable", ingresses[0].Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+	assert.Equal(t, "my-it-canary", ingresses[1].Name)
+	assert.Equal(t, "my-it-canary", ingresses[1].Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+	assert.Equal(t, "true", ingresses[1].Annotations[nginxCanaryAnnotation])
+	assert.Equal(t, "0", ingresses[1].Annotations[nginxCanaryWeightAnnotation])
+}
+
+func TestRolloutWithoutPreviousVersion(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "")
+
+	require.NoError(t, trait.Apply(environment))
+
+	assert.Nil(t, environment.Integration.Status.Rollout)
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it-canary" }))
+	assert.Empty(t, environment.PostActions)
+}
+
+func TestRolloutRolledBack(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1", rolloutCanaryDeployment())
+	environment.Integration.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:       v1.IntegrationRolloutPhaseRolledBack,
+		StableImage: "my-image:1",
+		CanaryImage: "my-image:2",
+	}
+
+	require.NoError(t, trait.Apply(environment))
+
+	assert.Equal(t, v1.IntegrationRolloutPhaseRolledBack, environment.Integration.Status.Rollout.Phase)
+	stable := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it" })
+	assert.Equal(t, "my-image:1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it-canary" }))
+	// The Deployment of the new version is removed
+	assert.Len(t, environment.PostActions, 1)
+}
+
+func TestRolloutPromoted(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1", rolloutCanaryDeployment())
+	environment.Integration.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:       v1.IntegrationRolloutPhasePromoted,
+		StableImage: "my-image:1",
+		CanaryImage: "my-image:2",
+		Weight:      100,
+	}
+
+	require.NoError(t, trait.Apply(environment))
+
+	stable := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it" })
+	assert.Equal(t, "my-image:2", stable.Spec.Template.Spec.Containers[0].Image)
+	// The new version keeps serving until the Deployment has been updated
+	canary := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "my-it-canary" })
+	require.NotNil(t, canary)
+	assert.Equal(t, int32(1), *canary.Spec.Replicas)
+}
+
+func TestUpdateRollout(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1")
+	trait.StepDurationSeconds = ptr.To(int32(30))
+	trait.ProgressDeadlineSeconds = ptr.To(int32(120))
+	environment.ExecutedTraits = append(environment.ExecutedTraits, trait)
+	start := metav1.Now()
+	environment.Integration.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:              v1.IntegrationRolloutPhaseProgressing,
+		Strategy:           traitv1.RolloutStrategyCanary,
+		CanaryImage:        "my-image:2",
+		Weight:             10,
+		LastTransitionTime: &start,
+	}
+	rollout := environment.Integration.Status.Rollout
+
+	// Not healthy for long enough
+	UpdateRollout(environment, true, "", metav1.NewTime(start.Add(10*time.Second)))
+	assert.Equal(t, int32(0), rollout.Step)
+
+	// Not healthy, within the deadline
+	UpdateRollout(environment, false, "", metav1.NewTime(start.Add(60*time.Second)))
+	assert.Equal(t, v1.IntegrationRolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, int32(0), rollout.Step)
+
+	UpdateRollout(environment, true, "", metav1.NewTime(start.Add(30*time.Second)))
+	assert.Equal(t, int32(1), rollout.Step)
+	assert.Equal(t, int32(50), rollout.Weight)
+
+	UpdateRollout(environment, true, "", metav1.NewTime(start.Add(60*time.Second)))
+	assert.Equal(t, v1.IntegrationRolloutPhasePromoted, rollout.Phase)
+	assert.Equal(t, int32(100), rollout.Weight)
+}
+
+func TestUpdateRolloutRollsBack(t *testing.T) {
+	_, environment := createNominalRolloutTest(t, "my-image:1")
+	start := metav1.Now()
+	environment.Integration.Status.Rollout = &v1.IntegrationRolloutStatus{
+		Phase:              v1.IntegrationRolloutPhaseProgressing,
+		LastTransitionTime: &start,
+	}
+	rollout := environment.Integration.Status.Rollout
+
+	UpdateRollout(environment, false, "", metav1.NewTime(start.Add(301*time.Second)))
+	assert.Equal(t, v1.IntegrationRolloutPhaseRolledBack, rollout.Phase)
+	assert.Equal(t, "new version rolled back: not healthy after 5m0s", rollout.Message)
+
+	rollout.Phase = v1.IntegrationRolloutPhaseProgressing
+	UpdateRollout(environment, true, "back-off restarting failed container", start)
+	assert.Equal(t, v1.IntegrationRolloutPhaseRolledBack, rollout.Phase)
+	assert.Equal(t, "new version rolled back: back-off restarting failed container", rollout.Message)
+}
+
+func TestConfigureRolloutTraitWithoutTrackSelector(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "my-image:1")
+	// The Deployment has been created by a former version of the operator
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	delete(deployment.Spec.Selector.MatchLabels, v1.IntegrationRolloutTrackLabel)
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.False(t, configured)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "must be recreated")
+}
+
+func TestConfigureRolloutTraitWithInvalidSteps(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t, "")
+	trait.Steps = []int32{10, 150}
+
+	configured, _, err := trait.Configure(environment)
+	require.Error(t, err)
+	assert.False(t, configured)
+}
+
+// createNominalRolloutTest returns a rollout trait deploying the version `my-image:2` of an Integration,
+// while the given version is running.
+func createNominalRolloutTest(t *testing.T, runningImage string, objects ...runtime.Object) (*rolloutTrait, *Environment) {
+	t.Helper()
+
+	if runningImage != "" {
+		objects = append(objects, rolloutDeployment(runningImage))
+	}
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	trait, _ := newRolloutTrait().(*rolloutTrait)
+	trait.Enabled = ptr.To(true)
+	trait.Client = client
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "ns",
+			Labels: map[string]string{
+				v1.IntegrationLabel:             "my-it",
+				"camel.apache.org/service.type": v1.ServiceTypeUser,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+			Selector: map[string]string{v1.IntegrationLabel: "my-it"},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(nil),
+		Client:  client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseRunning,
+			},
+		},
+		Resources: kubernetes.NewCollection(rolloutDeployment("my-image:2"), service),
+	}
+
+	return trait, environment
+}
+
+func rolloutDeployment(image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "ns",
+			Labels:    map[string]string{v1.IntegrationLabel: "my-it"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(3)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel:             "my-it",
+					v1.IntegrationRolloutTrackLabel: v1.IntegrationRolloutTrackStable,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel:             "my-it",
+						v1.IntegrationRolloutTrackLabel: v1.IntegrationRolloutTrackStable,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: defaultContainerName, Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func rolloutCanaryDeployment() *appsv1.Deployment {
+	return canaryDeploymentFor(rolloutDeployment("my-image:2"), 1)
+}
@@ -0,0 +1,187 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+	"github.com/apache/camel-k/v2/pkg/util/envvar"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/reference"
+)
+
+const (
+	serviceBindingTraitID    = "service-binding"
+	serviceBindingTraitOrder = 1615
+
+	serviceBindingRootEnvVar = "SERVICE_BINDING_ROOT"
+)
+
+var serviceBindingEnvVarDisallowedChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+type serviceBindingTrait struct {
+	BaseTrait
+	traitv1.ServiceBindingTrait `property:",squash"`
+
+	bindings []serviceBinding
+}
+
+// serviceBinding is a Secret bound to the Integration.
+type serviceBinding struct {
+	name   string
+	secret *corev1.Secret
+}
+
+func newServiceBindingTrait() Trait {
+	return &serviceBindingTrait{
+		BaseTrait: NewBaseTrait(serviceBindingTraitID, serviceBindingTraitOrder),
+	}
+}
+
+func (t *serviceBindingTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, true) || len(t.Services) == 0 {
+		return false, nil, nil
+	}
+	if !e.IntegrationInPhase(v1.IntegrationPhaseInitialization) && !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	converter := reference.NewConverter("")
+	var projected []string
+	for _, service := range t.Services {
+		ref, err := converter.FromString(service)
+		if err != nil {
+			return false, nil, fmt.Errorf("invalid service %q: %w", service, err)
+		}
+		if ref.Namespace != "" && ref.Namespace != e.Integration.Namespace {
+			return false, nil, fmt.Errorf("cannot bind the service %s: it must be in the Integration namespace", service)
+		}
+		secret, err := bindings.LookupServiceBindingSecret(e.Ctx, e.Client, e.Integration.Namespace, ref)
+		if err != nil {
+			return false, nil, fmt.Errorf("cannot lookup the binding of the service %s: %w", service, err)
+		}
+		if secret == nil {
+			return false, nil, fmt.Errorf("the service %s doesn't expose any binding Secret yet", service)
+		}
+		bindingType := bindings.ServiceBindingType(secret)
+		if bindingType == "" {
+			return false, nil, fmt.Errorf("the Secret %s of the service %s is not a Service Binding: missing %q entry",
+				secret.Name, service, bindings.ServiceBindingTypeKey)
+		}
+		if !bindings.IsWellKnownServiceBindingType(bindingType) {
+			projected = append(projected, ref.Name)
+		}
+		t.bindings = append(t.bindings, serviceBinding{name: ref.Name, secret: secret})
+	}
+
+	var condition *TraitCondition
+	if len(projected) > 0 {
+		condition = NewIntegrationCondition(
+			serviceBindingTraitID,
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			fmt.Sprintf("the %s bindings have no well-known type: they are only projected into %s",
+				strings.Join(projected, ", "), bindings.ServiceBindingRoot),
+		)
+	}
+
+	return true, condition, nil
+}
+
+func (t *serviceBindingTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		for _, binding := range t.bindings {
+			for _, dependency := range bindings.ServiceBindingDependencies(bindings.ServiceBindingType(binding.secret)) {
+				util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, dependency)
+			}
+		}
+
+		return nil
+	}
+
+	podSpec := e.GetIntegrationPodSpec()
+	container := e.GetIntegrationContainer()
+	if podSpec == nil || container == nil {
+		return fmt.Errorf("cannot bind the services of %s: missing integration container", e.Integration.Name)
+	}
+
+	envvar.SetVal(&container.Env, serviceBindingRootEnvVar, bindings.ServiceBindingRoot)
+	configured := make(map[string]string)
+	for _, binding := range t.bindings {
+		volumeName := kubernetes.SanitizeLabel("binding-" + binding.name)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: binding.secret.Name,
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: filepath.Join(bindings.ServiceBindingRoot, binding.name),
+			ReadOnly:  true,
+		})
+
+		// The properties refer to the entries of the Secret through environment variables
+		props, err := bindings.ServiceBindingProperties(binding.secret, func(key string) string {
+			name := serviceBindingEnvVar(binding.name, key)
+			envvar.SetVar(&container.Env, corev1.EnvVar{
+				Name: name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: binding.secret.Name},
+						Key:                  key,
+					},
+				},
+			})
+
+			return "${" + name + "}"
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range util.SortedStringMapKeys(props) {
+			if other, ok := configured[key]; ok {
+				return fmt.Errorf("the %s and %s bindings both configure the %s property", other, binding.name, key)
+			}
+			configured[key] = binding.name
+			e.ApplicationProperties[key] = props[key]
+		}
+	}
+
+	return nil
+}
+
+// serviceBindingEnvVar returns the name of the environment variable holding the given entry of a binding.
+func serviceBindingEnvVar(binding string, key string) string {
+	name := strings.ToUpper("CAMEL_K_BINDING_" + binding + "_" + key)
+
+	return serviceBindingEnvVarDisallowedChars.ReplaceAllString(name, "_")
+}
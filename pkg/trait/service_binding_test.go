@@ -0,0 +1,197 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/envvar"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestServiceBindingProjectsSecrets(t *testing.T) {
+	environment := createServiceBindingTestEnv(t, v1.IntegrationPhaseDeploying, []string{"v1:Secret:my-db", "Secret:my-cache"},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "ns"},
+			Data: map[string][]byte{
+				"type":     []byte("postgresql"),
+				"host":     []byte("my-db.ns.svc"),
+				"port":     []byte("5433"),
+				"database": []byte("orders"),
+				"username": []byte("user"),
+				"password": []byte("secret"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "ns"},
+			Data: map[string][]byte{
+				"type": []byte("redis"),
+				"host": []byte("my-cache.ns.svc"),
+			},
+		},
+	)
+
+	conditions, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: "binding-my-db",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "my-db"},
+		},
+	})
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "binding-my-db", MountPath: "/bindings/my-db", ReadOnly: true})
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "binding-my-cache", MountPath: "/bindings/my-cache", ReadOnly: true})
+
+	env := podSpec.Containers[0].Env
+	assert.Equal(t, "/bindings", envvar.Get(env, "SERVICE_BINDING_ROOT").Value)
+	password := envvar.Get(env, "CAMEL_K_BINDING_MY_DB_PASSWORD")
+	require.NotNil(t, password)
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "my-db"},
+		Key:                  "password",
+	}, password.ValueFrom.SecretKeyRef)
+	assert.Nil(t, envvar.Get(env, "CAMEL_K_BINDING_MY_CACHE_HOST"))
+
+	assert.Equal(t, "jdbc:postgresql://${CAMEL_K_BINDING_MY_DB_HOST}:${CAMEL_K_BINDING_MY_DB_PORT}/${CAMEL_K_BINDING_MY_DB_DATABASE}",
+		environment.ApplicationProperties["quarkus.datasource.jdbc.url"])
+	assert.Equal(t, "${CAMEL_K_BINDING_MY_DB_USERNAME}", environment.ApplicationProperties["quarkus.datasource.username"])
+	assert.Equal(t, "${CAMEL_K_BINDING_MY_DB_PASSWORD}", environment.ApplicationProperties["quarkus.datasource.password"])
+
+	var condition *TraitCondition
+	for _, c := range conditions {
+		if c.traitID == serviceBindingTraitID {
+			condition = c
+		}
+	}
+	require.NotNil(t, condition)
+	assert.Equal(t, "the my-cache bindings have no well-known type: they are only projected into /bindings", condition.message)
+}
+
+func TestServiceBindingAddsDependencies(t *testing.T) {
+	environment := createServiceBindingTestEnv(t, v1.IntegrationPhaseInitialization, []string{"Secret:my-db"},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "ns"},
+			Data: map[string][]byte{
+				"type": []byte("postgresql"),
+				"host": []byte("my-db.ns.svc"),
+			},
+		},
+	)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.Contains(t, environment.Integration.Status.Dependencies, "mvn:io.quarkus:quarkus-jdbc-postgresql")
+}
+
+func TestServiceBindingConflictingTypes(t *testing.T) {
+	kafka := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Data: map[string][]byte{
+				"type":              []byte("kafka"),
+				"bootstrap-servers": []byte(name + ":9092"),
+			},
+		}
+	}
+	environment := createServiceBindingTestEnv(t, v1.IntegrationPhaseDeploying, []string{"Secret:kafka-a", "Secret:kafka-b"},
+		kafka("kafka-a"), kafka("kafka-b"))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.EqualError(t, err, "service-binding trait execution failed: the kafka-a and kafka-b bindings both configure the camel.component.kafka.brokers property")
+}
+
+func TestServiceBindingInvalidServices(t *testing.T) {
+	environment := createServiceBindingTestEnv(t, v1.IntegrationPhaseDeploying, []string{"Secret:missing"})
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot lookup the binding of the service Secret:missing")
+
+	environment = createServiceBindingTestEnv(t, v1.IntegrationPhaseDeploying, []string{"Secret:other/my-db"})
+	_, _, err = environment.Catalog.apply(&environment)
+	require.EqualError(t, err, "service-binding trait configuration failed: cannot bind the service Secret:other/my-db: it must be in the Integration namespace")
+
+	environment = createServiceBindingTestEnv(t, v1.IntegrationPhaseDeploying, []string{"Secret:my-secret"},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "ns"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+	)
+	_, _, err = environment.Catalog.apply(&environment)
+	require.EqualError(t, err, "service-binding trait configuration failed: "+
+		`the Secret my-secret of the service Secret:my-secret is not a Service Binding: missing "type" entry`)
+}
+
+func createServiceBindingTestEnv(t *testing.T, phase v1.IntegrationPhase, services []string, secrets ...*corev1.Secret) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	for _, secret := range secrets {
+		require.NoError(t, client.Create(context.Background(), secret))
+	}
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: phase,
+			},
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					ServiceBinding: &traitv1.ServiceBindingTrait{
+						Services: services,
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:              pl,
+		EnvVars:               make([]corev1.EnvVar, 0),
+		ExecutedTraits:        make([]Trait, 0),
+		Resources:             kubernetes.NewCollection(),
+		ApplicationProperties: make(map[string]string),
+	}
+}
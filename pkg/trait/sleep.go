@@ -0,0 +1,213 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	sleepTraitID = "sleep"
+	// The sleep trait runs after the rollout trait, so that it also scales down the Deployment of a new version
+	// being rolled out.
+	sleepTraitOrder = 2460
+
+	defaultSleepTimeZone = "UTC"
+)
+
+// sleepScheduleParser parses the standard 5 fields cron expressions, the schedules in other formats being first
+// converted the same way as the cron trait does for the CronJob schedules.
+var sleepScheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+type sleepTrait struct {
+	BaseTrait
+	traitv1.SleepTrait `property:",squash"`
+
+	sleepSchedules []cron.Schedule
+	wakeSchedules  []cron.Schedule
+}
+
+func newSleepTrait() Trait {
+	return &sleepTrait{
+		BaseTrait: NewBaseTrait(sleepTraitID, sleepTraitOrder),
+	}
+}
+
+func (t *sleepTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if len(t.Sleep) == 0 || len(t.Wake) == 0 {
+		return false, nil, errors.New("sleep trait requires both sleep and wake schedules")
+	}
+
+	location, err := time.LoadLocation(ptr.Deref(t.TimeZone, defaultSleepTimeZone))
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid time zone %q: %w", *t.TimeZone, err)
+	}
+	if t.sleepSchedules, err = parseSleepSchedules(t.Sleep, location); err != nil {
+		return false, nil, err
+	}
+	if t.wakeSchedules, err = parseSleepSchedules(t.Wake, location); err != nil {
+		return false, nil, err
+	}
+
+	if kt, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait); ok && ptr.Deref(kt.Enabled, false) {
+		return false, NewIntegrationCondition(
+			sleepTraitID,
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			"the integration is scaled by KEDA: the sleep schedules are ignored",
+		), nil
+	}
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	if strategy != ControllerStrategyDeployment {
+		return false, NewIntegrationCondition(
+			sleepTraitID,
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			fmt.Sprintf("the sleep schedules are not supported with %s controller strategy: they are ignored", strategy),
+		), nil
+	}
+
+	return true, nil, nil
+}
+
+func (t *sleepTrait) Apply(e *Environment) error {
+	now := time.Now()
+	var wokenAt *time.Time
+	if value := e.Integration.Annotations[v1.IntegrationWokenAtAnnotation]; value != "" {
+		woken, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid %s annotation %q: %w", v1.IntegrationWokenAtAnnotation, value, err)
+		}
+		wokenAt = &woken
+	}
+
+	state := t.stateAt(now, wokenAt)
+	status := e.Integration.Status.Sleep
+	if status == nil || status.Sleeping != state.sleeping {
+		status = &v1.IntegrationSleepStatus{
+			Sleeping:           state.sleeping,
+			LastTransitionTime: &metav1.Time{Time: now},
+		}
+		e.Integration.Status.Sleep = status
+	}
+	status.NextTransitionTime = &metav1.Time{Time: state.next}
+
+	next := state.next.Format(time.RFC3339)
+	switch {
+	case state.sleeping:
+		e.Integration.Status.SetCondition(v1.IntegrationConditionSleeping, corev1.ConditionTrue,
+			v1.IntegrationConditionSleepScheduledReason, "sleeping until "+next)
+	case state.woken:
+		e.Integration.Status.SetCondition(v1.IntegrationConditionSleeping, corev1.ConditionFalse,
+			v1.IntegrationConditionWokenUpReason, fmt.Sprintf("woken up at %s, awake until %s", wokenAt.Format(time.RFC3339), next))
+	default:
+		e.Integration.Status.SetCondition(v1.IntegrationConditionSleeping, corev1.ConditionFalse,
+			v1.IntegrationConditionAwakeScheduledReason, "awake until "+next)
+	}
+
+	if state.sleeping {
+		e.Resources.VisitDeployment(func(deployment *appsv1.Deployment) {
+			if deployment.Labels[v1.IntegrationLabel] == e.Integration.Name {
+				deployment.Spec.Replicas = ptr.To(int32(0))
+			}
+		})
+	}
+
+	return nil
+}
+
+// sleepState is the state of the sleep schedules at a given time.
+type sleepState struct {
+	// whether the Integration sleeps
+	sleeping bool
+	// whether the Integration is awake because it has been woken up on demand
+	woken bool
+	// the time of the next transition
+	next time.Time
+}
+
+// stateAt returns the state of the sleep schedules at the given time. The Integration sleeps when it's going to
+// wake up before going to sleep again, unless it has been woken up since it last went to sleep.
+func (t *sleepTrait) stateAt(now time.Time, wokenAt *time.Time) sleepState {
+	nextSleep := nextScheduledTime(t.sleepSchedules, now)
+	nextWake := nextScheduledTime(t.wakeSchedules, now)
+	if !nextWake.Before(nextSleep) {
+		return sleepState{next: nextSleep}
+	}
+	if wokenAt != nil && nextScheduledTime(t.sleepSchedules, *wokenAt).After(now) {
+		return sleepState{woken: true, next: nextSleep}
+	}
+
+	return sleepState{sleeping: true, next: nextWake}
+}
+
+// parseSleepSchedules parses the cron expressions of the schedules, evaluated in the given location.
+func parseSleepSchedules(expressions []string, location *time.Location) ([]cron.Schedule, error) {
+	schedules := make([]cron.Schedule, 0, len(expressions))
+	for _, expression := range expressions {
+		standard := toKubernetesCronSchedule(strings.Join(strings.Fields(expression), " "))
+		if standard == "" {
+			return nil, fmt.Errorf("invalid schedule %q: expected a cron expression with 5 fields", expression)
+		}
+		schedule, err := sleepScheduleParser.Parse(standard)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", expression, err)
+		}
+		if spec, ok := schedule.(*cron.SpecSchedule); ok {
+			spec.Location = location
+		}
+		if schedule.Next(time.Now()).IsZero() {
+			return nil, fmt.Errorf("invalid schedule %q: it never occurs", expression)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// nextScheduledTime returns the earliest time, after the given time, matching any of the schedules.
+func nextScheduledTime(schedules []cron.Schedule, after time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range schedules {
+		if t := schedule.Next(after); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,329 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/gzip"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	// the schedules of an Integration that is always sleeping, or awake, but on February 29th
+	everyMinuteSchedule = "* * * * *"
+	leapDaySchedule     = "0 0 29 2 *"
+)
+
+func TestSleepScaledDown(t *testing.T) {
+	environment := createSleepTestEnv(t, &traitv1.SleepTrait{
+		Sleep: []string{leapDaySchedule},
+		Wake:  []string{everyMinuteSchedule},
+	})
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(0)), deployment.Spec.Replicas)
+
+	status := environment.Integration.Status.Sleep
+	require.NotNil(t, status)
+	assert.True(t, status.Sleeping)
+	assert.NotNil(t, status.LastTransitionTime)
+	require.NotNil(t, status.NextTransitionTime)
+	assert.WithinDuration(t, time.Now(), status.NextTransitionTime.Time, time.Minute)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionSleeping)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionSleepScheduledReason, condition.Reason)
+	assert.Equal(t, "sleeping until "+status.NextTransitionTime.Format(time.RFC3339), condition.Message)
+}
+
+func TestSleepAwake(t *testing.T) {
+	environment := createSleepTestEnv(t, &traitv1.SleepTrait{
+		Sleep: []string{everyMinuteSchedule},
+		Wake:  []string{leapDaySchedule},
+	})
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+
+	status := environment.Integration.Status.Sleep
+	require.NotNil(t, status)
+	assert.False(t, status.Sleeping)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionSleeping)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionAwakeScheduledReason, condition.Reason)
+}
+
+func TestSleepWokenUp(t *testing.T) {
+	environment := createSleepTestEnv(t, &traitv1.SleepTrait{
+		Sleep: []string{leapDaySchedule},
+		Wake:  []string{everyMinuteSchedule},
+	})
+	wokenAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	environment.Integration.Annotations = map[string]string{
+		v1.IntegrationWokenAtAnnotation: wokenAt,
+	}
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+	environment.Integration.Status.Sleep = &v1.IntegrationSleepStatus{
+		Sleeping:           true,
+		LastTransitionTime: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+
+	status := environment.Integration.Status.Sleep
+	require.NotNil(t, status)
+	assert.False(t, status.Sleeping)
+	assert.WithinDuration(t, time.Now(), status.LastTransitionTime.Time, time.Minute)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionSleeping)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionWokenUpReason, condition.Reason)
+	assert.Contains(t, condition.Message, "woken up at "+wokenAt)
+}
+
+func TestSleepState(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	require.NoError(t, err)
+	at := func(day, hour int) time.Time {
+		// October 19th 2026 is a Monday
+		return time.Date(2026, time.October, day, hour, 0, 0, 0, rome)
+	}
+
+	sleep := sleepTrait{}
+	sleep.sleepSchedules, err = parseSleepSchedules([]string{"0 20 * * 1-5"}, rome)
+	require.NoError(t, err)
+	sleep.wakeSchedules, err = parseSleepSchedules([]string{"0 0 8 * * 1-5"}, rome)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		wokenAt  *time.Time
+		expected sleepState
+	}{
+		{
+			name:     "working-hours",
+			now:      at(19, 10),
+			expected: sleepState{next: at(19, 20)},
+		},
+		{
+			name:     "night",
+			now:      at(19, 21),
+			expected: sleepState{sleeping: true, next: at(20, 8)},
+		},
+		{
+			name:     "week-end",
+			now:      at(24, 12),
+			expected: sleepState{sleeping: true, next: at(26, 8)},
+		},
+		{
+			name:     "woken-up-during-the-week-end",
+			now:      at(24, 12),
+			wokenAt:  ptr.To(at(24, 11)),
+			expected: sleepState{woken: true, next: at(26, 20)},
+		},
+		{
+			name:     "woken-up-before-going-to-sleep",
+			now:      at(24, 12),
+			wokenAt:  ptr.To(at(23, 19)),
+			expected: sleepState{sleeping: true, next: at(26, 8)},
+		},
+		{
+			name:     "woken-up-during-working-hours",
+			now:      at(19, 10),
+			wokenAt:  ptr.To(at(19, 9)),
+			expected: sleepState{next: at(19, 20)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := sleep.stateAt(test.now, test.wokenAt)
+			assert.Equal(t, test.expected.sleeping, state.sleeping)
+			assert.Equal(t, test.expected.woken, state.woken)
+			assert.True(t, test.expected.next.Equal(state.next), "expected %s, got %s", test.expected.next, state.next)
+		})
+	}
+}
+
+func TestSleepInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait traitv1.SleepTrait
+		err   string
+	}{
+		{
+			name:  "missing-wake",
+			trait: traitv1.SleepTrait{Sleep: []string{"0 20 * * *"}},
+			err:   "sleep trait requires both sleep and wake schedules",
+		},
+		{
+			name:  "invalid-schedule",
+			trait: traitv1.SleepTrait{Sleep: []string{"0 20 * *"}, Wake: []string{"0 8 * * *"}},
+			err:   `invalid schedule "0 20 * *": expected a cron expression with 5 fields`,
+		},
+		{
+			name:  "seconds",
+			trait: traitv1.SleepTrait{Sleep: []string{"0 20 * * *"}, Wake: []string{"30 0 8 * * *"}},
+			err:   `invalid schedule "30 0 8 * * *": expected a cron expression with 5 fields`,
+		},
+		{
+			name:  "invalid-field",
+			trait: traitv1.SleepTrait{Sleep: []string{"0 25 * * *"}, Wake: []string{"0 8 * * *"}},
+			err:   `invalid schedule "0 25 * * *"`,
+		},
+		{
+			name:  "never",
+			trait: traitv1.SleepTrait{Sleep: []string{"0 20 30 2 *"}, Wake: []string{"0 8 * * *"}},
+			err:   `invalid schedule "0 20 30 2 *": it never occurs`,
+		},
+		{
+			name: "invalid-time-zone",
+			trait: traitv1.SleepTrait{
+				Sleep:    []string{"0 20 * * *"},
+				Wake:     []string{"0 8 * * *"},
+				TimeZone: ptr.To("Mars/Olympus_Mons"),
+			},
+			err: `invalid time zone "Mars/Olympus_Mons"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			environment := createSleepTestEnv(t, &test.trait)
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestSleepIgnoredWithKeda(t *testing.T) {
+	environment := createSleepTestEnv(t, &traitv1.SleepTrait{
+		Sleep: []string{leapDaySchedule},
+		Wake:  []string{everyMinuteSchedule},
+	})
+	environment.Integration.Spec.Traits.Keda = &traitv1.KedaTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+		Auto:  ptr.To(false),
+	}
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	conditions, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	assert.Nil(t, environment.Integration.Status.Sleep)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionSleeping))
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+	assert.Contains(t, conditions, NewIntegrationCondition(
+		sleepTraitID,
+		v1.IntegrationConditionTraitInfo,
+		corev1.ConditionTrue,
+		TraitConfigurationReason,
+		"the integration is scaled by KEDA: the sleep schedules are ignored",
+	))
+}
+
+func createSleepTestEnv(t *testing.T, sleep *traitv1.SleepTrait) Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	compressedRoute, err := gzip.CompressBase64([]byte(`from("timer:tick").log("hello");`))
+	require.NoError(t, err)
+
+	sleep.Enabled = ptr.To(true)
+
+	return Environment{
+		Ctx:          context.Background(),
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:        "routes.java",
+							Content:     string(compressedRoute),
+							Compression: true,
+						},
+						Language: v1.LanguageJavaSource,
+					},
+				},
+				Traits: v1.Traits{
+					Sleep: sleep,
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+}
@@ -18,12 +18,16 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Masterminds/semver"
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/trait/discovery"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 )
@@ -40,6 +44,9 @@ const (
 	propSampler            = "propSampler"
 	propSamplerRatio       = "propSamplerRatio"
 	propSamplerParentBased = "propSamplerParentBased"
+
+	// telemetryTLSConfigurationName is the name of the TLS configuration used to connect to the endpoint.
+	telemetryTLSConfigurationName = "camel-k-telemetry"
 )
 
 var (
@@ -83,18 +90,28 @@ func (t *telemetryTrait) Configure(e *Environment) (bool, *TraitCondition, error
 			"Use properties and dependencies configuration instead.",
 	)
 
+	if unsupported := t.unsupportedExportSettings(e); len(unsupported) > 0 {
+		condition.message += fmt.Sprintf(" The %s settings are ignored, as they require a Quarkus runtime 3.15.0 or later.",
+			strings.Join(unsupported, ", "))
+	}
+
+	if t.Protocol != "" && t.Protocol != discovery.ProtocolGRPC && t.Protocol != discovery.ProtocolHTTP {
+		return false, nil, fmt.Errorf("unsupported telemetry protocol %s, must be %s or %s",
+			t.Protocol, discovery.ProtocolGRPC, discovery.ProtocolHTTP)
+	}
+
 	if !ptr.Deref(t.Auto, true) {
 		return true, condition, nil
 	}
 
 	if t.Endpoint == "" {
 		for _, locator := range discovery.TelemetryLocators {
-			endpoint, err := locator.FindEndpoint(e.Ctx, t.Client, t.L, e.Integration.Namespace)
+			endpoint, err := locator.FindEndpoint(e.Ctx, t.Client, t.L, e.Integration.Namespace, t.Protocol)
 			if err != nil {
 				return false, nil, err
 			}
-			if endpoint != "" {
-				t.L.Infof("Using tracing endpoint: %s", endpoint)
+			if endpoint != nil {
+				t.L.Infof("Using tracing endpoint: %s", endpoint.URL)
 				conditionMessage := "TracingEndpoint"
 				if condition != nil {
 					conditionMessage = conditionMessage + ";" + condition.message
@@ -104,9 +121,12 @@ func (t *telemetryTrait) Configure(e *Environment) (bool, *TraitCondition, error
 					v1.IntegrationConditionTraitInfo,
 					corev1.ConditionTrue,
 					conditionMessage,
-					endpoint,
+					endpoint.URL,
 				)
-				t.Endpoint = endpoint
+				t.Endpoint = endpoint.URL
+				if t.Protocol == "" {
+					t.Protocol = endpoint.Protocol
+				}
 
 				break
 			}
@@ -129,15 +149,11 @@ func (t *telemetryTrait) Apply(e *Environment) error {
 
 	if e.CamelCatalog.Runtime.Provider.IsQuarkusBased() {
 		// Hack for camel-k-runtime >= 3.15.0
-		ck315, err := semver.NewVersion("3.15.0")
-		if err != nil {
-			return err
-		}
-		qv, err := semver.NewVersion(e.CamelCatalog.Runtime.Version)
+		quarkus315, err := isQuarkus315Runtime(e.CamelCatalog)
 		if err != nil {
 			return err
 		}
-		if qv.Compare(ck315) >= 0 {
+		if quarkus315 {
 			t.setRuntimeProviderQuarkus315Properties(e)
 
 			return nil
@@ -152,6 +168,48 @@ func (t *telemetryTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// isQuarkus315Runtime reports whether the catalog runtime is Quarkus based, in version 3.15.0 or later.
+func isQuarkus315Runtime(catalog *camel.RuntimeCatalog) (bool, error) {
+	if catalog == nil || !catalog.Runtime.Provider.IsQuarkusBased() {
+		return false, nil
+	}
+	ck315, err := semver.NewVersion("3.15.0")
+	if err != nil {
+		return false, err
+	}
+	qv, err := semver.NewVersion(catalog.Runtime.Version)
+	if err != nil {
+		return false, err
+	}
+
+	return qv.Compare(ck315) >= 0, nil
+}
+
+// unsupportedExportSettings returns the export settings that are set, while the runtime is not able to apply them.
+func (t *telemetryTrait) unsupportedExportSettings(e *Environment) []string {
+	if e.CamelCatalog == nil {
+		return nil
+	}
+	if supported, err := isQuarkus315Runtime(e.CamelCatalog); err != nil || supported {
+		return nil
+	}
+	var unsupported []string
+	if t.Protocol != "" {
+		unsupported = append(unsupported, "protocol")
+	}
+	if t.CACert != "" {
+		unsupported = append(unsupported, "caCert")
+	}
+	if ptr.Deref(t.Metrics, false) {
+		unsupported = append(unsupported, "metrics")
+	}
+	if ptr.Deref(t.Logs, false) {
+		unsupported = append(unsupported, "logs")
+	}
+
+	return unsupported
+}
+
 func (t *telemetryTrait) setCatalogConfiguration(e *Environment) {
 	if e.ApplicationProperties == nil {
 		e.ApplicationProperties = make(map[string]string)
@@ -248,4 +306,35 @@ func (t *telemetryTrait) setRuntimeProviderQuarkus315Properties(e *Environment)
 			e.ApplicationProperties[CapabilityPropertyKey(cp.Key, e.ApplicationProperties)] = cp.Value
 		}
 	}
+
+	t.setRuntimeProviderQuarkus315ExportProperties(e)
+}
+
+// setRuntimeProviderQuarkus315ExportProperties configures the export of the enabled signals to the endpoint.
+func (t *telemetryTrait) setRuntimeProviderQuarkus315ExportProperties(e *Environment) {
+	signals := []string{"traces"}
+	if ptr.Deref(t.Metrics, false) {
+		e.ApplicationProperties["quarkus.otel.metrics.enabled"] = boolean.TrueString
+		signals = append(signals, "metrics")
+	}
+	if ptr.Deref(t.Logs, false) {
+		e.ApplicationProperties["quarkus.otel.logs.enabled"] = boolean.TrueString
+		signals = append(signals, "logs")
+	}
+	if t.CACert != "" {
+		e.ApplicationProperties["quarkus.tls."+telemetryTLSConfigurationName+".trust-store.pem.certs"] = t.CACert
+	}
+
+	for _, signal := range signals {
+		prefix := "quarkus.otel.exporter.otlp." + signal + "."
+		if t.Endpoint != "" {
+			e.ApplicationProperties[prefix+"endpoint"] = t.Endpoint
+		}
+		if t.Protocol != "" {
+			e.ApplicationProperties[prefix+"protocol"] = t.Protocol
+		}
+		if t.CACert != "" {
+			e.ApplicationProperties[prefix+"tls-configuration-name"] = telemetryTLSConfigurationName
+		}
+	}
 }
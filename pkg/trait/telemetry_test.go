@@ -21,10 +21,13 @@ import (
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/trait/discovery"
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
@@ -118,6 +121,129 @@ func TestTelemetryForSelfManagedBuild(t *testing.T) {
 	assert.Equal(t, boolean.FalseString, e.ApplicationProperties["camel.k.telemetry.samplerParentBased"])
 }
 
+func TestTelemetryTraitExportProperties(t *testing.T) {
+	e := createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = ptr.To(true)
+	tt.Auto = ptr.To(false)
+	tt.Endpoint = "https://collector:4318"
+	tt.Protocol = "http/protobuf"
+	tt.Metrics = ptr.To(true)
+	tt.Logs = ptr.To(true)
+	tt.CACert = "/etc/camel/resources.d/_secrets/otel-ca/ca.crt"
+
+	ok, _, err := telemetry.Configure(e)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	err = telemetry.Apply(e)
+	require.NoError(t, err)
+
+	assert.Equal(t, boolean.TrueString, e.ApplicationProperties["quarkus.otel.metrics.enabled"])
+	assert.Equal(t, boolean.TrueString, e.ApplicationProperties["quarkus.otel.logs.enabled"])
+	assert.Equal(t, "/etc/camel/resources.d/_secrets/otel-ca/ca.crt", e.ApplicationProperties["quarkus.tls.camel-k-telemetry.trust-store.pem.certs"])
+	for _, signal := range []string{"traces", "metrics", "logs"} {
+		assert.Equal(t, "https://collector:4318", e.ApplicationProperties["quarkus.otel.exporter.otlp."+signal+".endpoint"])
+		assert.Equal(t, "http/protobuf", e.ApplicationProperties["quarkus.otel.exporter.otlp."+signal+".protocol"])
+		assert.Equal(t, "camel-k-telemetry", e.ApplicationProperties["quarkus.otel.exporter.otlp."+signal+".tls-configuration-name"])
+	}
+}
+
+func TestTelemetryTraitTracesOnly(t *testing.T) {
+	e := createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = ptr.To(true)
+	tt.Auto = ptr.To(false)
+	tt.Endpoint = "http://collector:4317"
+
+	_, _, err := telemetry.Configure(e)
+	require.NoError(t, err)
+	err = telemetry.Apply(e)
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://collector:4317", e.ApplicationProperties["quarkus.otel.exporter.otlp.traces.endpoint"])
+	assert.NotContains(t, e.ApplicationProperties, "quarkus.otel.exporter.otlp.traces.protocol")
+	assert.NotContains(t, e.ApplicationProperties, "quarkus.otel.metrics.enabled")
+	assert.NotContains(t, e.ApplicationProperties, "quarkus.otel.exporter.otlp.metrics.endpoint")
+	assert.NotContains(t, e.ApplicationProperties, "quarkus.otel.exporter.otlp.traces.tls-configuration-name")
+}
+
+func TestTelemetryTraitUnsupportedExportSettings(t *testing.T) {
+	e := createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	e.CamelCatalog.Runtime.Version = "3.8.1"
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = ptr.To(true)
+	tt.Auto = ptr.To(false)
+	tt.Endpoint = "http://collector:4318"
+	tt.Protocol = "http/protobuf"
+	tt.Metrics = ptr.To(true)
+
+	ok, condition, err := telemetry.Configure(e)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "The protocol, metrics settings are ignored")
+	err = telemetry.Apply(e)
+	require.NoError(t, err)
+
+	assert.NotContains(t, e.ApplicationProperties, "quarkus.otel.metrics.enabled")
+
+	e = createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	ok, condition, err = telemetry.Configure(e)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NotNil(t, condition)
+	assert.NotContains(t, condition.message, "ignored")
+}
+
+func TestTelemetryTraitDiscovery(t *testing.T) {
+	e := createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	e.Integration.Namespace = "ns"
+	c, err := internal.NewFakeClient(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "collector",
+			Namespace: "ns",
+			Labels: map[string]string{
+				discovery.OTLPEndpointLabel: "true",
+			},
+			Annotations: map[string]string{
+				discovery.OTLPTLSAnnotation: "true",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "otlp-http", Port: 4318},
+			},
+		},
+	})
+	require.NoError(t, err)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Client = c
+	tt.Enabled = ptr.To(true)
+
+	ok, condition, err := telemetry.Configure(e)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "https://collector.ns.svc.cluster.local:4318", condition.message)
+	assert.Equal(t, "https://collector.ns.svc.cluster.local:4318", tt.Endpoint)
+	assert.Equal(t, "http/protobuf", tt.Protocol)
+}
+
+func TestTelemetryTraitInvalidProtocol(t *testing.T) {
+	e := createTelemetryEnvironment(t, camel.QuarkusCatalog)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = ptr.To(true)
+	tt.Protocol = "http/json"
+
+	_, _, err := telemetry.Configure(e)
+	require.Error(t, err)
+	assert.Equal(t, "unsupported telemetry protocol http/json, must be grpc or http/protobuf", err.Error())
+}
+
 func createTelemetryEnvironment(t *testing.T, catalogGen func() (*camel.RuntimeCatalog, error)) *Environment {
 	t.Helper()
 
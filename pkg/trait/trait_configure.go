@@ -49,10 +49,13 @@ func (c *Catalog) Configure(env *Environment) error {
 	}
 	//nolint:nestif
 	if env.Integration != nil {
-		ip, err := platform.GetForResource(env.Ctx, env.Client, env.Integration)
-		if ip != nil && err == nil {
-			if err := c.configureTraits(ip.Status.Traits); err != nil {
-				return err
+		// There is no platform to look up when the traits are applied outside of the cluster
+		if env.Client != nil {
+			ip, err := platform.GetForResource(env.Ctx, env.Client, env.Integration)
+			if ip != nil && err == nil {
+				if err := c.configureTraits(ip.Status.Traits); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -34,6 +34,7 @@ func init() {
 	AddToTraits(newGitTrait)
 	AddToTraits(newGitOpsTrait)
 	AddToTraits(newHealthTrait)
+	AddToTraits(newHPATrait)
 	AddToTraits(newInitContainersTrait)
 	AddToTraits(NewInitTrait)
 	AddToTraits(newIngressTrait)
@@ -47,15 +48,21 @@ func init() {
 	AddToTraits(newLoggingTraitTrait)
 	AddToTraits(NewMasterTrait)
 	AddToTraits(newMountTrait)
+	AddToTraits(newNetworkPolicyTrait)
+	AddToTraits(newOpenAPITrait)
 	AddToTraits(newOwnerTrait)
 	AddToTraits(newPdbTrait)
 	AddToTraits(newPodTrait)
 	AddToTraits(newPrometheusTrait)
 	AddToTraits(newPullSecretTrait)
 	AddToTraits(newQuarkusTrait)
+	AddToTraits(newRBACTrait)
+	AddToTraits(newRolloutTrait)
 	AddToTraits(newRouteTrait)
 	AddToTraits(newSecurityContextTrait)
 	AddToTraits(newServiceTrait)
+	AddToTraits(newServiceBindingTrait)
+	AddToTraits(newSleepTrait)
 	AddToTraits(NewTelemetryTrait)
 	AddToTraits(newTolerationTrait)
 	// ^^ Declaration order is not important, but let's keep them sorted for debugging.
@@ -18,10 +18,14 @@ limitations under the License.
 package trait
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/go-viper/mapstructure/v2"
 )
@@ -49,6 +53,57 @@ func ValidateTraits(catalog *Catalog, traits []string) error {
 	return nil
 }
 
+// ValidateTraitsSpec verifies that every trait of the given configuration, including the addons, exists in the catalog
+// and only sets properties declared by the trait API.
+func ValidateTraitsSpec(catalog *Catalog, traits v1.Traits) error {
+	traitMap, err := ToTraitMap(traits)
+	if err != nil {
+		return err
+	}
+	delete(traitMap, "addons")
+	for id, addon := range traits.Addons {
+		var config map[string]any
+		if err := json.Unmarshal(addon.RawMessage, &config); err != nil {
+			return fmt.Errorf("invalid configuration of trait %s: %w", id, err)
+		}
+		traitMap[id] = config
+	}
+
+	ids := make([]string, 0, len(traitMap))
+	for id := range traitMap {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var errs []error
+	for _, id := range ids {
+		if err := ValidateTrait(catalog, id); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		if err := validateTraitProperties(traitMap[id], catalog.GetTrait(id)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid configuration of trait %s: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateTraitProperties decodes the given trait configuration into the target trait, failing on unknown properties.
+func validateTraitProperties(config map[string]any, target Trait) error {
+	if err := MigrateLegacyConfiguration(config); err != nil {
+		return err
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(target)
+}
+
 func ConfigureTraits(options []string, traits any, catalog Finder) error {
 	config, err := optionsToMap(options)
 	if err != nil {
@@ -75,6 +130,30 @@ func ConfigureTraits(options []string, traits any, catalog Finder) error {
 	return nil
 }
 
+// ValidateTraitOptions verifies that every trait option, in the "<trait>.<prop>=<value>" format,
+// targets a property declared by the trait API.
+func ValidateTraitOptions(options []string) error {
+	config, err := optionsToMap(options)
+	if err != nil {
+		return err
+	}
+
+	traits := v1.Traits{}
+	decoder, err := mapstructure.NewDecoder(
+		&mapstructure.DecoderConfig{
+			ErrorUnused:      true,
+			WeaklyTypedInput: true,
+			TagName:          "property",
+			Result:           &traits,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(config)
+}
+
 func optionsToMap(options []string) (optionMap, error) {
 	optionMap := make(optionMap)
 
@@ -29,8 +29,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -147,3 +149,41 @@ func toAddonTrait(t *testing.T, config map[string]interface{}) v1.AddonTrait {
 
 	return addon
 }
+
+func TestValidateTraitOptions(t *testing.T) {
+	require.NoError(t, ValidateTraitOptions([]string{
+		"container.request-cpu=1",
+		"camel.properties=a=b",
+		"mount.configs=configmap:my-cm",
+	}))
+
+	err := ValidateTraitOptions([]string{"container.request-cpus=1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'container' has invalid keys: request-cpus")
+
+	err = ValidateTraitOptions([]string{"containers.request-cpu=1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid keys: containers")
+}
+
+func TestValidateTraitsSpec(t *testing.T) {
+	catalog := NewCatalog(nil)
+	require.NoError(t, ValidateTraitsSpec(catalog, v1.Traits{
+		Container: &traitv1.ContainerTrait{
+			RequestCPU: "1",
+		},
+		Addons: map[string]v1.AddonTrait{
+			"master": toAddonTrait(t, map[string]any{"enabled": true}),
+		},
+	}))
+
+	err := ValidateTraitsSpec(catalog, v1.Traits{
+		Addons: map[string]v1.AddonTrait{
+			"contaner": toAddonTrait(t, map[string]any{"requestCPU": "1"}),
+			"service":  toAddonTrait(t, map[string]any{"nodePorts": true}),
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "trait contaner does not exist in catalog")
+	assert.Contains(t, err.Error(), `invalid configuration of trait service: json: unknown field "nodePorts"`)
+}
@@ -0,0 +1,289 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+const (
+	// ServiceBindingRoot is the directory where the Service Binding Secrets are projected.
+	ServiceBindingRoot = "/bindings"
+	// ServiceBindingTypeKey is the Secret entry holding the type of the binding.
+	ServiceBindingTypeKey = "type"
+
+	ServiceBindingTypePostgreSQL = "postgresql"
+	ServiceBindingTypeKafka      = "kafka"
+	ServiceBindingTypeAMQP       = "amqp"
+)
+
+var serviceBindingTypes = []string{ServiceBindingTypeAMQP, ServiceBindingTypeKafka, ServiceBindingTypePostgreSQL}
+
+// ServiceBindingProvider converts a reference to a Service Binding Secret, or to a provisioned service, into the
+// Camel URI of the component matching the binding type. The Secret is bound to the Integration with the
+// service-binding trait.
+type ServiceBindingProvider struct{}
+
+// ID --.
+func (s ServiceBindingProvider) ID() string {
+	return "service-binding"
+}
+
+// Translate --.
+func (s ServiceBindingProvider) Translate(ctx BindingContext, _ EndpointContext, e v1.Endpoint) (*Binding, error) {
+	if e.Ref == nil {
+		// works only on refs
+		return nil, nil
+	}
+	namespace := e.Ref.Namespace
+	if namespace == "" {
+		namespace = ctx.Namespace
+	}
+
+	secret, err := LookupServiceBindingSecret(ctx.Ctx, ctx.Client, namespace, *e.Ref)
+	if err != nil {
+		if !isServiceBindingSecretRef(e.Ref) && (k8serrors.IsNotFound(err) || meta.IsNoMatchError(err)) {
+			// IMPORTANT: just pass through if this provider cannot manage the binding. Another provider in the chain may take care or it.
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	if secret == nil || len(secret.Data[ServiceBindingTypeKey]) == 0 {
+		// not a Service Binding
+		return nil, nil
+	}
+	if namespace != ctx.Namespace {
+		return nil, fmt.Errorf("cannot bind the Secret %s of namespace %s: Service Binding Secrets must be in the Pipe namespace",
+			secret.Name, namespace)
+	}
+
+	props, err := e.Properties.GetPropertyMap()
+	if err != nil {
+		return nil, err
+	}
+	if props == nil {
+		props = make(map[string]string)
+	}
+	bindingType := ServiceBindingType(secret)
+	var endpointURI string
+	switch bindingType {
+	case ServiceBindingTypeKafka:
+		endpointURI, err = serviceBindingURI("kafka:", "topic", props)
+	case ServiceBindingTypeAMQP:
+		endpointURI, err = serviceBindingURI("amqp:", "destination", props)
+	case ServiceBindingTypePostgreSQL:
+		endpointURI, err = serviceBindingURI("sql:", "query", props)
+	default:
+		err = fmt.Errorf("unsupported Service Binding type %q, must be one of %s", bindingType, strings.Join(serviceBindingTypes, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Binding{
+		URI: endpointURI,
+		Traits: v1.Traits{
+			ServiceBinding: &traitv1.ServiceBindingTrait{
+				Services: []string{serviceBindingRefString(e.Ref)},
+			},
+		},
+	}, nil
+}
+
+// Order --.
+//
+//nolint:mnd
+func (s ServiceBindingProvider) Order() int {
+	return OrderLast - 5
+}
+
+func init() {
+	RegisterBindingProvider(ServiceBindingProvider{})
+}
+
+// serviceBindingURI builds the URI of a component, whose path is provided by the given endpoint property.
+func serviceBindingURI(scheme string, pathProperty string, props map[string]string) (string, error) {
+	path := props[pathProperty]
+	if path == "" {
+		return "", fmt.Errorf("invalid endpoint configuration: missing %s property", pathProperty)
+	}
+	delete(props, pathProperty)
+
+	return uri.AppendParameters(scheme+path, props), nil
+}
+
+func isServiceBindingSecretRef(ref *corev1.ObjectReference) bool {
+	return (ref.APIVersion == "" || ref.APIVersion == corev1.SchemeGroupVersion.String()) && ref.Kind == "Secret"
+}
+
+// serviceBindingRefString formats the reference in the form expected by the service-binding trait.
+func serviceBindingRefString(ref *corev1.ObjectReference) string {
+	apiVersion := ref.APIVersion
+	if apiVersion == "" {
+		apiVersion = corev1.SchemeGroupVersion.String()
+	}
+
+	return fmt.Sprintf("%s:%s:%s", apiVersion, ref.Kind, ref.Name)
+}
+
+// LookupServiceBindingSecret returns the Secret bound by the given reference, either directly or as the binding
+// (`status.binding.name`) of a provisioned service. It returns nil if the provisioned service has no binding yet.
+func LookupServiceBindingSecret(ctx context.Context, c ctrl.Reader, namespace string, ref corev1.ObjectReference) (*corev1.Secret, error) {
+	name := ref.Name
+	if !isServiceBindingSecretRef(&ref) {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		service, err := kubernetes.GetUnstructured(ctx, c, gv.WithKind(ref.Kind), ref.Name, namespace)
+		if err != nil {
+			return nil, err
+		}
+		name, err = provisionedServiceBinding(service)
+		if err != nil || name == "" {
+			return nil, err
+		}
+	}
+
+	return kubernetes.GetSecret(ctx, c, name, namespace)
+}
+
+func provisionedServiceBinding(service *unstructured.Unstructured) (string, error) {
+	name, _, err := unstructured.NestedString(service.Object, "status", "binding", "name")
+	if err != nil {
+		return "", fmt.Errorf("invalid binding of %s %s: %w", service.GetKind(), service.GetName(), err)
+	}
+
+	return name, nil
+}
+
+// ServiceBindingType returns the type of the binding held by the Secret.
+func ServiceBindingType(secret *corev1.Secret) string {
+	return strings.ToLower(strings.TrimSpace(string(secret.Data[ServiceBindingTypeKey])))
+}
+
+// ServiceBindingDependencies returns the dependencies required by the given type of binding,
+// on top of the ones of the Camel components.
+func ServiceBindingDependencies(bindingType string) []string {
+	if bindingType == ServiceBindingTypePostgreSQL {
+		return []string{"mvn:io.quarkus:quarkus-jdbc-postgresql"}
+	}
+
+	return nil
+}
+
+// ServiceBindingProperties maps the entries of a Secret of a well-known binding type onto the Camel and Quarkus
+// properties of the matching components. The value of each entry is expressed by the given placeholder, so that
+// the content of the Secret isn't leaked into the properties. It returns nil if the type isn't a well-known one.
+func ServiceBindingProperties(secret *corev1.Secret, placeholder func(key string) string) (map[string]string, error) {
+	entry := func(keys ...string) string {
+		for _, key := range keys {
+			if len(secret.Data[key]) > 0 {
+				return placeholder(key)
+			}
+		}
+
+		return ""
+	}
+	entryOr := func(key string, defaultValue string) string {
+		if value := entry(key); value != "" {
+			return value
+		}
+
+		return defaultValue
+	}
+
+	props := make(map[string]string)
+	bindingType := ServiceBindingType(secret)
+	switch bindingType {
+	case ServiceBindingTypePostgreSQL:
+		host := entry("host")
+		if host == "" {
+			return nil, missingServiceBindingEntry(secret, "host")
+		}
+		props["quarkus.datasource.jdbc.url"] = fmt.Sprintf("jdbc:postgresql://%s:%s/%s", host, entryOr("port", "5432"), entry("database"))
+		setIfNotEmpty(props, "quarkus.datasource.username", entry("username"))
+		setIfNotEmpty(props, "quarkus.datasource.password", entry("password"))
+	case ServiceBindingTypeKafka:
+		brokers := entry("bootstrap-servers", "bootstrapServers")
+		if brokers == "" {
+			return nil, missingServiceBindingEntry(secret, "bootstrap-servers")
+		}
+		props["camel.component.kafka.brokers"] = brokers
+		setIfNotEmpty(props, "camel.component.kafka.security-protocol", entry("security.protocol", "securityProtocol"))
+		setIfNotEmpty(props, "camel.component.kafka.sasl-mechanism", entry("sasl.mechanism", "saslMechanism"))
+		user, password := entry("user", "username"), entry("password")
+		if user != "" && password != "" {
+			module := "org.apache.kafka.common.security.plain.PlainLoginModule"
+			mechanism := strings.ToUpper(string(secret.Data["sasl.mechanism"]) + string(secret.Data["saslMechanism"]))
+			if strings.HasPrefix(mechanism, "SCRAM") {
+				module = "org.apache.kafka.common.security.scram.ScramLoginModule"
+			}
+			props["camel.component.kafka.sasl-jaas-config"] = fmt.Sprintf(`%s required username="%s" password="%s";`, module, user, password)
+		}
+	case ServiceBindingTypeAMQP:
+		url := entry("uri")
+		if url == "" {
+			host := entry("host")
+			if host == "" {
+				return nil, missingServiceBindingEntry(secret, "host")
+			}
+			url = fmt.Sprintf("amqp://%s:%s", host, entryOr("port", "5672"))
+		}
+		props["quarkus.qpid-jms.url"] = url
+		setIfNotEmpty(props, "quarkus.qpid-jms.username", entry("username", "user"))
+		setIfNotEmpty(props, "quarkus.qpid-jms.password", entry("password"))
+	case "":
+		return nil, fmt.Errorf("the Secret %s is not a Service Binding: missing %q entry", secret.Name, ServiceBindingTypeKey)
+	default:
+		return nil, nil
+	}
+
+	return props, nil
+}
+
+// IsWellKnownServiceBindingType returns true if the properties of the given binding type are mapped automatically.
+func IsWellKnownServiceBindingType(bindingType string) bool {
+	return slices.Contains(serviceBindingTypes, bindingType)
+}
+
+func missingServiceBindingEntry(secret *corev1.Secret, key string) error {
+	return fmt.Errorf("the %s Service Binding Secret %s has no %q entry", ServiceBindingType(secret), secret.Name, key)
+}
+
+func setIfNotEmpty(props map[string]string, key string, value string) {
+	if value != "" {
+		props[key] = value
+	}
+}
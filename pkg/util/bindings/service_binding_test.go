@@ -0,0 +1,247 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"context"
+	"testing"
+
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestServiceBindingSecretRef(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	secret := serviceBindingSecret("my-kafka", map[string]string{
+		"type":              "kafka",
+		"bootstrap-servers": "my-cluster-kafka-bootstrap:9092",
+	})
+	client, err := internal.NewFakeClient(secret)
+	require.NoError(t, err)
+
+	binding, err := ServiceBindingProvider{}.Translate(BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+	}, EndpointContext{
+		Type: camelv1.EndpointTypeSink,
+	}, camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       "my-kafka",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+		Properties: asEndpointProperties(map[string]string{
+			"topic":   "orders",
+			"groupId": "my-group",
+		}),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "kafka:orders?groupId=my-group", binding.URI)
+	//nolint:staticcheck
+	require.NotNil(t, binding.Traits.ServiceBinding)
+	//nolint:staticcheck
+	assert.Equal(t, []string{"v1:Secret:my-kafka"}, binding.Traits.ServiceBinding.Services)
+}
+
+func TestServiceBindingProvisionedService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	secret := serviceBindingSecret("my-db-binding", map[string]string{
+		"type": "postgresql",
+		"host": "my-db",
+	})
+	database := &unstructured.Unstructured{}
+	database.SetAPIVersion("example.com/v1")
+	database.SetKind("Database")
+	database.SetNamespace("test")
+	database.SetName("my-db")
+	require.NoError(t, unstructured.SetNestedField(database.Object, "my-db-binding", "status", "binding", "name"))
+	client, err := internal.NewFakeClient(secret, database)
+	require.NoError(t, err)
+
+	binding, err := ServiceBindingProvider{}.Translate(BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+	}, EndpointContext{
+		Type: camelv1.EndpointTypeSource,
+	}, camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       "my-db",
+			APIVersion: "example.com/v1",
+			Kind:       "Database",
+		},
+		Properties: asEndpointProperties(map[string]string{
+			"query": "select * from orders",
+		}),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "sql:select * from orders", binding.URI)
+	//nolint:staticcheck
+	assert.Equal(t, []string{"example.com/v1:Database:my-db"}, binding.Traits.ServiceBinding.Services)
+}
+
+func TestServiceBindingPassThrough(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	secret := serviceBindingSecret("my-secret", map[string]string{
+		"password": "secret",
+	})
+	client, err := internal.NewFakeClient(secret)
+	require.NoError(t, err)
+	bindingContext := BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+	}
+
+	binding, err := ServiceBindingProvider{}.Translate(bindingContext, EndpointContext{}, camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       "my-secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, binding)
+
+	binding, err = ServiceBindingProvider{}.Translate(bindingContext, EndpointContext{}, camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       "my-integration",
+			APIVersion: "camel.apache.org/v1",
+			Kind:       "Integration",
+		},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, binding)
+}
+
+func TestServiceBindingMissingProperty(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	secret := serviceBindingSecret("my-broker", map[string]string{
+		"type": "amqp",
+		"host": "my-broker",
+	})
+	client, err := internal.NewFakeClient(secret)
+	require.NoError(t, err)
+
+	_, err = ServiceBindingProvider{}.Translate(BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+	}, EndpointContext{}, camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       "my-broker",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+	})
+	require.EqualError(t, err, "invalid endpoint configuration: missing destination property")
+}
+
+func TestServiceBindingProperties(t *testing.T) {
+	placeholder := func(key string) string {
+		return "${" + key + "}"
+	}
+
+	props, err := ServiceBindingProperties(serviceBindingSecret("db", map[string]string{
+		"type":     "postgresql",
+		"host":     "my-db",
+		"database": "orders",
+		"username": "user",
+		"password": "secret",
+	}), placeholder)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"quarkus.datasource.jdbc.url": "jdbc:postgresql://${host}:5432/${database}",
+		"quarkus.datasource.username": "${username}",
+		"quarkus.datasource.password": "${password}",
+	}, props)
+
+	props, err = ServiceBindingProperties(serviceBindingSecret("kafka", map[string]string{
+		"type":              "kafka",
+		"bootstrap-servers": "my-cluster-kafka-bootstrap:9093",
+		"security.protocol": "SASL_SSL",
+		"sasl.mechanism":    "SCRAM-SHA-512",
+		"user":              "user",
+		"password":          "secret",
+	}), placeholder)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"camel.component.kafka.brokers":           "${bootstrap-servers}",
+		"camel.component.kafka.security-protocol": "${security.protocol}",
+		"camel.component.kafka.sasl-mechanism":    "${sasl.mechanism}",
+		"camel.component.kafka.sasl-jaas-config": `org.apache.kafka.common.security.scram.ScramLoginModule required ` +
+			`username="${user}" password="${password}";`,
+	}, props)
+
+	props, err = ServiceBindingProperties(serviceBindingSecret("broker", map[string]string{
+		"type": "amqp",
+		"host": "my-broker",
+	}), placeholder)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"quarkus.qpid-jms.url": "amqp://${host}:5672",
+	}, props)
+
+	props, err = ServiceBindingProperties(serviceBindingSecret("cache", map[string]string{
+		"type": "redis",
+		"host": "my-cache",
+	}), placeholder)
+	require.NoError(t, err)
+	assert.Nil(t, props)
+
+	_, err = ServiceBindingProperties(serviceBindingSecret("db", map[string]string{
+		"type": "postgresql",
+	}), placeholder)
+	require.EqualError(t, err, `the postgresql Service Binding Secret db has no "host" entry`)
+}
+
+func serviceBindingSecret(name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		TypeMeta: v1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Data: make(map[string][]byte),
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+
+	return secret
+}
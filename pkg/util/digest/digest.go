@@ -72,6 +72,10 @@ func ComputeForIntegration(integration *v1.Integration, configmapVersions []stri
 			return "", err
 		}
 	}
+	// Integration rollback is relevant, as it changes the kit of the Integration
+	if _, err := hash.Write([]byte(integration.Annotations[v1.IntegrationRollbackRevisionAnnotation])); err != nil {
+		return "", err
+	}
 	// Profile is relevant
 	//nolint:staticcheck
 	if _, err := hash.Write([]byte(integration.Spec.Profile)); err != nil {
@@ -48,6 +48,13 @@ func TestDigestUsesAnnotations(t *testing.T) {
 	digest3, err := ComputeForIntegration(&it, nil, nil)
 	require.NoError(t, err)
 	assert.NotEqual(t, digest1, digest3)
+
+	it.Annotations = map[string]string{
+		v1.IntegrationRollbackRevisionAnnotation: "1",
+	}
+	digest4, err := ComputeForIntegration(&it, nil, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, digest1, digest4)
 }
 
 func TestDigestSHA1FromTempFile(t *testing.T) {
@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dsl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	yaml2 "gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// OpenAPISpec is the subset of an OpenAPI specification required to expose its operations.
+type OpenAPISpec struct {
+	OpenAPI string `json:"openapi"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers,omitempty"`
+	Paths map[string]any `json:"paths"`
+}
+
+// ParseOpenAPISpec parses an OpenAPI specification, either in JSON or YAML format.
+func ParseOpenAPISpec(data []byte) (*OpenAPISpec, error) {
+	jsonData, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var spec OpenAPISpec
+	if err := json.Unmarshal(jsonData, &spec); err != nil {
+		return nil, fmt.Errorf("error unmarshalling the OpenAPI spec: %w", err)
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI spec version %q: only 3.x specs are supported", spec.OpenAPI)
+	}
+	if len(spec.Paths) == 0 {
+		return nil, errors.New("the OpenAPI spec doesn't define any path")
+	}
+
+	return &spec, nil
+}
+
+// PathPrefixes returns the sorted prefixes of the paths exposed by the specification: either the base path
+// of its first server, or the leading static segment of each of its paths.
+func (s *OpenAPISpec) PathPrefixes() []string {
+	if len(s.Servers) > 0 {
+		if u, err := url.Parse(s.Servers[0].URL); err == nil && strings.Trim(u.Path, "/") != "" {
+			return []string{path.Clean("/" + u.Path)}
+		}
+	}
+
+	prefixes := make([]string, 0, len(s.Paths))
+	for p := range s.Paths {
+		segment, _, _ := strings.Cut(strings.TrimPrefix(p, "/"), "/")
+		prefix := "/" + segment
+		if strings.Contains(segment, "{") {
+			prefix = "/"
+		}
+		if !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	slices.Sort(prefixes)
+
+	return prefixes
+}
+
+// OpenAPIToYamlDSL generates the Camel YAML DSL exposing the operations of the OpenAPI specification stored at
+// the given location (contract first), and serving the specification itself on the given path.
+func OpenAPIToYamlDSL(location, servePath, missingOperation string) ([]byte, error) {
+	openAPI := map[string]any{
+		"specification": location,
+	}
+	if missingOperation != "" {
+		openAPI["missingOperation"] = missingOperation
+	}
+	contentType := "application/yaml"
+	if strings.HasSuffix(location, ".json") {
+		contentType = "application/json"
+	}
+
+	flows := []any{
+		map[string]any{
+			"rest": map[string]any{
+				"openApi": openAPI,
+			},
+		},
+		map[string]any{
+			"from": map[string]any{
+				"uri": "platform-http:" + servePath + "?httpMethodRestrict=GET",
+				"steps": []any{
+					map[string]any{
+						"setHeader": map[string]any{
+							"name":     "Content-Type",
+							"constant": contentType,
+						},
+					},
+					map[string]any{
+						"to": "language:constant:resource:" + location,
+					},
+				},
+			},
+		},
+	}
+	yamldata, err := yaml2.Marshal(flows)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling to yaml: %w", err)
+	}
+
+	return yamldata, nil
+}
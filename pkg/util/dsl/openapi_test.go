@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+  /pets/{petId}:
+    get:
+      operationId: getPet
+  /stores:
+    get:
+      operationId: listStores
+  /{tenant}/orders:
+    get:
+      operationId: listOrders
+`
+
+func TestParseOpenAPISpec(t *testing.T) {
+	spec, err := ParseOpenAPISpec([]byte(petstore))
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+	assert.Len(t, spec.Paths, 4)
+	assert.Equal(t, []string{"/", "/pets", "/stores"}, spec.PathPrefixes())
+
+	spec, err = ParseOpenAPISpec([]byte(`{"openapi": "3.1.0", "servers": [{"url": "https://example.com/api/v1/"}], "paths": {"/pets": {}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/v1"}, spec.PathPrefixes())
+}
+
+func TestParseInvalidOpenAPISpec(t *testing.T) {
+	_, err := ParseOpenAPISpec([]byte("swagger: \"2.0\"\npaths:\n  /pets: {}\n"))
+	require.EqualError(t, err, `unsupported OpenAPI spec version "": only 3.x specs are supported`)
+
+	_, err = ParseOpenAPISpec([]byte("openapi: 3.0.0\ninfo:\n  title: empty\n"))
+	require.EqualError(t, err, "the OpenAPI spec doesn't define any path")
+}
+
+func TestOpenAPIToYamlDSL(t *testing.T) {
+	yamlBytes, err := OpenAPIToYamlDSL("file:/etc/camel/resources.d/_configmaps/petstore/petstore.json", "/openapi/petstore.json", "mock")
+	require.NoError(t, err)
+	expected := `- rest:
+    openApi:
+      missingOperation: mock
+      specification: file:/etc/camel/resources.d/_configmaps/petstore/petstore.json
+- from:
+    steps:
+    - setHeader:
+        constant: application/json
+        name: Content-Type
+    - to: language:constant:resource:file:/etc/camel/resources.d/_configmaps/petstore/petstore.json
+    uri: platform-http:/openapi/petstore.json?httpMethodRestrict=GET
+`
+	assert.Equal(t, expected, string(yamlBytes))
+}
@@ -201,6 +201,16 @@ func EditPipe(kb *v1.Pipe, it *v1.Integration, kit *v1.IntegrationKit, toNamespa
 			}
 		}
 	}
+	for _, branch := range dst.Spec.GetBranches() {
+		for _, step := range branch.Steps {
+			if step.Ref != nil {
+				step.Ref.Namespace = toNamespace
+			}
+		}
+		if branch.Sink != nil && branch.Sink.Ref != nil {
+			branch.Sink.Ref.Namespace = toNamespace
+		}
+	}
 
 	return &dst
 }
@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indentedwriter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Writer is a helper object for writing indented, tab aligned text.
+type Writer struct {
+	out *tabwriter.Writer
+}
+
+// NewWriter returns an initialized Writer.
+func NewWriter(out io.Writer) *Writer {
+	return &Writer{
+		out: tabwriter.NewWriter(out, 0, 8, 2, ' ', 0),
+	}
+}
+
+// Writef writes the formatted string at the given indentation level.
+func (iw *Writer) Writef(indentLevel int, format string, i ...any) {
+	fmt.Fprint(iw.out, strings.Repeat("  ", indentLevel))
+	fmt.Fprintf(iw.out, format, i...)
+}
+
+// Flush flushes the buffered content to the underlying writer.
+func (iw *Writer) Flush() error {
+	return iw.out.Flush()
+}
+
+// IndentedString collects the output of the given function into a string.
+func IndentedString(f func(io.Writer) error) (string, error) {
+	var out strings.Builder
+	if err := f(&out); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,40 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indentedwriter
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndentedString(t *testing.T) {
+	out, err := IndentedString(func(out io.Writer) error {
+		w := NewWriter(out)
+		w.Writef(0, "Name:\t%s\n", "my-it")
+		w.Writef(0, "Conditions:\n")
+		w.Writef(1, "Type\tStatus\n")
+		w.Writef(1, "Ready\tTrue\n")
+
+		return w.Flush()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Name:  my-it\nConditions:\n  Type   Status\n  Ready  True\n", out)
+}
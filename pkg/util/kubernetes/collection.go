@@ -133,14 +133,16 @@ func (c *Collection) GetDeployment(filter func(*appsv1.Deployment) bool) *appsv1
 	return retValue
 }
 
-// GetDeploymentForIntegration returns a Deployment for the given integration.
+// GetDeploymentForIntegration returns a Deployment for the given integration, excluding the Deployment running
+// the new version of the integration during a rollout.
 func (c *Collection) GetDeploymentForIntegration(integration *v1.Integration) *appsv1.Deployment {
 	if integration == nil {
 		return nil
 	}
 
 	return c.GetDeployment(func(d *appsv1.Deployment) bool {
-		return d.Labels[v1.IntegrationLabel] == integration.Name
+		return d.Labels[v1.IntegrationLabel] == integration.Name &&
+			d.Labels[v1.IntegrationRolloutTrackLabel] != v1.IntegrationRolloutTrackCanary
 	})
 }
 
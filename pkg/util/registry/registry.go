@@ -19,16 +19,33 @@ package registry
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/apache/camel-k/v2/pkg/util/io"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"go.uber.org/multierr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// pingTimeout is the maximum time to wait for a registry to respond to a ping.
+const pingTimeout = 5 * time.Second
+
 // MountSecretRegistryConfig write a file containing the secret registry config in a temporary folder.
 func MountSecretRegistryConfig(ctx context.Context, c client.Client, namespace, prefix, name string) (string, error) {
 	dir, err := os.MkdirTemp("", prefix)
@@ -65,3 +82,135 @@ func remap(name string) string {
 
 	return name
 }
+
+// SecretKeychain returns a keychain resolving the registry credentials stored in the given secret.
+func SecretKeychain(secret *corev1.Secret) (authn.Keychain, error) {
+	for _, key := range []string{".dockerconfigjson", "config.json"} {
+		if config, ok := secret.Data[key]; ok {
+			return NewKeychain(config)
+		}
+	}
+
+	return nil, fmt.Errorf("no registry configuration found in secret %s/%s", secret.Namespace, secret.Name)
+}
+
+type dockerConfig struct {
+	Auths map[string]authn.AuthConfig `json:"auths"`
+}
+
+type keychain map[string]authn.AuthConfig
+
+// NewKeychain returns a keychain resolving the registry credentials of a Docker configuration file.
+func NewKeychain(config []byte) (authn.Keychain, error) {
+	var cfg dockerConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, err
+	}
+	k := make(keychain, len(cfg.Auths))
+	for registry, auth := range cfg.Auths {
+		if auth.Auth != "" && auth.Username == "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid credentials for registry %s: %w", registry, err)
+			}
+			auth.Username, auth.Password, _ = strings.Cut(string(decoded), ":")
+			auth.Auth = ""
+		}
+		k[normalizeRegistry(registry)] = auth
+	}
+
+	return k, nil
+}
+
+func (k keychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	if auth, ok := k[normalizeRegistry(target.RegistryStr())]; ok {
+		return authn.FromConfig(auth), nil
+	}
+
+	return authn.Anonymous, nil
+}
+
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	if registry == "docker.io" || registry == "registry-1.docker.io" {
+		registry = "index.docker.io"
+	}
+
+	return registry
+}
+
+// Ping verifies that the registry is reachable, by requesting its API version endpoint. The connection is secured
+// with the certificate authority stored in the ConfigMap of the registry, if any, and authenticated with the
+// credentials stored in the Secret of the registry, if any, both being looked up in the given namespace.
+func Ping(ctx context.Context, c client.Client, namespace string, spec v1.RegistrySpec) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	var options []name.Option
+	if spec.Insecure || strings.HasPrefix(spec.Address, "http://") {
+		options = append(options, name.Insecure)
+	}
+	reg, err := name.NewRegistry(normalizeRegistry(spec.Address), options...)
+	if err != nil {
+		return err
+	}
+	base, err := caTransport(ctx, c, namespace, spec.CA)
+	if err != nil {
+		return err
+	}
+	var auth authn.Authenticator = authn.Anonymous
+	if spec.Secret != "" {
+		secret, err := c.CoreV1().Secrets(namespace).Get(ctx, spec.Secret, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		keychain, err := SecretKeychain(secret)
+		if err != nil {
+			return err
+		}
+		if auth, err = keychain.Resolve(reg); err != nil {
+			return err
+		}
+	}
+
+	if _, err := transport.NewWithContext(ctx, reg, auth, base, nil); err != nil {
+		return fmt.Errorf("registry %s is not reachable: %w", spec.Address, err)
+	}
+
+	return nil
+}
+
+// caTransport returns an HTTP transport trusting the certificates stored in the given ConfigMap, along with
+// the system certificates, or the default transport if no ConfigMap is given.
+func caTransport(ctx context.Context, c client.Client, namespace string, ca string) (http.RoundTripper, error) {
+	if ca == "" {
+		return http.DefaultTransport, nil
+	}
+	cm, err := c.CoreV1().ConfigMaps(namespace).Get(ctx, ca, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	found := false
+	for _, data := range cm.Data {
+		found = pool.AppendCertsFromPEM([]byte(data)) || found
+	}
+	if !found {
+		return nil, fmt.Errorf("no certificate found in configmap %s/%s", namespace, ca)
+	}
+	t, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("unexpected default HTTP transport")
+	}
+	t = t.Clone()
+	t.TLSClientConfig = &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	return t, nil
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/apache/camel-k/v2/pkg/util/secrets"
 )
 
 // Config represents a config option.
@@ -59,6 +61,9 @@ func (config *Config) Key() string {
 
 // String represents the unparsed value of the resource.
 func (config *Config) String() string {
+	if config.storageType == StorageTypeExternal {
+		return config.resourceName
+	}
 	s := fmt.Sprintf("%s:%s", config.storageType, config.resourceName)
 	if config.resourceKey != "" {
 		s = fmt.Sprintf("%s/%s", s, config.resourceKey)
@@ -82,6 +87,8 @@ const (
 	StorageTypePVC StorageType = "pvc"
 	// StorageTypeEmptyDir --.
 	StorageTypeEmptyDir StorageType = "emptyDir"
+	// StorageTypeExternal is a value stored in an external secret store, e.g., `vault:secret/data/app#password`.
+	StorageTypeExternal StorageType = "external"
 )
 
 // ContentType represent what kind of a content is, either data or purely text configuration.
@@ -156,6 +163,19 @@ func parse(item string, contentType ContentType) (*Config, error) {
 			cot = StorageTypeSecret
 		}
 		value = groups[2]
+	case contentType == ContentTypeText && strings.Contains(item, "#"):
+		// parse as external secret reference
+		ref, err := secrets.ParseReference(item)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Config{
+			storageType:  StorageTypeExternal,
+			contentType:  contentType,
+			resourceName: ref.String(),
+			resourceKey:  ref.Key,
+		}, nil
 	default:
 		return nil, fmt.Errorf("could not match config or secret configuration as %s", item)
 	}
@@ -107,3 +107,19 @@ func TestParseConfigOptionAllParams(t *testing.T) {
 	assert.Equal(t, "", parsedSec4.Key())
 	assert.Equal(t, "", parsedSec4.DestinationPath())
 }
+
+func TestParseConfigExternalSecret(t *testing.T) {
+	ext, err := ParseConfig("vault:secret/data/app#password")
+	require.NoError(t, err)
+	assert.Equal(t, StorageTypeExternal, ext.StorageType())
+	assert.Equal(t, ContentTypeText, ext.ContentType())
+	assert.Equal(t, "vault:secret/data/app#password", ext.Name())
+	assert.Equal(t, "password", ext.Key())
+	assert.Equal(t, "vault:secret/data/app#password", ext.String())
+
+	_, err = ParseConfig("vault:secret/data/app#")
+	require.Error(t, err)
+	// External secrets can only be used as configs
+	_, err = ParseResource("vault:secret/data/app#password")
+	require.Error(t, err)
+}
@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// DefaultHistoryLimit is the number of old revisions of an Integration retained by default.
+const DefaultHistoryLimit = 10
+
+// Data is the state of an Integration recorded in a revision, allowing to deploy it again without rebuilding it.
+type Data struct {
+	// the digest of the Integration
+	Digest string `json:"digest"`
+	// the kit the Integration was deployed with
+	IntegrationKit *corev1.ObjectReference `json:"integrationKit,omitempty"`
+	// the container image the Integration was deployed with
+	Image string `json:"image,omitempty"`
+	// the specification of the Integration
+	Spec v1.IntegrationSpec `json:"spec"`
+	// the traits applied to the Integration
+	Traits *v1.Traits `json:"traits,omitempty"`
+}
+
+// Name returns the name of the revision of the Integration with the given number.
+func Name(integration string, revision int64) string {
+	return fmt.Sprintf("%s-%d", integration, revision)
+}
+
+// DataFor returns the state of the Integration to record in a revision.
+func DataFor(integration *v1.Integration) Data {
+	data := Data{
+		Digest: integration.Status.Digest,
+		Image:  integration.Status.Image,
+		Spec:   *integration.Spec.DeepCopy(),
+		Traits: integration.Status.Traits.DeepCopy(),
+	}
+	if kit := integration.Status.IntegrationKit; kit != nil {
+		data.IntegrationKit = &corev1.ObjectReference{
+			Namespace: integration.GetIntegrationKitNamespace(""),
+			Name:      kit.Name,
+		}
+	}
+
+	return data
+}
+
+// SameDeployment returns true if both revisions deploy the same version of the Integration.
+func (d *Data) SameDeployment(other *Data) bool {
+	if d.Digest != other.Digest || d.Image != other.Image {
+		return false
+	}
+	if d.IntegrationKit == nil || other.IntegrationKit == nil {
+		return d.IntegrationKit == nil && other.IntegrationKit == nil
+	}
+
+	return d.IntegrationKit.Namespace == other.IntegrationKit.Namespace && d.IntegrationKit.Name == other.IntegrationKit.Name
+}
+
+// New creates the revision with the given number, recording the current state of the Integration.
+func New(integration *v1.Integration, revision int64) (*appsv1.ControllerRevision, error) {
+	raw, err := json.Marshal(DataFor(integration))
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.ControllerRevision{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ControllerRevision",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: integration.Namespace,
+			Name:      Name(integration.Name, revision),
+			Labels: map[string]string{
+				v1.IntegrationRevisionLabel: integration.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         v1.SchemeGroupVersion.String(),
+					Kind:               v1.IntegrationKind,
+					Name:               integration.Name,
+					UID:                integration.UID,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(true),
+				},
+			},
+		},
+		Data:     runtime.RawExtension{Raw: raw},
+		Revision: revision,
+	}, nil
+}
+
+// Decode returns the state of the Integration recorded in the revision.
+func Decode(revision *appsv1.ControllerRevision) (*Data, error) {
+	data := Data{}
+	if err := json.Unmarshal(revision.Data.Raw, &data); err != nil {
+		return nil, fmt.Errorf("unable to decode revision %s: %w", revision.Name, err)
+	}
+
+	return &data, nil
+}
+
+// List returns the revisions of the Integration, sorted from the oldest to the latest.
+func List(ctx context.Context, c ctrl.Reader, integration *v1.Integration) ([]appsv1.ControllerRevision, error) {
+	list := appsv1.ControllerRevisionList{}
+	if err := c.List(ctx, &list,
+		ctrl.InNamespace(integration.Namespace),
+		ctrl.MatchingLabels{v1.IntegrationRevisionLabel: integration.Name},
+	); err != nil {
+		return nil, err
+	}
+	revisions := make([]appsv1.ControllerRevision, 0, len(list.Items))
+	for _, revision := range list.Items {
+		if metav1.IsControlledBy(&revision, integration) {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+
+	return revisions, nil
+}
@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAndDecode(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	it.UID = "my-it-uid"
+	it.Spec.Sources = []v1.SourceSpec{v1.NewSourceSpec("route.yaml", "content", v1.LanguageYaml)}
+	it.Status.Digest = "digest"
+	it.Status.IntegrationKit = &corev1.ObjectReference{Name: "my-kit"}
+
+	rev, err := New(&it, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "my-it-3", rev.Name)
+	assert.Equal(t, int64(3), rev.Revision)
+	assert.Equal(t, "my-it", rev.Labels[v1.IntegrationRevisionLabel])
+	// the revisions must not be garbage collected along with the resources of the Integration
+	assert.NotContains(t, rev.Labels, v1.IntegrationLabel)
+	require.Len(t, rev.OwnerReferences, 1)
+	assert.Equal(t, v1.IntegrationKind, rev.OwnerReferences[0].Kind)
+	assert.True(t, *rev.OwnerReferences[0].Controller)
+
+	data, err := Decode(rev)
+	require.NoError(t, err)
+	assert.Equal(t, "digest", data.Digest)
+	assert.Equal(t, &corev1.ObjectReference{Namespace: "ns", Name: "my-kit"}, data.IntegrationKit)
+	assert.Equal(t, it.Spec, data.Spec)
+}
+
+func TestSameDeployment(t *testing.T) {
+	kit := &corev1.ObjectReference{Namespace: "ns", Name: "my-kit"}
+	data := Data{Digest: "digest", IntegrationKit: kit}
+
+	assert.True(t, data.SameDeployment(&Data{Digest: "digest", IntegrationKit: kit.DeepCopy()}))
+	assert.False(t, data.SameDeployment(&Data{Digest: "other", IntegrationKit: kit}))
+	assert.False(t, data.SameDeployment(&Data{Digest: "digest", IntegrationKit: &corev1.ObjectReference{Namespace: "ns", Name: "other"}}))
+	assert.False(t, data.SameDeployment(&Data{Digest: "digest", Image: "my-image"}))
+	assert.True(t, (&Data{Digest: "digest", Image: "my-image"}).SameDeployment(&Data{Digest: "digest", Image: "my-image"}))
+}
@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileProvider reads the values from a directory tree, where each reference `<provider>:<path>#<key>` is stored
+// in the `<dir>/<path>/<key>` file. It's meant to be used in tests and local environments.
+type FileProvider struct {
+	id  string
+	dir string
+}
+
+// NewFileProvider returns a provider reading the values stored under the given directory.
+func NewFileProvider(id string, dir string) *FileProvider {
+	return &FileProvider{
+		id:  id,
+		dir: dir,
+	}
+}
+
+// ID --.
+func (p *FileProvider) ID() string {
+	return p.id
+}
+
+// Resolve --.
+func (p *FileProvider) Resolve(_ ProviderContext, ref Reference) ([]byte, error) {
+	file := filepath.Join(p.dir, filepath.FromSlash(ref.Path), ref.Key)
+	if rel, err := filepath.Rel(p.dir, file); err != nil || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("path %s is outside of the provider directory", ref.Path)
+	}
+
+	return os.ReadFile(file)
+}
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubernetesProviderID is the identifier of the default provider, reading the values from Kubernetes Secrets.
+const KubernetesProviderID = "kubernetes"
+
+// KubernetesSecretProvider reads the values from Kubernetes Secrets, referenced as `<provider>:<name>#<key>`.
+// The default provider reads the Secrets of the namespace of the resource requiring the value, while the providers
+// declared in the operator configuration read the Secrets of their own namespace, e.g., synchronized from an external
+// store by the External Secrets Operator. The Secrets of any other namespace can't be read.
+type KubernetesSecretProvider struct {
+	id        string
+	namespace string
+}
+
+// NewKubernetesSecretProvider returns a provider reading the Secrets from the given namespace. When empty, the namespace
+// of the resource requiring the value is used.
+func NewKubernetesSecretProvider(id string, namespace string) *KubernetesSecretProvider {
+	return &KubernetesSecretProvider{
+		id:        id,
+		namespace: namespace,
+	}
+}
+
+// ID --.
+func (p *KubernetesSecretProvider) ID() string {
+	return p.id
+}
+
+// Resolve --.
+func (p *KubernetesSecretProvider) Resolve(ctx ProviderContext, ref Reference) ([]byte, error) {
+	if ctx.Client == nil {
+		return nil, fmt.Errorf("a Kubernetes client is required by the %s secret provider", p.id)
+	}
+
+	namespace := p.namespace
+	if namespace == "" {
+		namespace = ctx.Namespace
+	}
+	name := ref.Path
+	if prefix, secret, found := strings.Cut(ref.Path, "/"); found {
+		// The namespace is only tolerated when it's the one the provider reads the Secrets from
+		if prefix != namespace {
+			return nil, fmt.Errorf("the %s secret provider can only read secrets from %s namespace", p.id, namespace)
+		}
+		name = secret
+	}
+
+	secret := corev1.Secret{}
+	if err := ctx.Client.Get(ctx.Ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("secret %s not found in %s namespace", name, namespace)
+		}
+
+		return nil, err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s/%s", ref.Key, namespace, name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,173 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+// ProvidersEnvVariable is the operator environment variable declaring the additional providers backed by Kubernetes Secrets,
+// in the format `<provider>=<namespace>[,<provider>=<namespace>]`, e.g., `vault=vault-secrets`.
+const ProvidersEnvVariable = "CAMEL_K_SECRET_PROVIDERS"
+
+var (
+	referenceRegexp = regexp.MustCompile(`^([a-z][a-z0-9\-]*):([^#\s]+)#([\w\.\-]+)$`)
+
+	// reservedSchemes can't be used as provider identifiers, as they already have a meaning for the CLI options.
+	reservedSchemes = []string{"configmap", "secret", "file", "pvc", "http", "https"}
+
+	providersLock sync.RWMutex
+	providers     = make(map[string]Provider)
+)
+
+// Reference is a pointer to a value stored in an external secret store, in the format `<provider>:<path>#<key>`,
+// e.g., `vault:secret/data/app#password`.
+type Reference struct {
+	// Provider is the identifier of the provider serving the value.
+	Provider string
+	// Path is the location of the secret in the store.
+	Path string
+	// Key is the entry of the secret holding the value.
+	Key string
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s:%s#%s", r.Provider, r.Path, r.Key)
+}
+
+// ProviderContext --.
+type ProviderContext struct {
+	Ctx    context.Context
+	Client client.Client
+	// Namespace is the namespace of the resource requiring the value.
+	Namespace string
+}
+
+// Provider resolves the values of the references served by an external secret store.
+type Provider interface {
+	// ID returns the identifier of the provider, used as scheme of the references.
+	ID() string
+	// Resolve returns the current value of the reference.
+	Resolve(ctx ProviderContext, ref Reference) ([]byte, error)
+}
+
+// RegisterProvider makes the provider available to resolve the references with its identifier as scheme,
+// replacing any provider previously registered with the same identifier.
+func RegisterProvider(p Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+
+	providers[p.ID()] = p
+}
+
+// GetProvider returns the provider registered with the given identifier, if any.
+func GetProvider(id string) Provider {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+
+	return providers[id]
+}
+
+// ProviderIDs returns the sorted identifiers of the registered providers.
+func ProviderIDs() []string {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+
+	ids := make([]string, 0, len(providers))
+	for id := range providers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// ParseReference parses a reference in the format `<provider>:<path>#<key>`. The provider is not required to be registered,
+// as references are usually parsed by clients not aware of the providers configured in the operator.
+func ParseReference(value string) (*Reference, error) {
+	groups := referenceRegexp.FindStringSubmatch(value)
+	if groups == nil {
+		return nil, fmt.Errorf("invalid secret reference %q, expected format is <provider>:<path>#<key>", value)
+	}
+	for _, reserved := range reservedSchemes {
+		if groups[1] == reserved {
+			return nil, fmt.Errorf("invalid secret reference %q, %q is not a secret provider", value, reserved)
+		}
+	}
+
+	return &Reference{
+		Provider: groups[1],
+		Path:     groups[2],
+		Key:      groups[3],
+	}, nil
+}
+
+// IsReference returns true if the value is a reference served by a registered provider.
+func IsReference(value string) bool {
+	ref, err := ParseReference(value)
+
+	return err == nil && GetProvider(ref.Provider) != nil
+}
+
+// Resolve returns the current value of the reference, using the provider registered for its scheme.
+func Resolve(ctx ProviderContext, ref Reference) ([]byte, error) {
+	p := GetProvider(ref.Provider)
+	if p == nil {
+		return nil, fmt.Errorf("unknown secret provider %q in %s, available providers are %s",
+			ref.Provider, ref.String(), strings.Join(ProviderIDs(), ", "))
+	}
+
+	value, err := p.Resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve secret reference %s: %w", ref.String(), err)
+	}
+
+	return value, nil
+}
+
+// RegisterKubernetesSecretProviders registers the providers backed by Kubernetes Secrets declared in the given configuration,
+// in the format `<provider>=<namespace>[,<provider>=<namespace>]`.
+func RegisterKubernetesSecretProviders(config string) error {
+	for entry := range strings.SplitSeq(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, namespace, ok := strings.Cut(entry, "=")
+		if !ok || namespace == "" {
+			return fmt.Errorf("invalid secret provider %q, expected format is <provider>=<namespace>", entry)
+		}
+		if _, err := ParseReference(id + ":path#key"); err != nil {
+			return fmt.Errorf("invalid secret provider identifier %q", id)
+		}
+		RegisterProvider(NewKubernetesSecretProvider(id, namespace))
+	}
+
+	return nil
+}
+
+func init() {
+	RegisterProvider(NewKubernetesSecretProvider(KubernetesProviderID, ""))
+}
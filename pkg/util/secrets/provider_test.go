@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("vault:secret/data/app#password")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{Provider: "vault", Path: "secret/data/app", Key: "password"}, *ref)
+	assert.Equal(t, "vault:secret/data/app#password", ref.String())
+
+	for _, value := range []string{"vault:secret/data/app", "vault:#password", "secret:my-secret#key", "http://host/path#anchor", "password"} {
+		_, err := ParseReference(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestIsReference(t *testing.T) {
+	assert.True(t, IsReference("kubernetes:my-secret#key"))
+	assert.False(t, IsReference("unknown:my-secret#key"))
+	assert.False(t, IsReference("my-value"))
+}
+
+func TestKubernetesSecretProvider(t *testing.T) {
+	c, err := internal.NewFakeClient(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "local"},
+			Data:       map[string][]byte{"password": []byte("local-pwd")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "store", Name: "shared"},
+			Data:       map[string][]byte{"password": []byte("shared-pwd")},
+		},
+	)
+	require.NoError(t, err)
+	ctx := ProviderContext{Ctx: context.Background(), Client: c, Namespace: "ns"}
+
+	value, err := Resolve(ctx, Reference{Provider: KubernetesProviderID, Path: "local", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "local-pwd", string(value))
+
+	value, err = Resolve(ctx, Reference{Provider: KubernetesProviderID, Path: "ns/local", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "local-pwd", string(value))
+
+	// The Secrets of other namespaces can't be read
+	_, err = Resolve(ctx, Reference{Provider: KubernetesProviderID, Path: "store/shared", Key: "password"})
+	require.EqualError(t, err, "could not resolve secret reference kubernetes:store/shared#password: "+
+		"the kubernetes secret provider can only read secrets from ns namespace")
+
+	_, err = Resolve(ctx, Reference{Provider: KubernetesProviderID, Path: "local", Key: "missing"})
+	require.EqualError(t, err, "could not resolve secret reference kubernetes:local#missing: key missing not found in secret ns/local")
+
+	_, err = Resolve(ctx, Reference{Provider: KubernetesProviderID, Path: "missing", Key: "password"})
+	require.EqualError(t, err, "could not resolve secret reference kubernetes:missing#password: secret missing not found in ns namespace")
+
+	// Providers declared in the operator configuration read the Secrets from their own namespace
+	require.NoError(t, RegisterKubernetesSecretProviders("vault=store, aws=other"))
+	value, err = Resolve(ctx, Reference{Provider: "vault", Path: "shared", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "shared-pwd", string(value))
+	assert.Contains(t, ProviderIDs(), "aws")
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "ns/local", Key: "password"})
+	require.EqualError(t, err, "could not resolve secret reference vault:ns/local#password: "+
+		"the vault secret provider can only read secrets from store namespace")
+
+	require.Error(t, RegisterKubernetesSecretProviders("vault"))
+	require.Error(t, RegisterKubernetesSecretProviders("secret=store"))
+}
+
+func TestFileProvider(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app", "db"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "db", "password"), []byte("file-pwd"), 0o600))
+	RegisterProvider(NewFileProvider("test-file", dir))
+
+	value, err := Resolve(ProviderContext{}, Reference{Provider: "test-file", Path: "app/db", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "file-pwd", string(value))
+
+	_, err = Resolve(ProviderContext{}, Reference{Provider: "test-file", Path: "../outside", Key: "password"})
+	require.Error(t, err)
+
+	_, err = Resolve(ProviderContext{}, Reference{Provider: "unknown", Path: "app/db", Key: "password"})
+	require.ErrorContains(t, err, `unknown secret provider "unknown"`)
+}
@@ -0,0 +1,328 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The signatures are stored in the registry using the same layout as cosign (https://github.com/sigstore/cosign),
+// so that they can be verified with cosign as well, and the signing keys can be generated with
+// `cosign generate-key-pair k8s://<namespace>/<secret>`.
+const (
+	// PrivateKeySecretKey is the key of the private key in the signing secret.
+	PrivateKeySecretKey = "cosign.key"
+	// PublicKeySecretKey is the key of the public key in the signing secret.
+	PublicKeySecretKey = "cosign.pub"
+	// PasswordSecretKey is the key of the password of an encrypted private key in the signing secret.
+	PasswordSecretKey = "cosign.password"
+
+	// SimpleSigningMediaType is the media type of the signed payload.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// SignatureAnnotation is the annotation of the payload layer holding the signature.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	signatureType      = "cosign container image signature"
+	signatureTagSuffix = ".sig"
+)
+
+// ErrNoSignature is returned when an image has not been signed.
+var ErrNoSignature = errors.New("no signature found")
+
+type payload struct {
+	Critical critical       `json:"critical"`
+	Optional map[string]any `json:"optional"`
+}
+
+type critical struct {
+	Identity identity `json:"identity"`
+	Image    image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// encryptedKey is the format of the encrypted private keys generated by cosign.
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// LoadPrivateKey parses a PEM encoded private key (PKCS#8, PKCS#1, SEC 1 or cosign encrypted key).
+func LoadPrivateKey(data []byte, password []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid private key: no PEM block found")
+	}
+
+	der := block.Bytes
+	switch block.Type {
+	case "ENCRYPTED SIGSTORE PRIVATE KEY", "ENCRYPTED COSIGN PRIVATE KEY":
+		decrypted, err := decrypt(block.Bytes, password)
+		if err != nil {
+			return nil, err
+		}
+		der = decrypted
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(der)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(der)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+func decrypt(data []byte, password []byte) ([]byte, error) {
+	var key encryptedKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid encrypted private key: %w", err)
+	}
+	if key.KDF.Name != "scrypt" || key.Cipher.Name != "nacl/secretbox" || len(key.Cipher.Nonce) != 24 {
+		return nil, errors.New("unsupported encrypted private key format")
+	}
+
+	derived, err := scrypt.Key(password, key.KDF.Salt, key.KDF.Params.N, key.KDF.Params.R, key.KDF.Params.P, 32)
+	if err != nil {
+		return nil, err
+	}
+	var secretKey [32]byte
+	var nonce [24]byte
+	copy(secretKey[:], derived)
+	copy(nonce[:], key.Cipher.Nonce)
+
+	decrypted, ok := secretbox.Open(nil, key.Ciphertext, &nonce, &secretKey)
+	if !ok {
+		return nil, errors.New("cannot decrypt private key: invalid password")
+	}
+
+	return decrypted, nil
+}
+
+// LoadPublicKey parses a PEM encoded public key.
+func LoadPublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid public key: no PEM block found")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return key, nil
+}
+
+// Tag returns the tag where the signatures of the image are stored.
+func Tag(ref name.Digest) name.Tag {
+	return ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + signatureTagSuffix)
+}
+
+// Sign signs the image and pushes the signature to the registry, next to the image.
+func Sign(ref name.Digest, signer crypto.Signer, options ...remote.Option) error {
+	content, err := json.Marshal(payload{
+		Critical: critical{
+			Identity: identity{DockerReference: ref.Context().Name()},
+			Image:    image{DockerManifestDigest: ref.DigestStr()},
+			Type:     signatureType,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	sig, err := sign(signer, content)
+	if err != nil {
+		return err
+	}
+
+	tag := Tag(ref)
+	signatures, err := remote.Image(tag, options...)
+	if err != nil {
+		if !isNotFound(err) {
+			return fmt.Errorf("cannot get signatures of image %s: %w", ref, err)
+		}
+		signatures = mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), types.OCIConfigJSON)
+	} else if verify(ref, signatures, signer.Public()) == nil {
+		// already signed with the same key
+		return nil
+	}
+
+	signatures, err = mutate.Append(signatures, mutate.Addendum{
+		Layer: static.NewLayer(content, SimpleSigningMediaType),
+		Annotations: map[string]string{
+			SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(tag, signatures, options...)
+}
+
+// Verify checks that the image has been signed with the private key matching the given public key.
+func Verify(ref name.Digest, publicKey crypto.PublicKey, options ...remote.Option) error {
+	signatures, err := remote.Image(Tag(ref), options...)
+	if err != nil {
+		if isNotFound(err) {
+			return fmt.Errorf("%w for image %s", ErrNoSignature, ref)
+		}
+
+		return fmt.Errorf("cannot get signatures of image %s: %w", ref, err)
+	}
+
+	return verify(ref, signatures, publicKey)
+}
+
+func verify(ref name.Digest, signatures containerv1.Image, publicKey crypto.PublicKey) error {
+	manifest, err := signatures.Manifest()
+	if err != nil {
+		return err
+	}
+	for _, descriptor := range manifest.Layers {
+		if descriptor.MediaType != SimpleSigningMediaType {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(descriptor.Annotations[SignatureAnnotation])
+		if err != nil {
+			continue
+		}
+		content, err := layerContent(signatures, descriptor.Digest)
+		if err != nil {
+			return err
+		}
+		var p payload
+		if err := json.Unmarshal(content, &p); err != nil {
+			continue
+		}
+		if p.Critical.Image.DockerManifestDigest != ref.DigestStr() {
+			continue
+		}
+		if verifySignature(publicKey, content, sig) == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no valid signature found for image %s", ref)
+}
+
+func layerContent(img containerv1.Image, digest containerv1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+func sign(signer crypto.Signer, content []byte) ([]byte, error) {
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		return signer.Sign(rand.Reader, content, crypto.Hash(0))
+	}
+	hash := sha256.Sum256(content)
+
+	return signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+}
+
+func verifySignature(publicKey crypto.PublicKey, content []byte, sig []byte) error {
+	hash := sha256.Sum256(content)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, hash[:], sig) {
+			return errors.New("invalid signature")
+		}
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, content, sig) {
+			return errors.New("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		return terr.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
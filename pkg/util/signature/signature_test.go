@@ -0,0 +1,160 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+func pushRandomImage(t *testing.T) name.Digest {
+	t.Helper()
+
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	ref, err := name.ParseReference(strings.TrimPrefix(server.URL, "http://")+"/ns/my-image:1", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	return ref.Context().Digest(digest.String())
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ref := pushRandomImage(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	err = Verify(ref, key.Public())
+	require.ErrorIs(t, err, ErrNoSignature)
+
+	require.NoError(t, Sign(ref, key))
+	require.NoError(t, Verify(ref, key.Public()))
+	require.Error(t, Verify(ref, other.Public()))
+
+	// signing again with the same key is a no-op, signing with another key adds a signature
+	require.NoError(t, Sign(ref, key))
+	require.NoError(t, Sign(ref, other))
+	require.NoError(t, Verify(ref, key.Public()))
+	require.NoError(t, Verify(ref, other.Public()))
+	signatures, err := remote.Image(Tag(ref))
+	require.NoError(t, err)
+	layers, err := signatures.Layers()
+	require.NoError(t, err)
+	assert.Len(t, layers, 2)
+
+	// a signature is bound to the image digest
+	otherImage := pushRandomImage(t)
+	require.ErrorIs(t, Verify(otherImage, key.Public()), ErrNoSignature)
+}
+
+func TestSignAndVerifyRSA(t *testing.T) {
+	ref := pushRandomImage(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	require.NoError(t, Sign(ref, key))
+	require.NoError(t, Verify(ref, key.Public()))
+}
+
+func TestTag(t *testing.T) {
+	ref, err := name.NewDigest("registry:5000/ns/my-image@sha256:" + strings.Repeat("a", 64))
+	require.NoError(t, err)
+	assert.Equal(t, "registry:5000/ns/my-image:sha256-"+strings.Repeat("a", 64)+".sig", Tag(ref).String())
+}
+
+func TestLoadKeys(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pub, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	signer, err := LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+
+	publicKey, err := LoadPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}))
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(publicKey))
+
+	_, err = LoadPrivateKey([]byte("not a key"), nil)
+	require.Error(t, err)
+	_, err = LoadPublicKey([]byte("not a key"))
+	require.Error(t, err)
+}
+
+func TestLoadEncryptedPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	// same format as the keys generated by cosign
+	var encrypted encryptedKey
+	encrypted.KDF.Name = "scrypt"
+	encrypted.KDF.Params.N = 32768
+	encrypted.KDF.Params.R = 8
+	encrypted.KDF.Params.P = 1
+	encrypted.KDF.Salt = []byte("0123456789abcdef0123456789abcdef")
+	encrypted.Cipher.Name = "nacl/secretbox"
+	encrypted.Cipher.Nonce = []byte("0123456789abcdef01234567")
+	derived, err := scrypt.Key([]byte("secret"), encrypted.KDF.Salt, 32768, 8, 1, 32)
+	require.NoError(t, err)
+	var secretKey [32]byte
+	var nonce [24]byte
+	copy(secretKey[:], derived)
+	copy(nonce[:], encrypted.Cipher.Nonce)
+	encrypted.Ciphertext = secretbox.Seal(nil, der, &nonce, &secretKey)
+	content, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: content})
+
+	signer, err := LoadPrivateKey(data, []byte("secret"))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+
+	_, err = LoadPrivateKey(data, []byte("wrong"))
+	require.EqualError(t, err, "cannot decrypt private key: invalid password")
+}
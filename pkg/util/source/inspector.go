@@ -25,6 +25,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
 )
 
 type catalog2deps func(*camel.RuntimeCatalog) []string
@@ -33,8 +34,12 @@ const (
 	defaultJSONDataFormat = "jackson"
 	kamelet               = "kamelet"
 	rest                  = "rest"
+	platformHTTP          = "platform-http"
 )
 
+// restVerbs are the HTTP methods of the REST DSL verbs.
+var restVerbs = []string{"get", "post", "put", "delete", "patch", "head"}
+
 var (
 	doubleQuotedFrom        = regexp.MustCompile(`from\s*\(\s*"([a-zA-Z0-9-]+:[^"]+)"`)
 	doubleQuotedFromF       = regexp.MustCompile(`fromF\s*\(\s*"([a-zA-Z0-9-]+:[^"]+)"`)
@@ -52,6 +57,7 @@ var (
 	restConfigurationRegexp = regexp.MustCompile(`.*restConfiguration\(\).*`)
 	restRegexp              = regexp.MustCompile(`.*rest\s*\([^)]*\).*`)
 	restClosureRegexp       = regexp.MustCompile(`.*rest\s*{\s*`)
+	restDSLRegexp           = regexp.MustCompile(`(?:\b(rest|from)|\.(get|post|put|delete|patch|head))\s*\(\s*(?:"([^"]*)")?\s*[,)]`)
 	openAPIRegexp           = regexp.MustCompile(`.*\.openApi\s*\([^)]*\).*`)
 	groovyLanguageRegexp    = regexp.MustCompile(`.*\.groovy\s*\(.*\).*`)
 	jsonPathLanguageRegexp  = regexp.MustCompile(`.*\.?(jsonpath|jsonpathWriteAsString)\s*\(.*\).*`)
@@ -247,6 +253,7 @@ func (i *baseInspector) extract(source v1.SourceSpec, meta *Metadata,
 	if hasRest {
 		meta.AddRequiredCapability(v1.CapabilityRest)
 	}
+	i.discoverHTTPEndpoints(meta)
 
 	meta.ExposesHTTPServices = hasRest || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
@@ -437,6 +444,25 @@ func (i *baseInspector) containsOnlyURIsIn(fromURI []string, allowed map[string]
 	return true
 }
 
+// discoverHTTPEndpoints adds the HTTP endpoints exposed by the platform-http consumers.
+func (i *baseInspector) discoverHTTPEndpoints(meta *Metadata) {
+	for _, fromURI := range meta.FromURIs {
+		if i.getURIPrefix(fromURI) != platformHTTP {
+			continue
+		}
+		path, _, _ := strings.Cut(strings.TrimPrefix(fromURI, platformHTTP+":"), "?")
+		methods := uri.GetQueryParameter(fromURI, "httpMethodRestrict")
+		if methods == "" {
+			meta.AddHTTPEndpoint("", path)
+
+			continue
+		}
+		for _, method := range strings.Split(methods, ",") {
+			meta.AddHTTPEndpoint(strings.TrimSpace(method), path)
+		}
+	}
+}
+
 func (i *baseInspector) getURIPrefix(uri string) string {
 	parts := strings.SplitN(uri, ":", 2)
 	if len(parts) > 0 {
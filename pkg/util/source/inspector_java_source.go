@@ -54,10 +54,31 @@ func (i JavaSourceInspector) Extract(source v1.SourceSpec, meta *Metadata) error
 		doubleQuotedKameletEip)
 
 	hasRest := restRegexp.MatchString(source.Content)
+	if hasRest {
+		discoverJavaRestEndpoints(source.Content, meta)
+	}
 
 	return i.extract(source, meta, from, to, kameletEips, hasRest)
 }
 
+// discoverJavaRestEndpoints adds the HTTP endpoints of the REST DSL verbs, e.g., `rest("/api").get("/orders")`.
+// The verbs are only looked up in the REST DSL blocks, that start with `rest(...)`, and end with the next `from(...)`.
+func discoverJavaRestEndpoints(content string, meta *Metadata) {
+	inRest := false
+	base := ""
+	for _, match := range restDSLRegexp.FindAllStringSubmatch(content, -1) {
+		switch {
+		case match[1] == rest:
+			inRest = true
+			base = match[3]
+		case match[1] == "from":
+			inRest = false
+		case inRest:
+			meta.AddHTTPEndpoint(match[2], httpEndpointPath(base, match[3]))
+		}
+	}
+}
+
 // ReplaceFromURI parses the source content and replace the `from` URI configuration with the a new URI. Returns true if it applies a replacement.
 func (i JavaSourceInspector) ReplaceFromURI(source *v1.SourceSpec, newFromURI string) (bool, error) {
 	return replaceFromURIDoubleQuotesOnly(source, newFromURI)
@@ -209,6 +209,34 @@ public void configure() throws Exception {
 	assert.Contains(t, meta.Dependencies.List(), "camel:rest-openapi")
 }
 
+func TestJavaRestEndpoints(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	sourceSpec := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "test.java",
+			Content: `
+public void configure() throws Exception {
+    rest("/api")
+        .get("/orders/{id}").to("direct:order")
+        .post().to("direct:create");
+
+    from("platform-http:/health")
+        .to("log:info");
+}
+			`,
+		},
+	}
+	meta := NewMetadata()
+	err := inspector.Extract(sourceSpec, &meta)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []HTTPEndpoint{
+		{Method: "GET", Path: "/api/orders/{id}"},
+		{Method: "POST", Path: "/api"},
+		{Path: "/health"},
+	}, meta.HTTPEndpoints)
+}
+
 func TestJavaBeanDependencies(t *testing.T) {
 	inspector := newTestJavaSourceInspector(t)
 
@@ -38,6 +38,9 @@ type XMLInspector struct {
 func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 	content := strings.NewReader(source.Content)
 	decoder := xml.NewDecoder(content)
+	// The base path of the REST DSL block being read, if any
+	inRest := false
+	restPath := ""
 
 	//nolint: nestif
 	for {
@@ -47,11 +50,22 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 			break
 		}
 
+		if ee, ok := t.(xml.EndElement); ok && ee.Name.Local == rest {
+			inRest = false
+		}
 		if se, ok := t.(xml.StartElement); ok {
 			switch se.Name.Local {
 			case rest, "restConfiguration":
 				meta.ExposesHTTPServices = true
 				meta.RequiredCapabilities.Add(v1.CapabilityRest)
+				if se.Name.Local == rest {
+					inRest = true
+					restPath = xmlAttribute(se, "path")
+				}
+			case "get", "post", "put", "delete", "patch", "head":
+				if inRest {
+					meta.AddHTTPEndpoint(se.Name.Local, httpEndpointPath(restPath, xmlAttribute(se, "path")))
+				}
 			case "openApi":
 				if dfDep := i.catalog.GetArtifactByScheme("rest-openapi"); dfDep != nil {
 					meta.AddDependency(dfDep.GetDependencyID())
@@ -121,6 +135,7 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 		return err
 	}
 	i.discoverKamelets(meta)
+	i.discoverHTTPEndpoints(meta)
 
 	meta.ExposesHTTPServices = meta.ExposesHTTPServices || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
@@ -128,6 +143,17 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 	return nil
 }
 
+// xmlAttribute returns the value of the attribute of the element with the given name, if any.
+func xmlAttribute(se xml.StartElement, name string) string {
+	for _, a := range se.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
 // ReplaceFromURI parses the source content and replace the `from` URI configuration with the a new URI. Returns true if it applies a replacement.
 func (i XMLInspector) ReplaceFromURI(source *v1.SourceSpec, newFromURI string) (bool, error) {
 	metadata := NewMetadata()
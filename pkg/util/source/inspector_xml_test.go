@@ -291,6 +291,37 @@ func TestXMLRestOpenapiFirst(t *testing.T) {
 	assert.Contains(t, meta.Dependencies.List(), "camel:rest-openapi")
 }
 
+func TestXMLRestEndpoints(t *testing.T) {
+	inspector := newTestXMLInspector(t)
+
+	sourceSpec := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "test.xml",
+			Content: `
+		  <rest path="/api">
+			<get path="/orders/{id}">
+			  <to uri="direct:order"/>
+			</get>
+			<delete path="/orders/{id}">
+			  <to uri="direct:delete"/>
+			</delete>
+		  </rest>
+		  <route>
+			<from uri="direct:order"/>
+			<to uri="log:info"/>
+		  </route>
+			`,
+		},
+	}
+	meta := NewMetadata()
+	err := inspector.Extract(sourceSpec, &meta)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []HTTPEndpoint{
+		{Method: "GET", Path: "/api/orders/{id}"},
+		{Method: "DELETE", Path: "/api/orders/{id}"},
+	}, meta.HTTPEndpoints)
+}
+
 func TestXMLBeanDependencies(t *testing.T) {
 	inspector := newTestXMLInspector(t)
 
@@ -58,6 +58,7 @@ func (i YAMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 		return err
 	}
 	i.discoverKamelets(meta)
+	i.discoverHTTPEndpoints(meta)
 
 	meta.ExposesHTTPServices = meta.ExposesHTTPServices || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
@@ -79,6 +80,9 @@ func (i YAMLInspector) parseDefinition(def map[string]any, meta *Metadata) error
 					}
 				}
 			}
+			if definition, ok := v.(map[any]any); ok {
+				discoverYAMLRestEndpoints(definition, meta)
+			}
 		}
 	}
 
@@ -95,6 +99,12 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 	case rest:
 		meta.ExposesHTTPServices = true
 		meta.RequiredCapabilities.Add(v1.CapabilityRest)
+		if cm, ok := content.(map[any]any); ok {
+			verb, _ := cm["verb"].(string)
+			if uri, uriOk := cm["uri"].(string); uriOk && verb != "" {
+				meta.AddHTTPEndpoint(verb, httpEndpointPath(uri))
+			}
+		}
 	case "circuitBreaker":
 		meta.RequiredCapabilities.Add(v1.CapabilityCircuitBreaker)
 	case "marshal", "unmarshal":
@@ -285,3 +295,26 @@ func (i YAMLInspector) ReplaceFromURI(source *v1.SourceSpec, newFromURI string)
 
 	return false, nil
 }
+
+// discoverYAMLRestEndpoints adds the HTTP endpoints of the verbs of the REST DSL definition.
+func discoverYAMLRestEndpoints(definition map[any]any, meta *Metadata) {
+	base, _ := definition["path"].(string)
+	for _, verb := range restVerbs {
+		var operations []any
+		switch t := definition[verb].(type) {
+		case []any:
+			operations = t
+		case map[any]any:
+			operations = []any{t}
+		default:
+			continue
+		}
+		for _, operation := range operations {
+			path := ""
+			if o, ok := operation.(map[any]any); ok {
+				path, _ = o["path"].(string)
+			}
+			meta.AddHTTPEndpoint(verb, httpEndpointPath(base, path))
+		}
+	}
+}
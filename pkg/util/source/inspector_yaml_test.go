@@ -232,11 +232,42 @@ func TestYAMLRestDSL(t *testing.T) {
 				assert.True(t, meta.RequiredCapabilities.Has(v1.CapabilityRest))
 				assert.True(t, meta.Dependencies.Has("camel:log"))
 				assert.True(t, meta.ExposesHTTPServices)
+				assert.Equal(t, []HTTPEndpoint{{Method: "POST", Path: "/api/flow"}}, meta.HTTPEndpoints)
 			})
 		})
 	}
 }
 
+const yamlRestDefinition = `
+- rest:
+    path: "/api"
+    get:
+      - path: "/orders/{id}"
+        to: "direct:order"
+      - path: "/orders"
+        to: "direct:orders"
+    post:
+      path: "/orders"
+      to: "direct:create"
+- from:
+    uri: "platform-http:/health?httpMethodRestrict=GET,HEAD"
+    steps:
+      - to: "log:info"
+`
+
+func TestYAMLRestEndpoints(t *testing.T) {
+	inspector := newTestYAMLInspector(t)
+	assertExtractYAML(t, inspector, yamlRestDefinition, func(meta *Metadata) {
+		assert.ElementsMatch(t, []HTTPEndpoint{
+			{Method: "GET", Path: "/api/orders/{id}"},
+			{Method: "GET", Path: "/api/orders"},
+			{Method: "POST", Path: "/api/orders"},
+			{Method: "GET", Path: "/health"},
+			{Method: "HEAD", Path: "/health"},
+		}, meta.HTTPEndpoints)
+	})
+}
+
 const yamlFromDSL = `
 - from:
     uri: "timer:tick"
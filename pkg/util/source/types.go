@@ -18,6 +18,10 @@ limitations under the License.
 package source
 
 import (
+	gopath "path"
+	"slices"
+	"strings"
+
 	"github.com/apache/camel-k/v2/pkg/util/sets"
 )
 
@@ -40,6 +44,16 @@ type Metadata struct {
 	RequiredCapabilities *sets.Set
 	// All kamelets
 	Kamelets []string
+	// All HTTP endpoints exposed by the REST DSL and by the platform-http consumers
+	HTTPEndpoints []HTTPEndpoint
+}
+
+// HTTPEndpoint is an HTTP endpoint exposed by a route.
+type HTTPEndpoint struct {
+	// the path of the endpoint, possibly with path parameters, e.g., `/orders/{id}`
+	Path string
+	// the HTTP method of the endpoint, or empty if the endpoint accepts any method
+	Method string
 }
 
 // NewMetadata creates a new metadata.
@@ -61,3 +75,19 @@ func (m *Metadata) AddRequiredCapability(capability string) {
 func (m *Metadata) AddDependency(dependency string) {
 	m.Dependencies.Add(dependency)
 }
+
+// AddHTTPEndpoint adds the HTTP endpoint with the given method and path, unless it is already present.
+func (m *Metadata) AddHTTPEndpoint(method string, path string) {
+	endpoint := HTTPEndpoint{
+		Path:   httpEndpointPath(path),
+		Method: strings.ToUpper(method),
+	}
+	if !slices.Contains(m.HTTPEndpoints, endpoint) {
+		m.HTTPEndpoints = append(m.HTTPEndpoints, endpoint)
+	}
+}
+
+// httpEndpointPath returns the absolute and clean form of the given path, and of the given sub-paths joined to it.
+func httpEndpointPath(path string, subPaths ...string) string {
+	return gopath.Join(append([]string{"/", path}, subPaths...)...)
+}
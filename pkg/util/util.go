@@ -298,14 +298,15 @@ type BytesMarshaller interface {
 	MarshalBytes() ([]byte, error)
 }
 
-func SortedMapKeys(m map[string]any) []string {
-	res := make([]string, len(m))
+// SortedMapKeys returns the keys of the map, sorted.
+func SortedMapKeys[K ~string, V any](m map[K]V) []K {
+	res := make([]K, len(m))
 	i := 0
 	for k := range m {
 		res[i] = k
 		i++
 	}
-	sort.Strings(res)
+	slices.Sort(res)
 
 	return res
 }